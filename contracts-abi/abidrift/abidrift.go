@@ -0,0 +1,217 @@
+// Package abidrift detects divergence between the generated FastSettlementV3
+// bindings and between a binding and deployed contract code.
+//
+// The full contract ABI (Fastsettlementv3MetaData) and the interface ABI
+// (Ifastsettlementv3MetaData) are generated independently, so nothing stops a
+// change to one from missing the other. Compare checks that the interface is a
+// faithful subset of the contract; CheckCode checks a binding against the
+// selectors and event topics actually present in runtime bytecode.
+package abidrift
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	ifastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/IFastSettlementV3"
+)
+
+// Kind classifies a Finding.
+type Kind string
+
+const (
+	// MissingFunction: a function selector expected by one side is absent
+	// from the other.
+	MissingFunction Kind = "missing-function"
+	// ExtraFunction: a function selector present on one side is not declared
+	// by the other.
+	ExtraFunction Kind = "extra-function"
+	// FunctionChanged: a function with the same name has a different
+	// signature, mutability or outputs.
+	FunctionChanged Kind = "function-changed"
+	// MissingEvent: an event is declared on one side only, or its topic does
+	// not appear in the code.
+	MissingEvent Kind = "missing-event"
+	// EventChanged: an event with the same name has a different topic or
+	// indexed layout.
+	EventChanged Kind = "event-changed"
+	// MissingError: a custom error is declared on one side only, or its
+	// selector does not appear in the code.
+	MissingError Kind = "missing-error"
+	// ErrorChanged: a custom error with the same name has a different
+	// selector.
+	ErrorChanged Kind = "error-changed"
+)
+
+// Severity says whether a Finding is drift or merely worth a look.
+type Severity string
+
+const (
+	// Fatal findings mean a binding will encode or decode incorrectly.
+	Fatal Severity = "fatal"
+	// Warning findings are expected in some configurations, e.g. views the
+	// interface deliberately omits, or errors the optimizer dropped because no
+	// code path raises them.
+	Warning Severity = "warning"
+)
+
+// Finding is a single difference.
+type Finding struct {
+	Kind     Kind     `json:"kind"`
+	Severity Severity `json:"severity"`
+	// Name is the function, event or error name.
+	Name string `json:"name"`
+	// Signature is the canonical signature, e.g. "rescueTokens(address,uint256)".
+	Signature string `json:"signature"`
+	// ID is the 4-byte selector or 32-byte topic, hex encoded.
+	ID string `json:"id"`
+	// Detail explains the difference in one line.
+	Detail string `json:"detail"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s %s %s [%s]: %s", f.Severity, f.Kind, f.Signature, f.ID, f.Detail)
+}
+
+// Report is the result of a comparison.
+type Report struct {
+	// Left and Right name the two sides being compared.
+	Left     string    `json:"left"`
+	Right    string    `json:"right"`
+	Findings []Finding `json:"findings"`
+}
+
+// Drift reports whether r contains any fatal finding.
+func (r *Report) Drift() bool {
+	for _, f := range r.Findings {
+		if f.Severity == Fatal {
+			return true
+		}
+	}
+	return false
+}
+
+// Merge appends the findings of other to r.
+func (r *Report) Merge(other *Report) {
+	r.Findings = append(r.Findings, other.Findings...)
+}
+
+func (r *Report) add(kind Kind, sev Severity, name, sig, id, format string, args ...interface{}) {
+	r.Findings = append(r.Findings, Finding{
+		Kind:      kind,
+		Severity:  sev,
+		Name:      name,
+		Signature: sig,
+		ID:        id,
+		Detail:    fmt.Sprintf(format, args...),
+	})
+}
+
+func (r *Report) sort() {
+	sort.SliceStable(r.Findings, func(i, j int) bool {
+		a, b := r.Findings[i], r.Findings[j]
+		if a.Severity != b.Severity {
+			return a.Severity == Fatal
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Signature < b.Signature
+	})
+}
+
+// ContractABI returns the parsed FastSettlementV3 binding ABI.
+func ContractABI() (*abi.ABI, error) { return fastsettlementv3.Fastsettlementv3MetaData.GetAbi() }
+
+// InterfaceABI returns the parsed IFastSettlementV3 binding ABI.
+func InterfaceABI() (*abi.ABI, error) { return ifastsettlementv3.Ifastsettlementv3MetaData.GetAbi() }
+
+// Compare checks that iface is a subset of contract: every function, event and
+// error iface declares must exist in contract with the same selector or topic.
+// Functions only contract declares are reported as warnings, since the
+// interface deliberately omits views and upgrade plumbing.
+func Compare(contract, iface *abi.ABI) *Report {
+	r := &Report{Left: "contract", Right: "interface"}
+
+	for name, m := range iface.Methods {
+		c, ok := contract.Methods[name]
+		switch {
+		case !ok:
+			r.add(MissingFunction, Fatal, name, m.Sig, selector(m.ID), "declared by the interface, absent from the contract")
+		case c.Sig != m.Sig:
+			r.add(FunctionChanged, Fatal, name, m.Sig, selector(m.ID), "contract declares %s [%s]", c.Sig, selector(c.ID))
+		case c.StateMutability != m.StateMutability:
+			r.add(FunctionChanged, Fatal, name, m.Sig, selector(m.ID), "mutability %s in the interface, %s in the contract", m.StateMutability, c.StateMutability)
+		case outputs(c.Outputs) != outputs(m.Outputs):
+			r.add(FunctionChanged, Fatal, name, m.Sig, selector(m.ID), "returns (%s) in the interface, (%s) in the contract", outputs(m.Outputs), outputs(c.Outputs))
+		}
+	}
+	for name, c := range contract.Methods {
+		if _, ok := iface.Methods[name]; !ok {
+			r.add(ExtraFunction, Warning, name, c.Sig, selector(c.ID), "declared by the contract only")
+		}
+	}
+
+	for name, e := range iface.Events {
+		c, ok := contract.Events[name]
+		switch {
+		case !ok:
+			r.add(MissingEvent, Fatal, name, e.Sig, e.ID.Hex(), "declared by the interface, absent from the contract")
+		case c.ID != e.ID:
+			r.add(EventChanged, Fatal, name, e.Sig, e.ID.Hex(), "contract declares %s with topic %s", c.Sig, c.ID.Hex())
+		case indexed(c.Inputs) != indexed(e.Inputs):
+			r.add(EventChanged, Fatal, name, e.Sig, e.ID.Hex(), "indexed fields (%s) in the interface, (%s) in the contract", indexed(e.Inputs), indexed(c.Inputs))
+		case c.Anonymous != e.Anonymous:
+			r.add(EventChanged, Fatal, name, e.Sig, e.ID.Hex(), "anonymous=%t in the interface, %t in the contract", e.Anonymous, c.Anonymous)
+		}
+	}
+
+	for name, e := range iface.Errors {
+		c, ok := contract.Errors[name]
+		switch {
+		case !ok:
+			r.add(MissingError, Fatal, name, e.Sig, selector(e.ID[:]), "declared by the interface, absent from the contract")
+		case c.ID != e.ID:
+			r.add(ErrorChanged, Fatal, name, e.Sig, selector(e.ID[:]), "contract declares %s [%s]", c.Sig, selector(c.ID[:]))
+		}
+	}
+
+	r.sort()
+	return r
+}
+
+// CompareBindings runs Compare over the two generated bindings.
+func CompareBindings() (*Report, error) {
+	contract, err := ContractABI()
+	if err != nil {
+		return nil, err
+	}
+	iface, err := InterfaceABI()
+	if err != nil {
+		return nil, err
+	}
+	return Compare(contract, iface), nil
+}
+
+func selector(id []byte) string { return hexutil.Encode(id[:4]) }
+
+func outputs(args abi.Arguments) string {
+	types := make([]string, len(args))
+	for i, a := range args {
+		types[i] = a.Type.String()
+	}
+	return strings.Join(types, ",")
+}
+
+func indexed(args abi.Arguments) string {
+	var names []string
+	for _, a := range args {
+		if a.Indexed {
+			names = append(names, a.Name)
+		}
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,137 @@
+package abidrift_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/primev/fastprotocolapp/contracts-abi/abidrift"
+)
+
+func contractABI(t *testing.T) *abi.ABI {
+	t.Helper()
+	a, err := abidrift.ContractABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func kinds(fs []abidrift.Finding) map[abidrift.Kind]abidrift.Severity {
+	out := map[abidrift.Kind]abidrift.Severity{}
+	for _, f := range fs {
+		out[f.Kind] = f.Severity
+	}
+	return out
+}
+
+// TestBindings is the check CI runs with -strict: the generated bindings
+// agree and produce no warning beyond the default baseline.
+func TestBindings(t *testing.T) {
+	r, err := abidrift.CompareBindings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Drift() {
+		t.Fatalf("bindings drift: %v", r.Findings)
+	}
+	if len(r.Findings) == 0 {
+		t.Fatal("expected the interface's omissions as warnings")
+	}
+	if extra := r.Unaccepted(abidrift.DefaultBaseline()); len(extra) > 0 {
+		t.Fatalf("warnings missing from abidrift/baseline.txt: %v", extra)
+	}
+}
+
+func TestCompareChanged(t *testing.T) {
+	contract := contractABI(t)
+	iface, err := abidrift.InterfaceABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := iface.Methods["executeWithPermit"]
+	m.StateMutability = "view"
+	iface.Methods["executeWithPermit"] = m
+
+	r := abidrift.Compare(contract, iface)
+	if !r.Drift() || kinds(r.Findings)[abidrift.FunctionChanged] != abidrift.Fatal {
+		t.Fatalf("findings %v", r.Findings)
+	}
+	if len(r.Unaccepted(abidrift.DefaultBaseline())) != 1 {
+		t.Fatalf("a baseline must not accept fatal findings: %v", r.Unaccepted(abidrift.DefaultBaseline()))
+	}
+}
+
+// code assembles runtime code dispatching every method of a but skip, pushing
+// its event topics and error selectors, and comparing extra like a selector.
+func code(a *abi.ABI, skip string, extra []byte) []byte {
+	var b []byte
+	push4 := func(sel []byte, eq bool) {
+		b = append(b, 0x63)
+		b = append(b, sel[:4]...)
+		if eq {
+			b = append(b, 0x80, 0x14) // DUP1 EQ
+		}
+	}
+	for name, m := range a.Methods {
+		if name != skip {
+			push4(m.ID, true)
+		}
+	}
+	for _, e := range a.Events {
+		b = append(b, 0x7f)
+		b = append(b, e.ID[:]...)
+	}
+	for _, e := range a.Errors {
+		push4(e.ID[:], false)
+	}
+	if extra != nil {
+		push4(extra, true)
+	}
+	return b
+}
+
+func TestCheckCode(t *testing.T) {
+	a := contractABI(t)
+	if r := abidrift.CheckCode("contract", a, code(a, "", nil)); len(r.Findings) != 0 {
+		t.Fatalf("matching code: %v", r.Findings)
+	}
+
+	// An ERC-1271 magic value compared with EQ looks like a selector.
+	magic := hexutil.MustDecode("0x1626ba7e")
+	r := abidrift.CheckCode("contract", a, code(a, "", magic))
+	if r.Drift() || len(r.Findings) != 1 || r.Findings[0].Kind != abidrift.ExtraFunction {
+		t.Fatalf("magic value: %v", r.Findings)
+	}
+	var bl bytes.Buffer
+	if err := abidrift.WriteBaseline(&bl, r); err != nil {
+		t.Fatal(err)
+	}
+	accepted, err := abidrift.ParseBaseline(&bl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Unaccepted(accepted)) != 0 {
+		t.Fatalf("written baseline %q does not accept %v", bl.String(), r.Findings)
+	}
+
+	r = abidrift.CheckCode("contract", a, code(a, "executeWithPermit", nil))
+	if !r.Drift() || kinds(r.Findings)[abidrift.MissingFunction] != abidrift.Fatal {
+		t.Fatalf("missing function: %v", r.Findings)
+	}
+}
+
+func TestParseBaseline(t *testing.T) {
+	b, err := abidrift.ParseBaseline(strings.NewReader("# comment\n\nextra-function 0x8da5cb5b owner()\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) != 1 || !b["extra-function 0x8da5cb5b"] {
+		t.Fatalf("baseline %v", b)
+	}
+	if _, err := abidrift.ParseBaseline(strings.NewReader("extra-function\n")); err == nil {
+		t.Fatal("a line without an ID parsed")
+	}
+}
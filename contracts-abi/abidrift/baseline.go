@@ -0,0 +1,86 @@
+package abidrift
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Baseline is a set of accepted warnings, keyed by Finding.Key. It lets
+// -strict fail on new warnings without failing on the known ones.
+//
+// The text form has one finding per line, its kind and ID followed by
+// anything, conventionally the signature; blank lines and lines starting
+// with # are ignored:
+//
+//	extra-function 0x8da5cb5b owner()
+type Baseline map[string]bool
+
+//go:embed baseline.txt
+var defaultBaseline string
+
+// DefaultBaseline returns the warnings the current bindings are known to
+// produce: the views and ownership and upgrade plumbing IFastSettlementV3
+// deliberately omits.
+func DefaultBaseline() Baseline {
+	b, err := ParseBaseline(strings.NewReader(defaultBaseline))
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Key identifies the finding in a Baseline.
+func (f Finding) Key() string { return string(f.Kind) + " " + f.ID }
+
+// ParseBaseline reads a baseline's text form.
+func ParseBaseline(r io.Reader) (Baseline, error) {
+	b := Baseline{}
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("abidrift: baseline line %d: want <kind> <id>, got %q", line, text)
+		}
+		b[fields[0]+" "+fields[1]] = true
+	}
+	return b, sc.Err()
+}
+
+// WriteBaseline writes the warnings of reports in the baseline's text form.
+// Fatal findings are never accepted, so they are left out.
+func WriteBaseline(w io.Writer, reports ...*Report) error {
+	for _, r := range reports {
+		for _, f := range r.Findings {
+			if f.Severity != Warning {
+				continue
+			}
+			sig := f.Signature
+			if sig == "" {
+				sig = "(" + f.Detail + ")"
+			}
+			if _, err := fmt.Fprintf(w, "%s %s\n", f.Key(), sig); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Unaccepted returns r's findings that b does not accept: every fatal
+// finding, and the warnings missing from b.
+func (r *Report) Unaccepted(b Baseline) []Finding {
+	var out []Finding
+	for _, f := range r.Findings {
+		if f.Severity == Fatal || !b[f.Key()] {
+			out = append(out, f)
+		}
+	}
+	return out
+}
@@ -0,0 +1,208 @@
+package abidrift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ImplementationSlot is the EIP-1967 storage slot holding a proxy's
+// implementation address.
+var ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+// ErrNoCode is returned when the inspected address has no runtime code.
+var ErrNoCode = errors.New("abidrift: no code at address")
+
+const (
+	opEQ     = 0x14
+	opPUSH1  = 0x60
+	opPUSH4  = 0x63
+	opPUSH32 = 0x7f
+	opDUP1   = 0x80
+	opDUP16  = 0x8f
+)
+
+// Code is the set of constants found in runtime bytecode that identify its
+// ABI surface.
+type Code struct {
+	// Dispatch holds the selectors compared against calldata in the function
+	// dispatcher (PUSHn <selector> [DUPn] EQ).
+	Dispatch map[[4]byte]bool
+	// Push4 holds every value pushed with PUSH1..PUSH4, left-padded to four
+	// bytes. Custom error selectors appear here.
+	Push4 map[[4]byte]bool
+	// Push32 holds every 32-byte constant. Event topics, and custom error
+	// selectors shifted into the high bytes, appear here.
+	Push32 map[common.Hash]bool
+}
+
+// ScanCode walks runtime bytecode and collects its selector and topic
+// constants. The trailing CBOR metadata is skipped so its bytes are not
+// mistaken for instructions.
+func ScanCode(code []byte) *Code {
+	code = stripMetadata(code)
+	c := &Code{
+		Dispatch: map[[4]byte]bool{},
+		Push4:    map[[4]byte]bool{},
+		Push32:   map[common.Hash]bool{},
+	}
+	for pc := 0; pc < len(code); pc++ {
+		op := code[pc]
+		if op < opPUSH1 || op > opPUSH32 {
+			continue
+		}
+		n := int(op-opPUSH1) + 1
+		end := pc + 1 + n
+		if end > len(code) {
+			break
+		}
+		arg := code[pc+1 : end]
+		switch {
+		case op <= opPUSH4:
+			var sel [4]byte
+			copy(sel[4-n:], arg)
+			c.Push4[sel] = true
+			next := end
+			if next < len(code) && code[next] >= opDUP1 && code[next] <= opDUP16 {
+				next++
+			}
+			if next < len(code) && code[next] == opEQ {
+				c.Dispatch[sel] = true
+			}
+		case op == opPUSH32:
+			c.Push32[common.BytesToHash(arg)] = true
+		}
+		pc = end - 1
+	}
+	return c
+}
+
+// stripMetadata removes the solc CBOR metadata trailer, whose length is
+// encoded in the last two bytes of the runtime code.
+func stripMetadata(code []byte) []byte {
+	if len(code) < 2 {
+		return code
+	}
+	n := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	start := len(code) - 2 - n
+	if n == 0 || start < 0 {
+		return code
+	}
+	// A CBOR map with one to five entries starts with 0xa1..0xa5.
+	if b := code[start]; b < 0xa1 || b > 0xa5 {
+		return code
+	}
+	return code[:start]
+}
+
+// hasError reports whether the custom error selector occurs either as a PUSH4
+// or as a PUSH32 with the selector in its high bytes.
+func (c *Code) hasError(sel [4]byte) bool {
+	if c.Push4[sel] {
+		return true
+	}
+	var h common.Hash
+	copy(h[:4], sel[:])
+	return c.Push32[h]
+}
+
+// CheckCode compares contractABI against runtime bytecode. Function selectors
+// missing from the dispatcher are fatal, as are event topics the code never
+// pushes. Error selectors absent from the code are warnings: the ABI keeps
+// errors the optimizer could prove unreachable.
+//
+// Selectors dispatched but undeclared are warnings too, because the check is
+// heuristic: any PUSH4 compared with EQ counts as dispatched, which also
+// matches magic-value comparisons such as ERC-1271's.
+func CheckCode(name string, contractABI *abi.ABI, code []byte) *Report {
+	r := &Report{Left: name, Right: "code"}
+	c := ScanCode(code)
+
+	declared := map[[4]byte]bool{}
+	for n, m := range contractABI.Methods {
+		var sel [4]byte
+		copy(sel[:], m.ID)
+		declared[sel] = true
+		if !c.Dispatch[sel] {
+			r.add(MissingFunction, Fatal, n, m.Sig, selector(m.ID), "declared by %s, not dispatched by the code", name)
+		}
+	}
+	var extra [][4]byte
+	for sel := range c.Dispatch {
+		if declared[sel] || sel[0] == 0 && sel[1] == 0 {
+			// Short constants compared with EQ are almost always not
+			// selectors; only full-width ones are worth reporting.
+			continue
+		}
+		extra = append(extra, sel)
+	}
+	sort.Slice(extra, func(i, j int) bool { return hexutil.Encode(extra[i][:]) < hexutil.Encode(extra[j][:]) })
+	for _, sel := range extra {
+		r.add(ExtraFunction, Warning, "", "", hexutil.Encode(sel[:]), "compared like a selector by the code, not declared by %s", name)
+	}
+
+	for n, e := range contractABI.Events {
+		if e.Anonymous {
+			continue
+		}
+		if !c.Push32[e.ID] {
+			r.add(MissingEvent, Fatal, n, e.Sig, e.ID.Hex(), "topic declared by %s never appears in the code", name)
+		}
+	}
+
+	for n, e := range contractABI.Errors {
+		var sel [4]byte
+		copy(sel[:], e.ID[:4])
+		if !c.hasError(sel) {
+			r.add(MissingError, Warning, n, e.Sig, selector(e.ID[:]), "declared by %s, selector not found in the code", name)
+		}
+	}
+
+	r.sort()
+	return r
+}
+
+// ChainReader is the subset of ethclient.Client CheckDeployed needs.
+type ChainReader interface {
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// Implementation resolves an EIP-1967 proxy to its implementation. Addresses
+// that are not proxies are returned unchanged.
+func Implementation(ctx context.Context, backend ChainReader, address common.Address) (common.Address, error) {
+	raw, err := backend.StorageAt(ctx, address, ImplementationSlot, nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+	impl := common.BytesToAddress(raw)
+	if impl == (common.Address{}) {
+		return address, nil
+	}
+	return impl, nil
+}
+
+// CheckDeployed runs CheckCode against the code at address, following an
+// EIP-1967 proxy to its implementation first.
+func CheckDeployed(ctx context.Context, backend ChainReader, address common.Address, name string, contractABI *abi.ABI) (*Report, error) {
+	impl, err := Implementation(ctx, backend, address)
+	if err != nil {
+		return nil, fmt.Errorf("resolve implementation of %s: %w", address, err)
+	}
+	code, err := backend.CodeAt(ctx, impl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("code at %s: %w", impl, err)
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("%w %s", ErrNoCode, impl)
+	}
+	r := CheckCode(name, contractABI, code)
+	r.Right = impl.Hex()
+	return r, nil
+}
@@ -0,0 +1,311 @@
+// Package amount is fixed-point arithmetic for token amounts. Intents carry
+// InputAmt, UserAmtOut, Received and Surplus as integers in a token's base
+// units; Amount pairs such an integer with the token's decimals and symbol
+// so it can be parsed from and rendered as human units without loss.
+//
+// Conversions are exact by default. Parsing "1.5 USDC" yields exactly
+// 1500000 base units, and ParseUnits(FormatUnits(x, d), d) == x for every
+// x. Operations that must discard precision — parsing more fraction digits
+// than the token has, rescaling between decimals, basis-point shares,
+// float conversion — take an explicit Rounding.
+//
+// Floats are for display only. Float64 returns the float nearest the exact
+// value, and FromFloat parses the shortest decimal that round-trips the
+// float, so an amount with at most 15 significant digits survives
+// Float64 → FromFloat unchanged.
+package amount
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/tokens"
+)
+
+var (
+	// ErrSyntax is returned for a string that is not a decimal number.
+	ErrSyntax = errors.New("amount: invalid number")
+	// ErrPrecision is returned when an exact conversion would lose digits.
+	ErrPrecision = errors.New("amount: more fraction digits than the token has")
+	// ErrUnknownToken is returned by Registry.Parse for an unregistered symbol.
+	ErrUnknownToken = errors.New("amount: unknown token")
+	// ErrMismatch is returned when combining amounts of different tokens.
+	ErrMismatch = errors.New("amount: different tokens")
+	// ErrNotFinite is returned by FromFloat for NaN and infinities.
+	ErrNotFinite = errors.New("amount: float is not finite")
+)
+
+// Rounding selects how discarded digits are handled.
+type Rounding int
+
+const (
+	// Down truncates toward zero.
+	Down Rounding = iota
+	// Up rounds away from zero.
+	Up
+	// Floor rounds toward negative infinity.
+	Floor
+	// Ceil rounds toward positive infinity.
+	Ceil
+	// HalfUp rounds to nearest, ties away from zero.
+	HalfUp
+	// HalfEven rounds to nearest, ties to the even neighbour.
+	HalfEven
+)
+
+// Amount is a token amount in base units. The zero value is zero of a
+// token with no decimals and no symbol.
+type Amount struct {
+	raw      *big.Int
+	decimals uint8
+	symbol   string
+	token    tokens.Metadata
+}
+
+// New returns raw base units of a token with the given decimals and symbol.
+func New(raw *big.Int, decimals uint8, symbol string) Amount {
+	return Amount{raw: copyInt(raw), decimals: decimals, symbol: symbol}
+}
+
+// Of returns raw base units of token.
+func Of(raw *big.Int, token tokens.Metadata) Amount {
+	return Amount{raw: copyInt(raw), decimals: token.Decimals, symbol: token.Symbol, token: token}
+}
+
+func copyInt(x *big.Int) *big.Int {
+	if x == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(x)
+}
+
+// Raw returns a copy of the base-unit integer, e.g. for an Intent field.
+func (a Amount) Raw() *big.Int { return copyInt(a.raw) }
+
+// Decimals returns the token's decimals.
+func (a Amount) Decimals() uint8 { return a.decimals }
+
+// Symbol returns the token's symbol.
+func (a Amount) Symbol() string { return a.symbol }
+
+// Token returns the metadata the amount was built from with Of, or metadata
+// holding only its decimals and symbol.
+func (a Amount) Token() tokens.Metadata {
+	if a.token == (tokens.Metadata{}) {
+		return tokens.Metadata{Symbol: a.symbol, Decimals: a.decimals}
+	}
+	return a.token
+}
+
+// Sign returns -1, 0 or +1.
+func (a Amount) Sign() int { return a.int().Sign() }
+
+// Cmp compares a and b, which must be of the same token.
+func (a Amount) Cmp(b Amount) (int, error) {
+	if err := a.same(b); err != nil {
+		return 0, err
+	}
+	return a.int().Cmp(b.int()), nil
+}
+
+// Add returns a + b, which must be of the same token.
+func (a Amount) Add(b Amount) (Amount, error) {
+	if err := a.same(b); err != nil {
+		return Amount{}, err
+	}
+	return a.with(new(big.Int).Add(a.int(), b.int())), nil
+}
+
+// Sub returns a - b, which must be of the same token.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	if err := a.same(b); err != nil {
+		return Amount{}, err
+	}
+	return a.with(new(big.Int).Sub(a.int(), b.int())), nil
+}
+
+func (a Amount) same(b Amount) error {
+	if a.decimals != b.decimals || !strings.EqualFold(a.symbol, b.symbol) || a.token.Address != b.token.Address {
+		return fmt.Errorf("%w: %s and %s", ErrMismatch, a, b)
+	}
+	return nil
+}
+
+func (a Amount) int() *big.Int {
+	if a.raw == nil {
+		return new(big.Int)
+	}
+	return a.raw
+}
+
+func (a Amount) with(raw *big.Int) Amount {
+	a.raw = raw
+	return a
+}
+
+// Text renders the amount in human units without its symbol, with no
+// trailing zeros: "1.5".
+func (a Amount) Text() string { return FormatUnits(a.int(), a.decimals) }
+
+// String renders the amount with its symbol: "1.5 USDC". An amount built
+// with Of for a token without a symbol is labelled with its address.
+func (a Amount) String() string {
+	switch {
+	case a.symbol != "":
+		return a.Text() + " " + a.symbol
+	case a.token.Address != (common.Address{}):
+		return a.Text() + " " + a.token.Address.Hex()
+	}
+	return a.Text()
+}
+
+// Fixed renders the amount with exactly places fraction digits, rounding
+// as mode says: Fixed(2, HalfUp) of 1.005 USDC is "1.01".
+func (a Amount) Fixed(places uint8, mode Rounding) string {
+	if places < a.decimals {
+		a = a.Rescale(places, mode)
+	}
+	s := a.Text()
+	frac := 0
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		frac = len(s) - i - 1
+	} else if places > 0 {
+		s += "."
+	}
+	return s + strings.Repeat("0", int(places)-frac)
+}
+
+// Rescale converts the amount to a token representation with decimals
+// places, e.g. USDC's 6 to an 18-decimal accounting unit. Scaling up is
+// exact; scaling down rounds as mode says.
+func (a Amount) Rescale(decimals uint8, mode Rounding) Amount {
+	out := a
+	out.decimals = decimals
+	out.token.Decimals = decimals
+	switch {
+	case decimals > a.decimals:
+		out.raw = new(big.Int).Mul(a.int(), pow10(int(decimals-a.decimals)))
+	case decimals < a.decimals:
+		out.raw = Div(a.int(), pow10(int(a.decimals-decimals)), mode)
+	default:
+		out.raw = copyInt(a.raw)
+	}
+	return out
+}
+
+// Float64 returns the float nearest the amount in human units.
+func (a Amount) Float64() float64 {
+	// The text is exact, so ParseFloat rounds it correctly; beyond
+	// float64's range it returns a signed infinity with ErrRange.
+	f, _ := strconv.ParseFloat(a.Text(), 64)
+	return f
+}
+
+// FromFloat converts a human-unit float to an amount, rounding digits past
+// decimals as mode says. It parses the float's shortest round-tripping
+// decimal form, so FromFloat(0.1, ...) is exactly 0.1, not the binary
+// approximation's 0.1000000000000000055511151231257827.
+func FromFloat(f float64, decimals uint8, symbol string, mode Rounding) (Amount, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Amount{}, ErrNotFinite
+	}
+	raw, err := ParseUnitsRound(strconv.FormatFloat(f, 'f', -1, 64), decimals, mode)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{raw: raw, decimals: decimals, symbol: symbol}, nil
+}
+
+// jsonAmount is Amount's JSON form; Raw is authoritative. Token and Name are
+// set for an amount built with Of, so that it decodes to the same token.
+type jsonAmount struct {
+	Raw      string          `json:"raw"`
+	Amount   string          `json:"amount"`
+	Decimals uint8           `json:"decimals"`
+	Symbol   string          `json:"symbol,omitempty"`
+	Token    *common.Address `json:"token,omitempty"`
+	Name     string          `json:"name,omitempty"`
+}
+
+// MarshalJSON encodes the amount as its raw base units, human-unit text,
+// decimals, symbol and, for an amount built with Of, the token's address and
+// name.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	j := jsonAmount{Raw: a.int().String(), Amount: a.Text(), Decimals: a.decimals, Symbol: a.symbol}
+	if a.token != (tokens.Metadata{}) {
+		addr := a.token.Address
+		j.Token, j.Name = &addr, a.token.Name
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes MarshalJSON's form from its raw base units.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var j jsonAmount
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	raw, ok := new(big.Int).SetString(j.Raw, 10)
+	if !ok {
+		return fmt.Errorf("%w: raw %q", ErrSyntax, j.Raw)
+	}
+	*a = Amount{raw: raw, decimals: j.Decimals, symbol: j.Symbol}
+	if j.Token != nil {
+		a.token = tokens.Metadata{Address: *j.Token, Name: j.Name, Symbol: j.Symbol, Decimals: j.Decimals}
+	}
+	return nil
+}
+
+var pow10s = func() []*big.Int {
+	out := make([]*big.Int, 78)
+	out[0] = big.NewInt(1)
+	for i := 1; i < len(out); i++ {
+		out[i] = new(big.Int).Mul(out[i-1], big.NewInt(10))
+	}
+	return out
+}()
+
+func pow10(n int) *big.Int {
+	if n < len(pow10s) {
+		return pow10s[n]
+	}
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// Div returns n / d rounded as mode says. d must be positive.
+func Div(n, d *big.Int, mode Rounding) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	// q is truncated toward zero; away moves it one step away from zero.
+	away := func() *big.Int { return q.Add(q, big.NewInt(int64(n.Sign()))) }
+	switch mode {
+	case Up:
+		return away()
+	case Floor:
+		if n.Sign() < 0 {
+			return away()
+		}
+	case Ceil:
+		if n.Sign() > 0 {
+			return away()
+		}
+	case HalfUp, HalfEven:
+		twice := new(big.Int).Abs(r)
+		twice.Lsh(twice, 1)
+		switch c := twice.Cmp(d); {
+		case c > 0:
+			return away()
+		case c == 0 && (mode == HalfUp || q.Bit(0) == 1):
+			return away()
+		}
+	}
+	return q
+}
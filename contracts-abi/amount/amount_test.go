@@ -0,0 +1,219 @@
+package amount_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/amount"
+	"github.com/primev/fastprotocolapp/contracts-abi/tokens"
+)
+
+var (
+	usdc = tokens.Metadata{Address: common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"), Name: "USD Coin", Symbol: "USDC", Decimals: 6}
+	// bridged has the same symbol and decimals at another address.
+	bridged = tokens.Metadata{Address: common.HexToAddress("0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174"), Name: "USD Coin (PoS)", Symbol: "USDC", Decimals: 6}
+)
+
+const iterations = 2000
+
+// randInt returns a signed integer of up to 40 decimal digits.
+func randInt(r *rand.Rand) *big.Int {
+	b := make([]byte, r.Intn(17))
+	r.Read(b)
+	x := new(big.Int).SetBytes(b)
+	if r.Intn(2) == 0 {
+		x.Neg(x)
+	}
+	return x
+}
+
+var modes = []amount.Rounding{amount.Down, amount.Up, amount.Floor, amount.Ceil, amount.HalfUp, amount.HalfEven}
+
+func TestFormat(t *testing.T) {
+	for _, tt := range []struct {
+		amount   int64
+		decimals uint8
+		want     string
+	}{
+		{1_500_000, 6, "1.5"},
+		{1, 6, "0.000001"},
+		{-2_000_000, 6, "-2"},
+		{0, 18, "0"},
+		{42, 0, "42"},
+	} {
+		if got := amount.FormatUnits(big.NewInt(tt.amount), tt.decimals); got != tt.want {
+			t.Errorf("FormatUnits(%d, %d) = %q, want %q", tt.amount, tt.decimals, got, tt.want)
+		}
+	}
+
+	bare := tokens.Metadata{Address: common.HexToAddress("0x000000000000000000000000000000000000ba5e"), Decimals: 2}
+	for _, tt := range []struct {
+		a    amount.Amount
+		want string
+	}{
+		{amount.Of(big.NewInt(1_500_000), usdc), "1.5 USDC"},
+		{amount.Of(big.NewInt(1_500_000), bare), "15000 " + bare.Address.Hex()},
+		{amount.New(big.NewInt(1_500_000), 6, ""), "1.5"},
+	} {
+		if got := tt.a.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestFormatParseRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < iterations; i++ {
+		x, d := randInt(r), uint8(r.Intn(40))
+		s := amount.FormatUnits(x, d)
+		got, err := amount.ParseUnits(s, d)
+		if err != nil {
+			t.Fatalf("ParseUnits(%q, %d): %v", s, d, err)
+		}
+		if got.Cmp(x) != 0 {
+			t.Fatalf("ParseUnits(FormatUnits(%s, %d)) = %s", x, d, got)
+		}
+	}
+	if _, err := amount.ParseUnits("1.0000001", 6); !errors.Is(err, amount.ErrPrecision) {
+		t.Fatalf("excess digits: err = %v, want ErrPrecision", err)
+	}
+}
+
+// TestDiv compares Div with rounding the exact rational quotient.
+func TestDiv(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	half := big.NewRat(1, 2)
+	for i := 0; i < iterations; i++ {
+		n := randInt(r)
+		d := new(big.Int).Abs(randInt(r))
+		if d.Sign() == 0 {
+			d.SetInt64(1 + r.Int63n(1000))
+		}
+		q := new(big.Rat).SetFrac(n, d)
+		floor := new(big.Int).Div(n, d) // Euclidean, so floor for positive d
+		ceil := new(big.Int).Set(floor)
+		if !q.IsInt() {
+			ceil.Add(ceil, common.Big1)
+		}
+		towardZero, awayZero := floor, ceil
+		if n.Sign() < 0 {
+			towardZero, awayZero = ceil, floor
+		}
+		frac := new(big.Rat).Sub(q, new(big.Rat).SetInt(floor))
+		nearest := func(even bool) *big.Int {
+			switch c := frac.Cmp(half); {
+			case c < 0:
+				return floor
+			case c > 0:
+				return ceil
+			case even:
+				if floor.Bit(0) == 0 {
+					return floor
+				}
+				return ceil
+			default:
+				return awayZero
+			}
+		}
+		want := map[amount.Rounding]*big.Int{
+			amount.Down:     towardZero,
+			amount.Up:       awayZero,
+			amount.Floor:    floor,
+			amount.Ceil:     ceil,
+			amount.HalfUp:   nearest(false),
+			amount.HalfEven: nearest(true),
+		}
+		for _, m := range modes {
+			if got := amount.Div(n, d, m); got.Cmp(want[m]) != 0 {
+				t.Fatalf("Div(%s, %s, %d) = %s, want %s", n, d, m, got, want[m])
+			}
+		}
+	}
+}
+
+func TestRescaleRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < iterations; i++ {
+		a := amount.Of(randInt(r), usdc)
+		up := a.Rescale(18, amount.Down)
+		back := up.Rescale(6, modes[r.Intn(len(modes))])
+		if c, err := back.Cmp(a); err != nil || c != 0 {
+			t.Fatalf("%s → %s → %s (%v)", a, up, back, err)
+		}
+	}
+}
+
+func TestShare(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	for i := 0; i < iterations; i++ {
+		x, bps := randInt(r), uint64(r.Intn(amount.BPS+1))
+		share, rest, err := amount.Share(x, bps)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if new(big.Int).Add(share, rest).Cmp(x) != 0 {
+			t.Fatalf("Share(%s, %d) = %s + %s", x, bps, share, rest)
+		}
+	}
+	if _, _, err := amount.Share(big.NewInt(1), amount.BPS+1); !errors.Is(err, amount.ErrBps) {
+		t.Fatalf("err = %v, want ErrBps", err)
+	}
+}
+
+func TestFloatRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	for i := 0; i < iterations; i++ {
+		// At most 15 significant digits.
+		raw := big.NewInt(r.Int63n(1_000_000_000_000_000))
+		a := amount.New(raw, uint8(r.Intn(19)), "X")
+		got, err := amount.FromFloat(a.Float64(), a.Decimals(), "X", amount.HalfEven)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c, err := got.Cmp(a); err != nil || c != 0 {
+			t.Fatalf("%s → %s → %s", a, strconv.FormatFloat(a.Float64(), 'g', -1, 64), got)
+		}
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+	decode := func(a amount.Amount) amount.Amount {
+		t.Helper()
+		b, err := json.Marshal(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got amount.Amount
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatalf("decode %s: %v", b, err)
+		}
+		return got
+	}
+	for i := 0; i < iterations; i++ {
+		for _, a := range []amount.Amount{
+			amount.Of(randInt(r), usdc),
+			amount.Of(randInt(r), tokens.ETH),
+			amount.New(randInt(r), uint8(r.Intn(40)), "X"),
+		} {
+			got := decode(a)
+			if got.Token() != a.Token() || got.Raw().Cmp(a.Raw()) != 0 {
+				t.Fatalf("%+v decoded to %+v", a.Token(), got.Token())
+			}
+			// The decoded amount is still the same token as the original.
+			if _, err := got.Add(a); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	a := decode(amount.Of(big.NewInt(1), usdc))
+	if _, err := a.Add(amount.Of(big.NewInt(1), bridged)); !errors.Is(err, amount.ErrMismatch) {
+		t.Fatalf("decoded USDC + bridged USDC: err = %v, want ErrMismatch", err)
+	}
+}
@@ -0,0 +1,80 @@
+package amount
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// BPS is the basis-point denominator: 10000 bps is 100%.
+const BPS = 10_000
+
+// ErrBps is returned for a basis-point value outside [0, BPS].
+var ErrBps = errors.New("amount: basis points out of range")
+
+var bpsDenom = big.NewInt(BPS)
+
+func checkBps(bps uint64) error {
+	if bps > BPS {
+		return fmt.Errorf("%w: %d", ErrBps, bps)
+	}
+	return nil
+}
+
+// MulBps returns x * bps / BPS rounded as mode says. bps may exceed BPS
+// here, e.g. to scale by 150%.
+func MulBps(x *big.Int, bps uint64, mode Rounding) *big.Int {
+	n := new(big.Int).Mul(x, new(big.Int).SetUint64(bps))
+	return Div(n, bpsDenom, mode)
+}
+
+// Bps returns part / whole in basis points rounded as mode says, e.g. the
+// surplus of an execution relative to UserAmtOut. whole must be positive.
+func Bps(part, whole *big.Int, mode Rounding) *big.Int {
+	return Div(new(big.Int).Mul(part, bpsDenom), whole, mode)
+}
+
+// MinOut returns the least acceptable output for quote given slippageBps of
+// tolerance, the UserAmtOut to sign. It rounds up so the tolerance is never
+// exceeded by rounding.
+func MinOut(quote *big.Int, slippageBps uint64) (*big.Int, error) {
+	if err := checkBps(slippageBps); err != nil {
+		return nil, err
+	}
+	return MulBps(quote, BPS-slippageBps, Up), nil
+}
+
+// MaxIn returns the most input to allow for quote given slippageBps of
+// tolerance on an exact-output swap. It rounds down so the tolerance is
+// never exceeded by rounding.
+func MaxIn(quote *big.Int, slippageBps uint64) *big.Int {
+	return MulBps(quote, BPS+slippageBps, Down)
+}
+
+// Share splits x into shareBps of it, rounded down, and the remainder, so
+// share + rest == x exactly. It is how a surplus is divided between the
+// user and the treasury without creating or losing a base unit.
+func Share(x *big.Int, shareBps uint64) (share, rest *big.Int, err error) {
+	if err := checkBps(shareBps); err != nil {
+		return nil, nil, err
+	}
+	share = MulBps(x, shareBps, Down)
+	return share, new(big.Int).Sub(x, share), nil
+}
+
+// MulBps returns the amount scaled by bps / BPS rounded as mode says.
+func (a Amount) MulBps(bps uint64, mode Rounding) Amount {
+	return a.with(MulBps(a.int(), bps, mode))
+}
+
+// Bps returns a as a share of whole in basis points, rounded as mode says.
+// whole must be of the same token and positive.
+func (a Amount) Bps(whole Amount, mode Rounding) (*big.Int, error) {
+	if err := a.same(whole); err != nil {
+		return nil, err
+	}
+	if whole.Sign() <= 0 {
+		return nil, fmt.Errorf("amount: share of non-positive %s", whole)
+	}
+	return Bps(a.int(), whole.int(), mode), nil
+}
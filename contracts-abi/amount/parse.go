@@ -0,0 +1,146 @@
+package amount
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/primev/fastprotocolapp/contracts-abi/tokens"
+)
+
+// FormatUnits renders x base units scaled down by decimals, without
+// trailing zeros: FormatUnits(1500000, 6) is "1.5".
+func FormatUnits(x *big.Int, decimals uint8) string {
+	if x == nil {
+		return "0"
+	}
+	neg := x.Sign() < 0
+	digits := new(big.Int).Abs(x).String()
+	if d := int(decimals); d > 0 {
+		if len(digits) <= d {
+			digits = strings.Repeat("0", d-len(digits)+1) + digits
+		}
+		whole, frac := digits[:len(digits)-d], strings.TrimRight(digits[len(digits)-d:], "0")
+		digits = whole
+		if frac != "" {
+			digits += "." + frac
+		}
+	}
+	if neg {
+		return "-" + digits
+	}
+	return digits
+}
+
+// ParseUnits parses a human-unit decimal such as "1.5" or "-0.25" into base
+// units of a token with decimals places. It fails with ErrPrecision rather
+// than round, so ParseUnits(FormatUnits(x, d), d) is x exactly.
+func ParseUnits(s string, decimals uint8) (*big.Int, error) {
+	return parseUnits(s, decimals, nil)
+}
+
+// ParseUnitsRound is ParseUnits rounding fraction digits past decimals as
+// mode says instead of failing.
+func ParseUnitsRound(s string, decimals uint8, mode Rounding) (*big.Int, error) {
+	return parseUnits(s, decimals, &mode)
+}
+
+func parseUnits(s string, decimals uint8, mode *Rounding) (*big.Int, error) {
+	in := s
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg, s = true, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" || !digits(whole) || !digits(frac) {
+		return nil, fmt.Errorf("%w: %q", ErrSyntax, in)
+	}
+	n, ok := new(big.Int).SetString(whole+frac+"0", 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrSyntax, in)
+	}
+	// The appended "0" keeps SetString away from an empty string; drop it.
+	n.Quo(n, pow10(1))
+	if neg {
+		n.Neg(n)
+	}
+	switch d := int(decimals); {
+	case len(frac) <= d:
+		return n.Mul(n, pow10(d-len(frac))), nil
+	case mode == nil:
+		scale := pow10(len(frac) - d)
+		if new(big.Int).Rem(n, scale).Sign() != 0 {
+			return nil, fmt.Errorf("%w: %q has %d, at most %d allowed", ErrPrecision, in, len(frac), d)
+		}
+		return n.Quo(n, scale), nil
+	default:
+		return Div(n, pow10(len(frac)-d), *mode), nil
+	}
+}
+
+func digits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse parses a human-unit decimal of token exactly.
+func Parse(s string, token tokens.Metadata) (Amount, error) {
+	raw, err := ParseUnits(strings.TrimSpace(s), token.Decimals)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{raw: raw, decimals: token.Decimals, symbol: token.Symbol, token: token}, nil
+}
+
+// Registry resolves token symbols so strings like "1.5 USDC" can be parsed.
+// Symbols match case-insensitively. A Registry is not safe for concurrent
+// mutation; build it up front.
+type Registry struct {
+	bySymbol map[string]tokens.Metadata
+}
+
+// NewRegistry returns a registry of the given tokens and ETH.
+func NewRegistry(mds ...tokens.Metadata) *Registry {
+	r := &Registry{bySymbol: make(map[string]tokens.Metadata)}
+	r.Add(tokens.ETH)
+	for _, md := range mds {
+		r.Add(md)
+	}
+	return r
+}
+
+// Add registers md under its symbol, replacing any token of that symbol.
+// Tokens without a symbol are ignored.
+func (r *Registry) Add(md tokens.Metadata) {
+	if md.Symbol == "" {
+		return
+	}
+	r.bySymbol[strings.ToUpper(md.Symbol)] = md
+}
+
+// Lookup returns the token registered under symbol.
+func (r *Registry) Lookup(symbol string) (tokens.Metadata, bool) {
+	md, ok := r.bySymbol[strings.ToUpper(symbol)]
+	return md, ok
+}
+
+// Parse parses "<decimal> <symbol>", e.g. "1.5 USDC", exactly into base
+// units of the registered token.
+func (r *Registry) Parse(s string) (Amount, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return Amount{}, fmt.Errorf("%w: %q is not \"<amount> <symbol>\"", ErrSyntax, s)
+	}
+	md, ok := r.Lookup(fields[1])
+	if !ok {
+		return Amount{}, fmt.Errorf("%w: %s", ErrUnknownToken, fields[1])
+	}
+	return Parse(fields[0], md)
+}
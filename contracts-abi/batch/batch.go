@@ -0,0 +1,479 @@
+// Package batch settles several intents in one transaction through an
+// IMulticall3 aggregator.
+//
+// FastSettlementV3 is onlyExecutor, so the aggregator must itself hold the
+// executor role: an owner-gated contract exposing aggregate3Value, such as
+// contracts/src/ExecutorAggregator.sol, never the public Multicall3. Every
+// call is sent with allowFailure, so one failing intent does not revert the
+// others.
+//
+// A Batcher estimates each intent on its own, drops the ones that would
+// revert, packs the rest greedily under a gas budget (Plan), simulates the
+// batch to decode per-intent results (Simulate), and submits it (Send).
+// Without an aggregator it falls back to one executeWithPermit transaction
+// per intent. Executed matches a mined receipt's IntentExecuted logs back to
+// the intents.
+//
+// Only Permit2-signed intents can be batched. executeWithETH requires
+// msg.sender to be the intent's user, so it always reverts when sent by the
+// executor or the aggregator; ETH-input intents go through package ethflow,
+// or package userop for smart accounts.
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	imulticall3 "github.com/primev/fastprotocolapp/contracts-abi/clients/IMulticall3"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"github.com/primev/fastprotocolapp/contracts-abi/telemetry"
+)
+
+// Call3Value is one aggregated call.
+type Call3Value = imulticall3.IMulticall3Call3Value
+
+var (
+	// ErrNotExecuted is reported by Executed for an intent without a
+	// matching IntentExecuted log.
+	ErrNotExecuted = errors.New("batch: intent not executed")
+	// ErrUnsigned rejects an item without a Permit2 signature: only the
+	// intent's user can send executeWithETH.
+	ErrUnsigned = errors.New("batch: intent has no Permit2 signature; settle it through ethflow or userop")
+)
+
+const txGas = 21_000
+
+// Item is one intent ready for settlement.
+type Item struct {
+	// Hash is the intent's witness hash.
+	Hash   common.Hash
+	Intent settlement.Intent
+	// Signature is the user's Permit2 signature. Items without one are
+	// rejected with ErrUnsigned.
+	Signature []byte
+	SwapCall  settlement.SwapCall
+}
+
+// Calldata returns the item's executeWithPermit calldata.
+func (it Item) Calldata() ([]byte, error) {
+	if len(it.Signature) == 0 {
+		return nil, ErrUnsigned
+	}
+	return settlementABI.Pack("executeWithPermit", it.Intent, it.Signature, it.SwapCall)
+}
+
+var (
+	settlementABI abi.ABI
+	multicall3ABI abi.ABI
+)
+
+func init() {
+	for _, b := range []struct {
+		dst *abi.ABI
+		md  *bind.MetaData
+	}{
+		{&settlementABI, fastsettlementv3.Fastsettlementv3MetaData},
+		{&multicall3ABI, imulticall3.Imulticall3MetaData},
+	} {
+		parsed, err := b.md.GetAbi()
+		if err != nil {
+			panic(err)
+		}
+		*b.dst = *parsed
+	}
+}
+
+// Config configures a Batcher.
+type Config struct {
+	// Settlement is the FastSettlementV3 proxy.
+	Settlement common.Address
+	// Executor is the EOA that signs transactions: the aggregator's owner, or
+	// the settlement executor itself when Aggregator is zero.
+	Executor common.Address
+	// Aggregator is the IMulticall3 contract holding the executor role. Zero
+	// disables batching.
+	Aggregator common.Address
+	Backend    bind.ContractBackend
+	// GasLimit caps a batch's gas. Defaults to 10M.
+	GasLimit uint64
+	// MaxSize caps the intents per batch. Defaults to 16.
+	MaxSize int
+	// BaseGas is the aggregator transaction's fixed cost including the 21000
+	// intrinsic gas. Defaults to 40000.
+	BaseGas uint64
+	// CallGas is the aggregator's per-call overhead. Defaults to 6000.
+	CallGas uint64
+	// Telemetry, when set, receives each item's Plan estimate as its
+	// simulation, its transaction as Submitted, and reverts and unsigned
+	// items as Failed.
+	Telemetry *telemetry.Telemetry
+}
+
+// Batcher plans and submits batches.
+type Batcher struct {
+	cfg    Config
+	single *settlement.V3
+	agg    *imulticall3.Imulticall3
+}
+
+// New returns a Batcher for cfg.
+func New(cfg Config) (*Batcher, error) {
+	if cfg.Backend == nil {
+		return nil, errors.New("batch: Backend is required")
+	}
+	if cfg.GasLimit == 0 {
+		cfg.GasLimit = 10_000_000
+	}
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = 16
+	}
+	if cfg.BaseGas == 0 {
+		cfg.BaseGas = 40_000
+	}
+	if cfg.CallGas == 0 {
+		cfg.CallGas = 6_000
+	}
+	single, err := settlement.NewV3(cfg.Settlement, cfg.Backend)
+	if err != nil {
+		return nil, err
+	}
+	agg, err := imulticall3.NewImulticall3(cfg.Aggregator, cfg.Backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Batcher{cfg: cfg, single: single, agg: agg}, nil
+}
+
+func (b *Batcher) batching() bool { return b.cfg.Aggregator != (common.Address{}) }
+
+// Batch is a group of intents submitted together.
+type Batch struct {
+	Items []Item
+	// Gas is the planned gas limit: the items' estimates less their intrinsic
+	// gas, plus the aggregator's overheads.
+	Gas uint64
+	// gas holds each item's standalone estimate.
+	gas []uint64
+}
+
+// itemGas returns item i's standalone estimate, or zero for a batch not
+// built by Plan.
+func (bt *Batch) itemGas(i int) uint64 {
+	if i < len(bt.gas) {
+		return bt.gas[i]
+	}
+	return 0
+}
+
+// Calls returns the aggregate3Value calls for the batch.
+func (bt *Batch) Calls(settlementAddr common.Address) ([]Call3Value, error) {
+	calls := make([]Call3Value, len(bt.Items))
+	for i, it := range bt.Items {
+		data, err := it.Calldata()
+		if err != nil {
+			return nil, fmt.Errorf("batch: intent %s: %w", it.Hash, err)
+		}
+		calls[i] = Call3Value{Target: settlementAddr, AllowFailure: true, Value: new(big.Int), CallData: data}
+	}
+	return calls, nil
+}
+
+// callCost is an item's share of a batch: its standalone estimate without
+// the intrinsic gas the batch pays once, plus the aggregator's overhead.
+func (b *Batcher) callCost(gas uint64) uint64 {
+	cost := b.cfg.CallGas
+	if gas > txGas {
+		cost += gas - txGas
+	}
+	return cost
+}
+
+func (b *Batcher) add(bt *Batch, it Item, gas uint64) {
+	bt.Items = append(bt.Items, it)
+	bt.gas = append(bt.gas, gas)
+	bt.Gas += b.callCost(gas)
+}
+
+// Rejected is an intent dropped before submission.
+type Rejected struct {
+	Item Item
+	// Err is a *reverts.Error when the intent's estimate reverted.
+	Err error
+}
+
+// Plan estimates every item and packs those that would succeed into batches
+// in order, starting a new batch when MaxSize or GasLimit would be exceeded.
+// Items whose estimate reverts are returned as rejected; any other RPC error
+// aborts the plan.
+func (b *Batcher) Plan(ctx context.Context, items []Item) ([]*Batch, []Rejected, error) {
+	from := b.cfg.Executor
+	if b.batching() {
+		from = b.cfg.Aggregator
+	}
+	var (
+		batches  []*Batch
+		rejected []Rejected
+		cur      *Batch
+	)
+	for _, it := range items {
+		data, err := it.Calldata()
+		if err != nil {
+			b.failed(ctx, it, err)
+			rejected = append(rejected, Rejected{Item: it, Err: err})
+			continue
+		}
+		gas, err := b.cfg.Backend.EstimateGas(ctx, ethereum.CallMsg{
+			From: from, To: &b.cfg.Settlement, Data: data,
+		})
+		if err != nil {
+			if rev := reverts.FromError(err); rev != nil {
+				b.simulated(ctx, it, rev)
+				rejected = append(rejected, Rejected{Item: it, Err: rev})
+				continue
+			}
+			return nil, nil, fmt.Errorf("batch: estimate %s: %w", it.Hash, err)
+		}
+		b.simulated(ctx, it, nil)
+		if cur == nil || len(cur.Items) == b.cfg.MaxSize || cur.Gas+b.callCost(gas) > b.cfg.GasLimit {
+			cur = &Batch{Gas: b.cfg.BaseGas}
+			batches = append(batches, cur)
+		}
+		b.add(cur, it, gas)
+	}
+	return batches, rejected, nil
+}
+
+// Result is the simulated or submitted outcome of one intent.
+type Result struct {
+	Item Item
+	// Tx is the transaction carrying the intent; intents of one batch share
+	// it. Nil when the intent was not submitted.
+	Tx *types.Transaction
+	// Err is the decoded revert of a failed call, or the submission error.
+	Err error
+}
+
+// Simulate eth_calls the batch through the aggregator and decodes each
+// intent's result.
+func (b *Batcher) Simulate(ctx context.Context, bt *Batch) ([]Result, error) {
+	if !b.batching() {
+		return nil, errors.New("batch: no aggregator configured")
+	}
+	calls, err := bt.Calls(b.cfg.Settlement)
+	if err != nil {
+		return nil, err
+	}
+	data, err := multicall3ABI.Pack("aggregate3Value", calls)
+	if err != nil {
+		return nil, err
+	}
+	out, err := b.cfg.Backend.CallContract(ctx, ethereum.CallMsg{
+		From: b.cfg.Executor, To: &b.cfg.Aggregator, Gas: bt.Gas, Data: data,
+	}, nil)
+	if err != nil {
+		if rev := reverts.FromError(err); rev != nil {
+			return nil, fmt.Errorf("batch: aggregator reverted: %w", rev)
+		}
+		return nil, err
+	}
+	unpacked, err := multicall3ABI.Unpack("aggregate3Value", out)
+	if err != nil {
+		return nil, fmt.Errorf("batch: decode results: %w", err)
+	}
+	results := *abi.ConvertType(unpacked[0], new([]imulticall3.IMulticall3Result)).(*[]imulticall3.IMulticall3Result)
+	if len(results) != len(bt.Items) {
+		return nil, fmt.Errorf("batch: %d results for %d calls", len(results), len(bt.Items))
+	}
+	res := make([]Result, len(results))
+	for i, r := range results {
+		res[i].Item = bt.Items[i]
+		if !r.Success {
+			res[i].Err = reverts.Decode(r.ReturnData)
+		}
+	}
+	return res, nil
+}
+
+// Send submits the batch. Without an aggregator each intent is sent directly
+// to the settlement contract. With one, a multi-intent batch is simulated
+// first: failing intents are reported without being submitted and the rest
+// go out in one aggregate3Value transaction. A single intent, or a batch
+// whose simulation fails as a whole, falls back to one aggregator
+// transaction per intent. opts.Nonce, if set, is incremented per
+// transaction sent.
+func (b *Batcher) Send(ctx context.Context, opts *bind.TransactOpts, bt *Batch) ([]Result, error) {
+	if !b.batching() {
+		return b.sendDirect(ctx, opts, bt)
+	}
+	if len(bt.Items) > 1 {
+		if sim, err := b.Simulate(ctx, bt); err == nil {
+			var (
+				results []Result
+				ok      = &Batch{Gas: b.cfg.BaseGas}
+			)
+			for i, r := range sim {
+				if r.Err != nil {
+					b.failed(ctx, r.Item, r.Err)
+					results = append(results, r)
+					continue
+				}
+				b.add(ok, r.Item, bt.itemGas(i))
+			}
+			if len(ok.Items) == 0 {
+				return results, nil
+			}
+			sent, err := b.sendAggregate(ctx, opts, ok)
+			return append(results, sent...), err
+		}
+	}
+	var (
+		results  []Result
+		firstErr error
+	)
+	for i, it := range bt.Items {
+		one := &Batch{Gas: b.cfg.BaseGas}
+		b.add(one, it, bt.itemGas(i))
+		sent, err := b.sendAggregate(ctx, opts, one)
+		results = append(results, sent...)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return results, firstErr
+}
+
+func (b *Batcher) sendAggregate(ctx context.Context, opts *bind.TransactOpts, bt *Batch) ([]Result, error) {
+	calls, err := bt.Calls(b.cfg.Settlement)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := b.agg.Aggregate3Value(b.txOpts(ctx, opts, bt.Gas), calls)
+	if rev := reverts.FromError(err); rev != nil {
+		err = rev
+	}
+	results := make([]Result, len(bt.Items))
+	for i, it := range bt.Items {
+		results[i] = Result{Item: it, Tx: tx, Err: err}
+		b.sent(ctx, it, tx, err)
+	}
+	if err == nil && opts.Nonce != nil {
+		opts.Nonce.Add(opts.Nonce, common.Big1)
+	}
+	return results, err
+}
+
+func (b *Batcher) sendDirect(ctx context.Context, opts *bind.TransactOpts, bt *Batch) ([]Result, error) {
+	results := make([]Result, len(bt.Items))
+	var firstErr error
+	for i, it := range bt.Items {
+		var (
+			tx  *types.Transaction
+			err = ErrUnsigned
+		)
+		if len(it.Signature) > 0 {
+			tx, err = b.single.ExecuteWithPermit(b.txOpts(ctx, opts, bt.itemGas(i)), it.Intent, it.Signature, it.SwapCall)
+		}
+		if rev := reverts.FromError(err); rev != nil {
+			err = rev
+		}
+		results[i] = Result{Item: it, Tx: tx, Err: err}
+		b.sent(ctx, it, tx, err)
+		switch {
+		case err == nil && opts.Nonce != nil:
+			opts.Nonce.Add(opts.Nonce, common.Big1)
+		case err != nil && firstErr == nil:
+			firstErr = err
+		}
+	}
+	return results, firstErr
+}
+
+func (b *Batcher) simulated(ctx context.Context, it Item, err error) {
+	if b.cfg.Telemetry != nil {
+		b.cfg.Telemetry.Simulated(ctx, it.Hash, err)
+	}
+}
+
+func (b *Batcher) failed(ctx context.Context, it Item, err error) {
+	if b.cfg.Telemetry != nil {
+		b.cfg.Telemetry.Failed(ctx, it.Hash, err)
+	}
+}
+
+// sent reports a send like telemetry.WrapSettlement: transport errors are
+// left to the caller's retry.
+func (b *Batcher) sent(ctx context.Context, it Item, tx *types.Transaction, err error) {
+	switch {
+	case b.cfg.Telemetry == nil:
+	case err == nil:
+		b.cfg.Telemetry.Submitted(ctx, it.Hash, tx.Hash())
+	case errors.Is(err, ErrUnsigned) || reverts.FromError(err) != nil:
+		b.cfg.Telemetry.Failed(ctx, it.Hash, err)
+	}
+}
+
+// txOpts copies opts with no value and, unless opts fixes one, the planned
+// gas limit.
+func (b *Batcher) txOpts(ctx context.Context, opts *bind.TransactOpts, gas uint64) *bind.TransactOpts {
+	cp := *opts
+	cp.Context = ctx
+	cp.Value = nil
+	if cp.GasLimit == 0 {
+		cp.GasLimit = gas
+	}
+	if opts.Nonce != nil {
+		cp.Nonce = new(big.Int).Set(opts.Nonce)
+	}
+	return &cp
+}
+
+// Executed matches the receipt's IntentExecuted logs to items in order. Logs
+// are emitted in call order and failed calls emit none, so each log is
+// paired with the next item whose user, tokens and input amount match it.
+// Items without a log get ErrNotExecuted.
+func Executed(receipt *types.Receipt, settlementAddr common.Address, items []Item) ([]*settlement.IntentExecuted, []error, error) {
+	parser, err := settlement.NewV3(settlementAddr, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	events := make([]*settlement.IntentExecuted, len(items))
+	errs := make([]error, len(items))
+	next := 0
+	for _, l := range receipt.Logs {
+		if l.Address != settlementAddr || len(l.Topics) == 0 || l.Topics[0] != settlement.IntentExecutedTopic {
+			continue
+		}
+		ev, err := parser.ParseIntentExecuted(*l)
+		if err != nil {
+			return nil, nil, err
+		}
+		for next < len(items) && !matches(items[next].Intent, ev) {
+			next++
+		}
+		if next == len(items) {
+			return nil, nil, fmt.Errorf("batch: IntentExecuted log %d matches no intent", l.Index)
+		}
+		events[next] = ev
+		next++
+	}
+	for i, ev := range events {
+		if ev == nil {
+			errs[i] = ErrNotExecuted
+		}
+	}
+	return events, errs, nil
+}
+
+func matches(in settlement.Intent, ev *settlement.IntentExecuted) bool {
+	return in.User == ev.User && in.InputToken == ev.InputToken && in.OutputToken == ev.OutputToken &&
+		in.InputAmt.Cmp(ev.InputAmt) == 0 && in.UserAmtOut.Cmp(ev.UserAmtOut) == 0
+}
@@ -0,0 +1,248 @@
+package batch_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primev/fastprotocolapp/contracts-abi/batch"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"github.com/primev/fastprotocolapp/contracts-abi/telemetry"
+	"github.com/primev/fastprotocolapp/contracts-abi/testharness"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	proxy      = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	executor   = common.HexToAddress("0x0000000000000000000000000000000000000002")
+	router     = common.HexToAddress("0x0000000000000000000000000000000000000004")
+	tokenIn    = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	tokenOut   = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+	userKey, _ = crypto.ToECDSA(crypto.Keccak256([]byte("user")))
+	user       = crypto.PubkeyToAddress(userKey.PublicKey)
+	domain     = permit2.Domain{ChainID: big.NewInt(1), Permit2: permit2.CanonicalAddress}
+)
+
+// estimator answers EstimateGas with gas, failing the nth call with fail[n].
+type estimator struct {
+	bind.ContractBackend
+	gas   uint64
+	calls int
+	fail  map[int]error
+}
+
+func (e *estimator) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	e.calls++
+	if err := e.fail[e.calls]; err != nil {
+		return 0, err
+	}
+	return e.gas, nil
+}
+
+func item(t *testing.T, nonce int64) batch.Item {
+	t.Helper()
+	in := settlement.Intent{
+		User:        user,
+		InputToken:  tokenIn,
+		OutputToken: tokenOut,
+		InputAmt:    big.NewInt(100),
+		UserAmtOut:  big.NewInt(nonce),
+		Recipient:   user,
+		Deadline:    big.NewInt(2_000),
+		Nonce:       big.NewInt(nonce),
+	}
+	sig, err := domain.Sign(userKey, proxy, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return batch.Item{
+		Hash:      permit2.WitnessHash(in),
+		Intent:    in,
+		Signature: sig,
+		SwapCall:  settlement.SwapCall{To: router, Value: new(big.Int)},
+	}
+}
+
+// revertErr is an RPC error carrying revert data.
+type revertErr struct{ data []byte }
+
+func (e revertErr) Error() string          { return "execution reverted" }
+func (e revertErr) ErrorData() interface{} { return "0x" + common.Bytes2Hex(e.data) }
+
+func TestPlan(t *testing.T) {
+	unsigned := item(t, 3)
+	unsigned.Signature = nil
+	items := []batch.Item{item(t, 1), unsigned, item(t, 2), item(t, 4), item(t, 5)}
+
+	be := &estimator{gas: 121_000, fail: map[int]error{3: revertErr{reverts.New("InvalidNonce").Data}}}
+	tel := telemetry.New(telemetry.Config{})
+	b, err := batch.New(batch.Config{Settlement: proxy, Executor: executor, Backend: be, MaxSize: 2, Telemetry: tel})
+	if err != nil {
+		t.Fatal(err)
+	}
+	batches, rejected, err := b.Plan(context.Background(), items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if be.calls != 4 {
+		t.Fatalf("%d estimates, want 4: the unsigned item is rejected before estimating", be.calls)
+	}
+	if len(rejected) != 2 || !errors.Is(rejected[0].Err, batch.ErrUnsigned) || !errors.Is(rejected[1].Err, reverts.Named("InvalidNonce")) {
+		t.Fatalf("rejected %+v", rejected)
+	}
+	if rejected[1].Item.Hash != items[3].Hash {
+		t.Fatalf("rejected %s, want the fourth item", rejected[1].Item.Hash)
+	}
+	if len(batches) != 2 || len(batches[0].Items) != 2 || len(batches[1].Items) != 1 {
+		t.Fatalf("%d batches", len(batches))
+	}
+	// 40000 base plus two calls at 6000 + (121000 - 21000).
+	if batches[0].Gas != 40_000+2*106_000 {
+		t.Fatalf("batch gas %d", batches[0].Gas)
+	}
+
+	m := tel.Metrics
+	if ok, rev, unsigned := testutil.ToFloat64(m.Simulated.WithLabelValues("ok")), testutil.ToFloat64(m.Simulated.WithLabelValues("revert")),
+		testutil.ToFloat64(m.Failed.WithLabelValues(telemetry.StageSubmit)); ok != 3 || rev != 1 || unsigned != 1 {
+		t.Fatalf("telemetry: %v simulated ok, %v reverted, %v failed", ok, rev, unsigned)
+	}
+
+	be.calls, be.fail = 0, map[int]error{1: errors.New("connection refused")}
+	if _, _, err := b.Plan(context.Background(), items[:1]); err == nil || errors.Is(err, batch.ErrUnsigned) {
+		t.Fatalf("RPC failure: err = %v, want it returned", err)
+	}
+}
+
+func TestCalldataRequiresSignature(t *testing.T) {
+	it := item(t, 1)
+	if _, err := it.Calldata(); err != nil {
+		t.Fatal(err)
+	}
+	it.Signature = nil
+	if _, err := it.Calldata(); !errors.Is(err, batch.ErrUnsigned) {
+		t.Fatalf("err = %v, want ErrUnsigned", err)
+	}
+	bt := &batch.Batch{Items: []batch.Item{it}}
+	if _, err := bt.Calls(proxy); !errors.Is(err, batch.ErrUnsigned) {
+		t.Fatalf("Calls: err = %v, want ErrUnsigned", err)
+	}
+}
+
+func TestExecuted(t *testing.T) {
+	f := settlement.NewFake(settlement.FakeConfig{
+		Address:  proxy,
+		Executor: executor,
+		Permit2:  permit2.CanonicalAddress,
+	})
+	f.SetTime(1_000)
+	f.AllowSwapTarget(router, true)
+	f.Mint(tokenIn, user, big.NewInt(1_000))
+	f.SetSwapHandler(router, func(env *settlement.SwapEnv) error {
+		if err := env.Pull(big.NewInt(100)); err != nil {
+			return err
+		}
+		env.Pay(tokenOut, env.Settlement, big.NewInt(10))
+		return nil
+	})
+	items := []batch.Item{item(t, 1), item(t, 2), item(t, 3)}
+	for _, it := range []batch.Item{items[0], items[2]} {
+		if _, err := f.ExecuteWithPermit(&bind.TransactOpts{From: executor}, it.Intent, it.Signature, it.SwapCall); err != nil {
+			t.Fatal(err)
+		}
+	}
+	evs, err := f.FilterIntentExecuted(nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt := &types.Receipt{}
+	for _, ev := range evs {
+		l := ev.Raw
+		receipt.Logs = append(receipt.Logs, &l)
+	}
+	// A log from another contract is ignored.
+	foreign := *receipt.Logs[0]
+	foreign.Address = router
+	receipt.Logs = append([]*types.Log{&foreign}, receipt.Logs...)
+
+	got, errs, err := batch.Executed(receipt, proxy, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[0] == nil || got[2] == nil || got[1] != nil || !errors.Is(errs[1], batch.ErrNotExecuted) || errs[0] != nil {
+		t.Fatalf("events %v, errs %v", got, errs)
+	}
+	if got[2].UserAmtOut.Int64() != 3 {
+		t.Fatalf("third log matched intent with userAmtOut %s", got[2].UserAmtOut)
+	}
+}
+
+// TestSendDirect settles a signed intent without an aggregator and shows why
+// unsigned intents cannot be batched: executeWithETH from the executor
+// reverts.
+func TestSendDirect(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{})
+	amountIn := new(big.Int).Mul(big.NewInt(10), testharness.Ether)
+	amountOut := new(big.Int).Mul(big.NewInt(9), testharness.Ether)
+	minOut := new(big.Int).Mul(big.NewInt(8), testharness.Ether)
+
+	in, err := h.NewIntent(h.TokenIn, h.TokenOut, amountIn, minOut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := h.SignIntent(h.User, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call, err := h.SwapCall(h.TokenIn, amountIn, h.TokenOut, amountOut, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := batch.New(batch.Config{Settlement: h.Proxy, Executor: h.Executor.Address, Backend: h.Client})
+	if err != nil {
+		t.Fatal(err)
+	}
+	items := []batch.Item{
+		{Hash: permit2.WitnessHash(in), Intent: in, Signature: sig, SwapCall: call},
+		{Intent: in, SwapCall: call},
+	}
+	batches, rejected, err := b.Plan(context.Background(), items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 1 || len(rejected) != 1 || !errors.Is(rejected[0].Err, batch.ErrUnsigned) {
+		t.Fatalf("%d batches, rejected %+v", len(batches), rejected)
+	}
+	results, err := b.Send(context.Background(), h.Opts(h.Executor), batches[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt, err := h.Mine(results[0].Tx, results[0].Err)
+	if err != nil {
+		t.Fatal(err)
+	}
+	evs, errs, err := batch.Executed(receipt, h.Proxy, batches[0].Items)
+	if err != nil || errs[0] != nil || evs[0].Received.Cmp(amountOut) != 0 {
+		t.Fatalf("executed %v %v %v", evs, errs, err)
+	}
+
+	ethIn, err := h.NewIntent(settlement.ETH, h.TokenOut, amountIn, minOut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ethCall, err := h.WETHSwapCall(amountIn, h.TokenOut, amountOut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.ExecuteWithETH(h.Executor, ethIn, ethCall, amountIn)
+	if !errors.Is(reverts.FromError(err), reverts.Named("UnauthorizedCaller")) {
+		t.Fatalf("executeWithETH from the executor: err = %v, want UnauthorizedCaller", err)
+	}
+}
@@ -0,0 +1,1240 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package cowsettlementhelper
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// CowsettlementhelperMetaData contains all meta data concerning the Cowsettlementhelper contract.
+var CowsettlementhelperMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"receive\",\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"acceptOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"arm\",\"inputs\":[{\"name\":\"legs\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"armed\",\"inputs\":[{\"name\":\"leg\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"operator\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pendingOwner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOperator\",\"inputs\":[{\"name\":\"_operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"settle\",\"inputs\":[{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdraw\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"LegArmed\",\"inputs\":[{\"name\":\"leg\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"LegSettled\",\"inputs\":[{\"name\":\"leg\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OperatorUpdated\",\"inputs\":[{\"name\":\"oldOperator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOperator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferStarted\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"FailedCall\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientBalance\",\"inputs\":[{\"name\":\"balance\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"needed\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"LegNotArmed\",\"inputs\":[{\"name\":\"leg\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"SafeERC20FailedOperation\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"Unauthorized\",\"inputs\":[]}]",
+}
+
+// CowsettlementhelperABI is the input ABI used to generate the binding from.
+// Deprecated: Use CowsettlementhelperMetaData.ABI instead.
+var CowsettlementhelperABI = CowsettlementhelperMetaData.ABI
+
+// Cowsettlementhelper is an auto generated Go binding around an Ethereum contract.
+type Cowsettlementhelper struct {
+	CowsettlementhelperCaller     // Read-only binding to the contract
+	CowsettlementhelperTransactor // Write-only binding to the contract
+	CowsettlementhelperFilterer   // Log filterer for contract events
+}
+
+// CowsettlementhelperCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CowsettlementhelperCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CowsettlementhelperTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CowsettlementhelperTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CowsettlementhelperFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CowsettlementhelperFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CowsettlementhelperSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CowsettlementhelperSession struct {
+	Contract     *Cowsettlementhelper // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// CowsettlementhelperCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CowsettlementhelperCallerSession struct {
+	Contract *CowsettlementhelperCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts              // Call options to use throughout this session
+}
+
+// CowsettlementhelperTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CowsettlementhelperTransactorSession struct {
+	Contract     *CowsettlementhelperTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// CowsettlementhelperRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CowsettlementhelperRaw struct {
+	Contract *Cowsettlementhelper // Generic contract binding to access the raw methods on
+}
+
+// CowsettlementhelperCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CowsettlementhelperCallerRaw struct {
+	Contract *CowsettlementhelperCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CowsettlementhelperTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CowsettlementhelperTransactorRaw struct {
+	Contract *CowsettlementhelperTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCowsettlementhelper creates a new instance of Cowsettlementhelper, bound to a specific deployed contract.
+func NewCowsettlementhelper(address common.Address, backend bind.ContractBackend) (*Cowsettlementhelper, error) {
+	contract, err := bindCowsettlementhelper(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Cowsettlementhelper{CowsettlementhelperCaller: CowsettlementhelperCaller{contract: contract}, CowsettlementhelperTransactor: CowsettlementhelperTransactor{contract: contract}, CowsettlementhelperFilterer: CowsettlementhelperFilterer{contract: contract}}, nil
+}
+
+// NewCowsettlementhelperCaller creates a new read-only instance of Cowsettlementhelper, bound to a specific deployed contract.
+func NewCowsettlementhelperCaller(address common.Address, caller bind.ContractCaller) (*CowsettlementhelperCaller, error) {
+	contract, err := bindCowsettlementhelper(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CowsettlementhelperCaller{contract: contract}, nil
+}
+
+// NewCowsettlementhelperTransactor creates a new write-only instance of Cowsettlementhelper, bound to a specific deployed contract.
+func NewCowsettlementhelperTransactor(address common.Address, transactor bind.ContractTransactor) (*CowsettlementhelperTransactor, error) {
+	contract, err := bindCowsettlementhelper(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CowsettlementhelperTransactor{contract: contract}, nil
+}
+
+// NewCowsettlementhelperFilterer creates a new log filterer instance of Cowsettlementhelper, bound to a specific deployed contract.
+func NewCowsettlementhelperFilterer(address common.Address, filterer bind.ContractFilterer) (*CowsettlementhelperFilterer, error) {
+	contract, err := bindCowsettlementhelper(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CowsettlementhelperFilterer{contract: contract}, nil
+}
+
+// bindCowsettlementhelper binds a generic wrapper to an already deployed contract.
+func bindCowsettlementhelper(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := CowsettlementhelperMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Cowsettlementhelper *CowsettlementhelperRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Cowsettlementhelper.Contract.CowsettlementhelperCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Cowsettlementhelper *CowsettlementhelperRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.CowsettlementhelperTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Cowsettlementhelper *CowsettlementhelperRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.CowsettlementhelperTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Cowsettlementhelper *CowsettlementhelperCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Cowsettlementhelper.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Cowsettlementhelper *CowsettlementhelperTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Cowsettlementhelper *CowsettlementhelperTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.contract.Transact(opts, method, params...)
+}
+
+// Armed is a free data retrieval call binding the contract method 0x85e2a7ec.
+//
+// Solidity: function armed(bytes32 leg) view returns(bool)
+func (_Cowsettlementhelper *CowsettlementhelperCaller) Armed(opts *bind.CallOpts, leg [32]byte) (bool, error) {
+	var out []interface{}
+	err := _Cowsettlementhelper.contract.Call(opts, &out, "armed", leg)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Armed is a free data retrieval call binding the contract method 0x85e2a7ec.
+//
+// Solidity: function armed(bytes32 leg) view returns(bool)
+func (_Cowsettlementhelper *CowsettlementhelperSession) Armed(leg [32]byte) (bool, error) {
+	return _Cowsettlementhelper.Contract.Armed(&_Cowsettlementhelper.CallOpts, leg)
+}
+
+// Armed is a free data retrieval call binding the contract method 0x85e2a7ec.
+//
+// Solidity: function armed(bytes32 leg) view returns(bool)
+func (_Cowsettlementhelper *CowsettlementhelperCallerSession) Armed(leg [32]byte) (bool, error) {
+	return _Cowsettlementhelper.Contract.Armed(&_Cowsettlementhelper.CallOpts, leg)
+}
+
+// Operator is a free data retrieval call binding the contract method 0x570ca735.
+//
+// Solidity: function operator() view returns(address)
+func (_Cowsettlementhelper *CowsettlementhelperCaller) Operator(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Cowsettlementhelper.contract.Call(opts, &out, "operator")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Operator is a free data retrieval call binding the contract method 0x570ca735.
+//
+// Solidity: function operator() view returns(address)
+func (_Cowsettlementhelper *CowsettlementhelperSession) Operator() (common.Address, error) {
+	return _Cowsettlementhelper.Contract.Operator(&_Cowsettlementhelper.CallOpts)
+}
+
+// Operator is a free data retrieval call binding the contract method 0x570ca735.
+//
+// Solidity: function operator() view returns(address)
+func (_Cowsettlementhelper *CowsettlementhelperCallerSession) Operator() (common.Address, error) {
+	return _Cowsettlementhelper.Contract.Operator(&_Cowsettlementhelper.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Cowsettlementhelper *CowsettlementhelperCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Cowsettlementhelper.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Cowsettlementhelper *CowsettlementhelperSession) Owner() (common.Address, error) {
+	return _Cowsettlementhelper.Contract.Owner(&_Cowsettlementhelper.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Cowsettlementhelper *CowsettlementhelperCallerSession) Owner() (common.Address, error) {
+	return _Cowsettlementhelper.Contract.Owner(&_Cowsettlementhelper.CallOpts)
+}
+
+// PendingOwner is a free data retrieval call binding the contract method 0xe30c3978.
+//
+// Solidity: function pendingOwner() view returns(address)
+func (_Cowsettlementhelper *CowsettlementhelperCaller) PendingOwner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Cowsettlementhelper.contract.Call(opts, &out, "pendingOwner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PendingOwner is a free data retrieval call binding the contract method 0xe30c3978.
+//
+// Solidity: function pendingOwner() view returns(address)
+func (_Cowsettlementhelper *CowsettlementhelperSession) PendingOwner() (common.Address, error) {
+	return _Cowsettlementhelper.Contract.PendingOwner(&_Cowsettlementhelper.CallOpts)
+}
+
+// PendingOwner is a free data retrieval call binding the contract method 0xe30c3978.
+//
+// Solidity: function pendingOwner() view returns(address)
+func (_Cowsettlementhelper *CowsettlementhelperCallerSession) PendingOwner() (common.Address, error) {
+	return _Cowsettlementhelper.Contract.PendingOwner(&_Cowsettlementhelper.CallOpts)
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactor) AcceptOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Cowsettlementhelper.contract.Transact(opts, "acceptOwnership")
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_Cowsettlementhelper *CowsettlementhelperSession) AcceptOwnership() (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.AcceptOwnership(&_Cowsettlementhelper.TransactOpts)
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactorSession) AcceptOwnership() (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.AcceptOwnership(&_Cowsettlementhelper.TransactOpts)
+}
+
+// Arm is a paid mutator transaction binding the contract method 0x6585de2c.
+//
+// Solidity: function arm(bytes32[] legs) returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactor) Arm(opts *bind.TransactOpts, legs [][32]byte) (*types.Transaction, error) {
+	return _Cowsettlementhelper.contract.Transact(opts, "arm", legs)
+}
+
+// Arm is a paid mutator transaction binding the contract method 0x6585de2c.
+//
+// Solidity: function arm(bytes32[] legs) returns()
+func (_Cowsettlementhelper *CowsettlementhelperSession) Arm(legs [][32]byte) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.Arm(&_Cowsettlementhelper.TransactOpts, legs)
+}
+
+// Arm is a paid mutator transaction binding the contract method 0x6585de2c.
+//
+// Solidity: function arm(bytes32[] legs) returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactorSession) Arm(legs [][32]byte) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.Arm(&_Cowsettlementhelper.TransactOpts, legs)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Cowsettlementhelper.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Cowsettlementhelper *CowsettlementhelperSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.RenounceOwnership(&_Cowsettlementhelper.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.RenounceOwnership(&_Cowsettlementhelper.TransactOpts)
+}
+
+// SetOperator is a paid mutator transaction binding the contract method 0xb3ab15fb.
+//
+// Solidity: function setOperator(address _operator) returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactor) SetOperator(opts *bind.TransactOpts, _operator common.Address) (*types.Transaction, error) {
+	return _Cowsettlementhelper.contract.Transact(opts, "setOperator", _operator)
+}
+
+// SetOperator is a paid mutator transaction binding the contract method 0xb3ab15fb.
+//
+// Solidity: function setOperator(address _operator) returns()
+func (_Cowsettlementhelper *CowsettlementhelperSession) SetOperator(_operator common.Address) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.SetOperator(&_Cowsettlementhelper.TransactOpts, _operator)
+}
+
+// SetOperator is a paid mutator transaction binding the contract method 0xb3ab15fb.
+//
+// Solidity: function setOperator(address _operator) returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactorSession) SetOperator(_operator common.Address) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.SetOperator(&_Cowsettlementhelper.TransactOpts, _operator)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x3232688e.
+//
+// Solidity: function settle(address tokenIn, uint256 amountIn, address tokenOut, uint256 amountOut) returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactor) Settle(opts *bind.TransactOpts, tokenIn common.Address, amountIn *big.Int, tokenOut common.Address, amountOut *big.Int) (*types.Transaction, error) {
+	return _Cowsettlementhelper.contract.Transact(opts, "settle", tokenIn, amountIn, tokenOut, amountOut)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x3232688e.
+//
+// Solidity: function settle(address tokenIn, uint256 amountIn, address tokenOut, uint256 amountOut) returns()
+func (_Cowsettlementhelper *CowsettlementhelperSession) Settle(tokenIn common.Address, amountIn *big.Int, tokenOut common.Address, amountOut *big.Int) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.Settle(&_Cowsettlementhelper.TransactOpts, tokenIn, amountIn, tokenOut, amountOut)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x3232688e.
+//
+// Solidity: function settle(address tokenIn, uint256 amountIn, address tokenOut, uint256 amountOut) returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactorSession) Settle(tokenIn common.Address, amountIn *big.Int, tokenOut common.Address, amountOut *big.Int) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.Settle(&_Cowsettlementhelper.TransactOpts, tokenIn, amountIn, tokenOut, amountOut)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Cowsettlementhelper.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Cowsettlementhelper *CowsettlementhelperSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.TransferOwnership(&_Cowsettlementhelper.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.TransferOwnership(&_Cowsettlementhelper.TransactOpts, newOwner)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xd9caed12.
+//
+// Solidity: function withdraw(address token, address to, uint256 amount) returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactor) Withdraw(opts *bind.TransactOpts, token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Cowsettlementhelper.contract.Transact(opts, "withdraw", token, to, amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xd9caed12.
+//
+// Solidity: function withdraw(address token, address to, uint256 amount) returns()
+func (_Cowsettlementhelper *CowsettlementhelperSession) Withdraw(token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.Withdraw(&_Cowsettlementhelper.TransactOpts, token, to, amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xd9caed12.
+//
+// Solidity: function withdraw(address token, address to, uint256 amount) returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactorSession) Withdraw(token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.Withdraw(&_Cowsettlementhelper.TransactOpts, token, to, amount)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Cowsettlementhelper.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Cowsettlementhelper *CowsettlementhelperSession) Receive() (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.Receive(&_Cowsettlementhelper.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Cowsettlementhelper *CowsettlementhelperTransactorSession) Receive() (*types.Transaction, error) {
+	return _Cowsettlementhelper.Contract.Receive(&_Cowsettlementhelper.TransactOpts)
+}
+
+// CowsettlementhelperLegArmedIterator is returned from FilterLegArmed and is used to iterate over the raw logs and unpacked data for LegArmed events raised by the Cowsettlementhelper contract.
+type CowsettlementhelperLegArmedIterator struct {
+	Event *CowsettlementhelperLegArmed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CowsettlementhelperLegArmedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CowsettlementhelperLegArmed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CowsettlementhelperLegArmed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CowsettlementhelperLegArmedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CowsettlementhelperLegArmedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CowsettlementhelperLegArmed represents a LegArmed event raised by the Cowsettlementhelper contract.
+type CowsettlementhelperLegArmed struct {
+	Leg [32]byte
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterLegArmed is a free log retrieval operation binding the contract event 0x37107826717f512f64d6050bb453ab5cd7b82789770fa2f0a185a1a369bee7fa.
+//
+// Solidity: event LegArmed(bytes32 indexed leg)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) FilterLegArmed(opts *bind.FilterOpts, leg [][32]byte) (*CowsettlementhelperLegArmedIterator, error) {
+
+	var legRule []interface{}
+	for _, legItem := range leg {
+		legRule = append(legRule, legItem)
+	}
+
+	logs, sub, err := _Cowsettlementhelper.contract.FilterLogs(opts, "LegArmed", legRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CowsettlementhelperLegArmedIterator{contract: _Cowsettlementhelper.contract, event: "LegArmed", logs: logs, sub: sub}, nil
+}
+
+// WatchLegArmed is a free log subscription operation binding the contract event 0x37107826717f512f64d6050bb453ab5cd7b82789770fa2f0a185a1a369bee7fa.
+//
+// Solidity: event LegArmed(bytes32 indexed leg)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) WatchLegArmed(opts *bind.WatchOpts, sink chan<- *CowsettlementhelperLegArmed, leg [][32]byte) (event.Subscription, error) {
+
+	var legRule []interface{}
+	for _, legItem := range leg {
+		legRule = append(legRule, legItem)
+	}
+
+	logs, sub, err := _Cowsettlementhelper.contract.WatchLogs(opts, "LegArmed", legRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CowsettlementhelperLegArmed)
+				if err := _Cowsettlementhelper.contract.UnpackLog(event, "LegArmed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLegArmed is a log parse operation binding the contract event 0x37107826717f512f64d6050bb453ab5cd7b82789770fa2f0a185a1a369bee7fa.
+//
+// Solidity: event LegArmed(bytes32 indexed leg)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) ParseLegArmed(log types.Log) (*CowsettlementhelperLegArmed, error) {
+	event := new(CowsettlementhelperLegArmed)
+	if err := _Cowsettlementhelper.contract.UnpackLog(event, "LegArmed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CowsettlementhelperLegSettledIterator is returned from FilterLegSettled and is used to iterate over the raw logs and unpacked data for LegSettled events raised by the Cowsettlementhelper contract.
+type CowsettlementhelperLegSettledIterator struct {
+	Event *CowsettlementhelperLegSettled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CowsettlementhelperLegSettledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CowsettlementhelperLegSettled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CowsettlementhelperLegSettled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CowsettlementhelperLegSettledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CowsettlementhelperLegSettledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CowsettlementhelperLegSettled represents a LegSettled event raised by the Cowsettlementhelper contract.
+type CowsettlementhelperLegSettled struct {
+	Leg       [32]byte
+	TokenIn   common.Address
+	TokenOut  common.Address
+	AmountIn  *big.Int
+	AmountOut *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterLegSettled is a free log retrieval operation binding the contract event 0x885c0e003be11008d1a039fdf6d22190c957015cccb90fbe0a3d56a730d05f90.
+//
+// Solidity: event LegSettled(bytes32 indexed leg, address indexed tokenIn, address indexed tokenOut, uint256 amountIn, uint256 amountOut)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) FilterLegSettled(opts *bind.FilterOpts, leg [][32]byte, tokenIn []common.Address, tokenOut []common.Address) (*CowsettlementhelperLegSettledIterator, error) {
+
+	var legRule []interface{}
+	for _, legItem := range leg {
+		legRule = append(legRule, legItem)
+	}
+	var tokenInRule []interface{}
+	for _, tokenInItem := range tokenIn {
+		tokenInRule = append(tokenInRule, tokenInItem)
+	}
+	var tokenOutRule []interface{}
+	for _, tokenOutItem := range tokenOut {
+		tokenOutRule = append(tokenOutRule, tokenOutItem)
+	}
+
+	logs, sub, err := _Cowsettlementhelper.contract.FilterLogs(opts, "LegSettled", legRule, tokenInRule, tokenOutRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CowsettlementhelperLegSettledIterator{contract: _Cowsettlementhelper.contract, event: "LegSettled", logs: logs, sub: sub}, nil
+}
+
+// WatchLegSettled is a free log subscription operation binding the contract event 0x885c0e003be11008d1a039fdf6d22190c957015cccb90fbe0a3d56a730d05f90.
+//
+// Solidity: event LegSettled(bytes32 indexed leg, address indexed tokenIn, address indexed tokenOut, uint256 amountIn, uint256 amountOut)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) WatchLegSettled(opts *bind.WatchOpts, sink chan<- *CowsettlementhelperLegSettled, leg [][32]byte, tokenIn []common.Address, tokenOut []common.Address) (event.Subscription, error) {
+
+	var legRule []interface{}
+	for _, legItem := range leg {
+		legRule = append(legRule, legItem)
+	}
+	var tokenInRule []interface{}
+	for _, tokenInItem := range tokenIn {
+		tokenInRule = append(tokenInRule, tokenInItem)
+	}
+	var tokenOutRule []interface{}
+	for _, tokenOutItem := range tokenOut {
+		tokenOutRule = append(tokenOutRule, tokenOutItem)
+	}
+
+	logs, sub, err := _Cowsettlementhelper.contract.WatchLogs(opts, "LegSettled", legRule, tokenInRule, tokenOutRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CowsettlementhelperLegSettled)
+				if err := _Cowsettlementhelper.contract.UnpackLog(event, "LegSettled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLegSettled is a log parse operation binding the contract event 0x885c0e003be11008d1a039fdf6d22190c957015cccb90fbe0a3d56a730d05f90.
+//
+// Solidity: event LegSettled(bytes32 indexed leg, address indexed tokenIn, address indexed tokenOut, uint256 amountIn, uint256 amountOut)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) ParseLegSettled(log types.Log) (*CowsettlementhelperLegSettled, error) {
+	event := new(CowsettlementhelperLegSettled)
+	if err := _Cowsettlementhelper.contract.UnpackLog(event, "LegSettled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CowsettlementhelperOperatorUpdatedIterator is returned from FilterOperatorUpdated and is used to iterate over the raw logs and unpacked data for OperatorUpdated events raised by the Cowsettlementhelper contract.
+type CowsettlementhelperOperatorUpdatedIterator struct {
+	Event *CowsettlementhelperOperatorUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CowsettlementhelperOperatorUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CowsettlementhelperOperatorUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CowsettlementhelperOperatorUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CowsettlementhelperOperatorUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CowsettlementhelperOperatorUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CowsettlementhelperOperatorUpdated represents a OperatorUpdated event raised by the Cowsettlementhelper contract.
+type CowsettlementhelperOperatorUpdated struct {
+	OldOperator common.Address
+	NewOperator common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterOperatorUpdated is a free log retrieval operation binding the contract event 0xfbe5b6cbafb274f445d7fed869dc77a838d8243a22c460de156560e8857cad03.
+//
+// Solidity: event OperatorUpdated(address indexed oldOperator, address indexed newOperator)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) FilterOperatorUpdated(opts *bind.FilterOpts, oldOperator []common.Address, newOperator []common.Address) (*CowsettlementhelperOperatorUpdatedIterator, error) {
+
+	var oldOperatorRule []interface{}
+	for _, oldOperatorItem := range oldOperator {
+		oldOperatorRule = append(oldOperatorRule, oldOperatorItem)
+	}
+	var newOperatorRule []interface{}
+	for _, newOperatorItem := range newOperator {
+		newOperatorRule = append(newOperatorRule, newOperatorItem)
+	}
+
+	logs, sub, err := _Cowsettlementhelper.contract.FilterLogs(opts, "OperatorUpdated", oldOperatorRule, newOperatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CowsettlementhelperOperatorUpdatedIterator{contract: _Cowsettlementhelper.contract, event: "OperatorUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchOperatorUpdated is a free log subscription operation binding the contract event 0xfbe5b6cbafb274f445d7fed869dc77a838d8243a22c460de156560e8857cad03.
+//
+// Solidity: event OperatorUpdated(address indexed oldOperator, address indexed newOperator)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) WatchOperatorUpdated(opts *bind.WatchOpts, sink chan<- *CowsettlementhelperOperatorUpdated, oldOperator []common.Address, newOperator []common.Address) (event.Subscription, error) {
+
+	var oldOperatorRule []interface{}
+	for _, oldOperatorItem := range oldOperator {
+		oldOperatorRule = append(oldOperatorRule, oldOperatorItem)
+	}
+	var newOperatorRule []interface{}
+	for _, newOperatorItem := range newOperator {
+		newOperatorRule = append(newOperatorRule, newOperatorItem)
+	}
+
+	logs, sub, err := _Cowsettlementhelper.contract.WatchLogs(opts, "OperatorUpdated", oldOperatorRule, newOperatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CowsettlementhelperOperatorUpdated)
+				if err := _Cowsettlementhelper.contract.UnpackLog(event, "OperatorUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOperatorUpdated is a log parse operation binding the contract event 0xfbe5b6cbafb274f445d7fed869dc77a838d8243a22c460de156560e8857cad03.
+//
+// Solidity: event OperatorUpdated(address indexed oldOperator, address indexed newOperator)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) ParseOperatorUpdated(log types.Log) (*CowsettlementhelperOperatorUpdated, error) {
+	event := new(CowsettlementhelperOperatorUpdated)
+	if err := _Cowsettlementhelper.contract.UnpackLog(event, "OperatorUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CowsettlementhelperOwnershipTransferStartedIterator is returned from FilterOwnershipTransferStarted and is used to iterate over the raw logs and unpacked data for OwnershipTransferStarted events raised by the Cowsettlementhelper contract.
+type CowsettlementhelperOwnershipTransferStartedIterator struct {
+	Event *CowsettlementhelperOwnershipTransferStarted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CowsettlementhelperOwnershipTransferStartedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CowsettlementhelperOwnershipTransferStarted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CowsettlementhelperOwnershipTransferStarted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CowsettlementhelperOwnershipTransferStartedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CowsettlementhelperOwnershipTransferStartedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CowsettlementhelperOwnershipTransferStarted represents a OwnershipTransferStarted event raised by the Cowsettlementhelper contract.
+type CowsettlementhelperOwnershipTransferStarted struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferStarted is a free log retrieval operation binding the contract event 0x38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e22700.
+//
+// Solidity: event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) FilterOwnershipTransferStarted(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*CowsettlementhelperOwnershipTransferStartedIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Cowsettlementhelper.contract.FilterLogs(opts, "OwnershipTransferStarted", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CowsettlementhelperOwnershipTransferStartedIterator{contract: _Cowsettlementhelper.contract, event: "OwnershipTransferStarted", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferStarted is a free log subscription operation binding the contract event 0x38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e22700.
+//
+// Solidity: event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) WatchOwnershipTransferStarted(opts *bind.WatchOpts, sink chan<- *CowsettlementhelperOwnershipTransferStarted, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Cowsettlementhelper.contract.WatchLogs(opts, "OwnershipTransferStarted", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CowsettlementhelperOwnershipTransferStarted)
+				if err := _Cowsettlementhelper.contract.UnpackLog(event, "OwnershipTransferStarted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferStarted is a log parse operation binding the contract event 0x38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e22700.
+//
+// Solidity: event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) ParseOwnershipTransferStarted(log types.Log) (*CowsettlementhelperOwnershipTransferStarted, error) {
+	event := new(CowsettlementhelperOwnershipTransferStarted)
+	if err := _Cowsettlementhelper.contract.UnpackLog(event, "OwnershipTransferStarted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CowsettlementhelperOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Cowsettlementhelper contract.
+type CowsettlementhelperOwnershipTransferredIterator struct {
+	Event *CowsettlementhelperOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CowsettlementhelperOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CowsettlementhelperOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CowsettlementhelperOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CowsettlementhelperOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CowsettlementhelperOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CowsettlementhelperOwnershipTransferred represents a OwnershipTransferred event raised by the Cowsettlementhelper contract.
+type CowsettlementhelperOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*CowsettlementhelperOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Cowsettlementhelper.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CowsettlementhelperOwnershipTransferredIterator{contract: _Cowsettlementhelper.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *CowsettlementhelperOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Cowsettlementhelper.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CowsettlementhelperOwnershipTransferred)
+				if err := _Cowsettlementhelper.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Cowsettlementhelper *CowsettlementhelperFilterer) ParseOwnershipTransferred(log types.Log) (*CowsettlementhelperOwnershipTransferred, error) {
+	event := new(CowsettlementhelperOwnershipTransferred)
+	if err := _Cowsettlementhelper.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,2450 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package fastsettlementv2
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	sharedtypes "github.com/primev/fastprotocolapp/contracts-abi/clients/SharedTypes"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IFastSettlementV2Intent is an auto generated low-level Go binding around an user-defined struct.
+// It is declared once in sharedtypes and shared by every binding that uses it.
+type IFastSettlementV2Intent = sharedtypes.IFastSettlementV2Intent
+
+// Fastsettlementv2MetaData contains all meta data concerning the Fastsettlementv2 contract.
+var Fastsettlementv2MetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"permit2_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"owner_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"surplusRecipient_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"receive\",\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"BPS_DENOMINATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DOMAIN_SEPARATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"INTENT_TYPEHASH\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MAX_NONCE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"NONCE_DOMAIN\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"PERMIT2\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractISignatureTransfer\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"WITNESS_TYPE_STRING\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"acceptOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getIntentId\",\"inputs\":[{\"name\":\"intent\",\"type\":\"tuple\",\"internalType\":\"structIFastSettlementV2.Intent\",\"components\":[{\"name\":\"maker\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"refId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getMinNonce\",\"inputs\":[{\"name\":\"maker\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getSurplusRecipient\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"invalidateNoncesUpTo\",\"inputs\":[{\"name\":\"newMinNonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isExecutorAllowed\",\"inputs\":[{\"name\":\"executor\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isExecutorWhitelistActive\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isNonceUsed\",\"inputs\":[{\"name\":\"maker\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"paused\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pendingOwner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"rescueTokens\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutor\",\"inputs\":[{\"name\":\"executor\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"allowed\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutorWhitelistActive\",\"inputs\":[{\"name\":\"active\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setSurplusRecipient\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"settle\",\"inputs\":[{\"name\":\"intent\",\"type\":\"tuple\",\"internalType\":\"structIFastSettlementV2.Intent\",\"components\":[{\"name\":\"maker\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"refId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"executorData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"unpause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"validate\",\"inputs\":[{\"name\":\"intent\",\"type\":\"tuple\",\"internalType\":\"structIFastSettlementV2.Intent\",\"components\":[{\"name\":\"maker\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"refId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"isValid\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"reason\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"ExecutorUpdated\",\"inputs\":[{\"name\":\"executor\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"allowed\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ExecutorWhitelistActiveUpdated\",\"inputs\":[{\"name\":\"active\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"IntentSettled\",\"inputs\":[{\"name\":\"intentId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"maker\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"protocolAmt\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"NonceInvalidated\",\"inputs\":[{\"name\":\"maker\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newMinNonce\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferStarted\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Paused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SurplusRecipientUpdated\",\"inputs\":[{\"name\":\"oldRecipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newRecipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TokensRescued\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unpaused\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"EnforcedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ExpectedPause\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientOutput\",\"inputs\":[{\"name\":\"received\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"required\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidExecutorAddress\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidNonceIncrement\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidOwnerAddress\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidPermit2Address\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidRecipient\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidSurplusBps\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidSurplusRecipient\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NonceAlreadyUsed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NonceTooHigh\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"Permit2TransferFailed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SafeERC20FailedOperation\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"SettlementFailed\",\"inputs\":[{\"name\":\"intentId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"reason\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"type\":\"error\",\"name\":\"TransactionExpired\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UnauthorizedExecutor\",\"inputs\":[]}]",
+}
+
+// Fastsettlementv2ABI is the input ABI used to generate the binding from.
+// Deprecated: Use Fastsettlementv2MetaData.ABI instead.
+var Fastsettlementv2ABI = Fastsettlementv2MetaData.ABI
+
+// Fastsettlementv2 is an auto generated Go binding around an Ethereum contract.
+type Fastsettlementv2 struct {
+	Fastsettlementv2Caller     // Read-only binding to the contract
+	Fastsettlementv2Transactor // Write-only binding to the contract
+	Fastsettlementv2Filterer   // Log filterer for contract events
+}
+
+// Fastsettlementv2Caller is an auto generated read-only Go binding around an Ethereum contract.
+type Fastsettlementv2Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Fastsettlementv2Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type Fastsettlementv2Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Fastsettlementv2Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Fastsettlementv2Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Fastsettlementv2Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Fastsettlementv2Session struct {
+	Contract     *Fastsettlementv2 // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// Fastsettlementv2CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Fastsettlementv2CallerSession struct {
+	Contract *Fastsettlementv2Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// Fastsettlementv2TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Fastsettlementv2TransactorSession struct {
+	Contract     *Fastsettlementv2Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// Fastsettlementv2Raw is an auto generated low-level Go binding around an Ethereum contract.
+type Fastsettlementv2Raw struct {
+	Contract *Fastsettlementv2 // Generic contract binding to access the raw methods on
+}
+
+// Fastsettlementv2CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Fastsettlementv2CallerRaw struct {
+	Contract *Fastsettlementv2Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// Fastsettlementv2TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Fastsettlementv2TransactorRaw struct {
+	Contract *Fastsettlementv2Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewFastsettlementv2 creates a new instance of Fastsettlementv2, bound to a specific deployed contract.
+func NewFastsettlementv2(address common.Address, backend bind.ContractBackend) (*Fastsettlementv2, error) {
+	contract, err := bindFastsettlementv2(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2{Fastsettlementv2Caller: Fastsettlementv2Caller{contract: contract}, Fastsettlementv2Transactor: Fastsettlementv2Transactor{contract: contract}, Fastsettlementv2Filterer: Fastsettlementv2Filterer{contract: contract}}, nil
+}
+
+// NewFastsettlementv2Caller creates a new read-only instance of Fastsettlementv2, bound to a specific deployed contract.
+func NewFastsettlementv2Caller(address common.Address, caller bind.ContractCaller) (*Fastsettlementv2Caller, error) {
+	contract, err := bindFastsettlementv2(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2Caller{contract: contract}, nil
+}
+
+// NewFastsettlementv2Transactor creates a new write-only instance of Fastsettlementv2, bound to a specific deployed contract.
+func NewFastsettlementv2Transactor(address common.Address, transactor bind.ContractTransactor) (*Fastsettlementv2Transactor, error) {
+	contract, err := bindFastsettlementv2(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2Transactor{contract: contract}, nil
+}
+
+// NewFastsettlementv2Filterer creates a new log filterer instance of Fastsettlementv2, bound to a specific deployed contract.
+func NewFastsettlementv2Filterer(address common.Address, filterer bind.ContractFilterer) (*Fastsettlementv2Filterer, error) {
+	contract, err := bindFastsettlementv2(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2Filterer{contract: contract}, nil
+}
+
+// bindFastsettlementv2 binds a generic wrapper to an already deployed contract.
+func bindFastsettlementv2(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Fastsettlementv2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Fastsettlementv2 *Fastsettlementv2Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Fastsettlementv2.Contract.Fastsettlementv2Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Fastsettlementv2 *Fastsettlementv2Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.Fastsettlementv2Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Fastsettlementv2 *Fastsettlementv2Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.Fastsettlementv2Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Fastsettlementv2 *Fastsettlementv2CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Fastsettlementv2.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Fastsettlementv2 *Fastsettlementv2TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Fastsettlementv2 *Fastsettlementv2TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.contract.Transact(opts, method, params...)
+}
+
+// BPSDENOMINATOR is a free data retrieval call binding the contract method 0xe1a45218.
+//
+// Solidity: function BPS_DENOMINATOR() view returns(uint256)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) BPSDENOMINATOR(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "BPS_DENOMINATOR")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BPSDENOMINATOR is a free data retrieval call binding the contract method 0xe1a45218.
+//
+// Solidity: function BPS_DENOMINATOR() view returns(uint256)
+func (_Fastsettlementv2 *Fastsettlementv2Session) BPSDENOMINATOR() (*big.Int, error) {
+	return _Fastsettlementv2.Contract.BPSDENOMINATOR(&_Fastsettlementv2.CallOpts)
+}
+
+// BPSDENOMINATOR is a free data retrieval call binding the contract method 0xe1a45218.
+//
+// Solidity: function BPS_DENOMINATOR() view returns(uint256)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) BPSDENOMINATOR() (*big.Int, error) {
+	return _Fastsettlementv2.Contract.BPSDENOMINATOR(&_Fastsettlementv2.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_Fastsettlementv2 *Fastsettlementv2Session) DOMAINSEPARATOR() ([32]byte, error) {
+	return _Fastsettlementv2.Contract.DOMAINSEPARATOR(&_Fastsettlementv2.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _Fastsettlementv2.Contract.DOMAINSEPARATOR(&_Fastsettlementv2.CallOpts)
+}
+
+// INTENTTYPEHASH is a free data retrieval call binding the contract method 0xb082a274.
+//
+// Solidity: function INTENT_TYPEHASH() view returns(bytes32)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) INTENTTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "INTENT_TYPEHASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// INTENTTYPEHASH is a free data retrieval call binding the contract method 0xb082a274.
+//
+// Solidity: function INTENT_TYPEHASH() view returns(bytes32)
+func (_Fastsettlementv2 *Fastsettlementv2Session) INTENTTYPEHASH() ([32]byte, error) {
+	return _Fastsettlementv2.Contract.INTENTTYPEHASH(&_Fastsettlementv2.CallOpts)
+}
+
+// INTENTTYPEHASH is a free data retrieval call binding the contract method 0xb082a274.
+//
+// Solidity: function INTENT_TYPEHASH() view returns(bytes32)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) INTENTTYPEHASH() ([32]byte, error) {
+	return _Fastsettlementv2.Contract.INTENTTYPEHASH(&_Fastsettlementv2.CallOpts)
+}
+
+// MAXNONCE is a free data retrieval call binding the contract method 0xb267f5d4.
+//
+// Solidity: function MAX_NONCE() view returns(uint256)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) MAXNONCE(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "MAX_NONCE")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXNONCE is a free data retrieval call binding the contract method 0xb267f5d4.
+//
+// Solidity: function MAX_NONCE() view returns(uint256)
+func (_Fastsettlementv2 *Fastsettlementv2Session) MAXNONCE() (*big.Int, error) {
+	return _Fastsettlementv2.Contract.MAXNONCE(&_Fastsettlementv2.CallOpts)
+}
+
+// MAXNONCE is a free data retrieval call binding the contract method 0xb267f5d4.
+//
+// Solidity: function MAX_NONCE() view returns(uint256)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) MAXNONCE() (*big.Int, error) {
+	return _Fastsettlementv2.Contract.MAXNONCE(&_Fastsettlementv2.CallOpts)
+}
+
+// NONCEDOMAIN is a free data retrieval call binding the contract method 0x4c848902.
+//
+// Solidity: function NONCE_DOMAIN() view returns(bytes32)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) NONCEDOMAIN(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "NONCE_DOMAIN")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// NONCEDOMAIN is a free data retrieval call binding the contract method 0x4c848902.
+//
+// Solidity: function NONCE_DOMAIN() view returns(bytes32)
+func (_Fastsettlementv2 *Fastsettlementv2Session) NONCEDOMAIN() ([32]byte, error) {
+	return _Fastsettlementv2.Contract.NONCEDOMAIN(&_Fastsettlementv2.CallOpts)
+}
+
+// NONCEDOMAIN is a free data retrieval call binding the contract method 0x4c848902.
+//
+// Solidity: function NONCE_DOMAIN() view returns(bytes32)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) NONCEDOMAIN() ([32]byte, error) {
+	return _Fastsettlementv2.Contract.NONCEDOMAIN(&_Fastsettlementv2.CallOpts)
+}
+
+// PERMIT2 is a free data retrieval call binding the contract method 0x6afdd850.
+//
+// Solidity: function PERMIT2() view returns(address)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) PERMIT2(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "PERMIT2")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PERMIT2 is a free data retrieval call binding the contract method 0x6afdd850.
+//
+// Solidity: function PERMIT2() view returns(address)
+func (_Fastsettlementv2 *Fastsettlementv2Session) PERMIT2() (common.Address, error) {
+	return _Fastsettlementv2.Contract.PERMIT2(&_Fastsettlementv2.CallOpts)
+}
+
+// PERMIT2 is a free data retrieval call binding the contract method 0x6afdd850.
+//
+// Solidity: function PERMIT2() view returns(address)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) PERMIT2() (common.Address, error) {
+	return _Fastsettlementv2.Contract.PERMIT2(&_Fastsettlementv2.CallOpts)
+}
+
+// WITNESSTYPESTRING is a free data retrieval call binding the contract method 0x156e2152.
+//
+// Solidity: function WITNESS_TYPE_STRING() view returns(string)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) WITNESSTYPESTRING(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "WITNESS_TYPE_STRING")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// WITNESSTYPESTRING is a free data retrieval call binding the contract method 0x156e2152.
+//
+// Solidity: function WITNESS_TYPE_STRING() view returns(string)
+func (_Fastsettlementv2 *Fastsettlementv2Session) WITNESSTYPESTRING() (string, error) {
+	return _Fastsettlementv2.Contract.WITNESSTYPESTRING(&_Fastsettlementv2.CallOpts)
+}
+
+// WITNESSTYPESTRING is a free data retrieval call binding the contract method 0x156e2152.
+//
+// Solidity: function WITNESS_TYPE_STRING() view returns(string)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) WITNESSTYPESTRING() (string, error) {
+	return _Fastsettlementv2.Contract.WITNESSTYPESTRING(&_Fastsettlementv2.CallOpts)
+}
+
+// GetIntentId is a free data retrieval call binding the contract method 0x3744f6e8.
+//
+// Solidity: function getIntentId((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent) view returns(bytes32)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) GetIntentId(opts *bind.CallOpts, intent IFastSettlementV2Intent) ([32]byte, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "getIntentId", intent)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetIntentId is a free data retrieval call binding the contract method 0x3744f6e8.
+//
+// Solidity: function getIntentId((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent) view returns(bytes32)
+func (_Fastsettlementv2 *Fastsettlementv2Session) GetIntentId(intent IFastSettlementV2Intent) ([32]byte, error) {
+	return _Fastsettlementv2.Contract.GetIntentId(&_Fastsettlementv2.CallOpts, intent)
+}
+
+// GetIntentId is a free data retrieval call binding the contract method 0x3744f6e8.
+//
+// Solidity: function getIntentId((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent) view returns(bytes32)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) GetIntentId(intent IFastSettlementV2Intent) ([32]byte, error) {
+	return _Fastsettlementv2.Contract.GetIntentId(&_Fastsettlementv2.CallOpts, intent)
+}
+
+// GetMinNonce is a free data retrieval call binding the contract method 0x896909dc.
+//
+// Solidity: function getMinNonce(address maker) view returns(uint256)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) GetMinNonce(opts *bind.CallOpts, maker common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "getMinNonce", maker)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinNonce is a free data retrieval call binding the contract method 0x896909dc.
+//
+// Solidity: function getMinNonce(address maker) view returns(uint256)
+func (_Fastsettlementv2 *Fastsettlementv2Session) GetMinNonce(maker common.Address) (*big.Int, error) {
+	return _Fastsettlementv2.Contract.GetMinNonce(&_Fastsettlementv2.CallOpts, maker)
+}
+
+// GetMinNonce is a free data retrieval call binding the contract method 0x896909dc.
+//
+// Solidity: function getMinNonce(address maker) view returns(uint256)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) GetMinNonce(maker common.Address) (*big.Int, error) {
+	return _Fastsettlementv2.Contract.GetMinNonce(&_Fastsettlementv2.CallOpts, maker)
+}
+
+// GetSurplusRecipient is a free data retrieval call binding the contract method 0x217a16a5.
+//
+// Solidity: function getSurplusRecipient() view returns(address)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) GetSurplusRecipient(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "getSurplusRecipient")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetSurplusRecipient is a free data retrieval call binding the contract method 0x217a16a5.
+//
+// Solidity: function getSurplusRecipient() view returns(address)
+func (_Fastsettlementv2 *Fastsettlementv2Session) GetSurplusRecipient() (common.Address, error) {
+	return _Fastsettlementv2.Contract.GetSurplusRecipient(&_Fastsettlementv2.CallOpts)
+}
+
+// GetSurplusRecipient is a free data retrieval call binding the contract method 0x217a16a5.
+//
+// Solidity: function getSurplusRecipient() view returns(address)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) GetSurplusRecipient() (common.Address, error) {
+	return _Fastsettlementv2.Contract.GetSurplusRecipient(&_Fastsettlementv2.CallOpts)
+}
+
+// IsExecutorAllowed is a free data retrieval call binding the contract method 0x7d701102.
+//
+// Solidity: function isExecutorAllowed(address executor) view returns(bool)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) IsExecutorAllowed(opts *bind.CallOpts, executor common.Address) (bool, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "isExecutorAllowed", executor)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsExecutorAllowed is a free data retrieval call binding the contract method 0x7d701102.
+//
+// Solidity: function isExecutorAllowed(address executor) view returns(bool)
+func (_Fastsettlementv2 *Fastsettlementv2Session) IsExecutorAllowed(executor common.Address) (bool, error) {
+	return _Fastsettlementv2.Contract.IsExecutorAllowed(&_Fastsettlementv2.CallOpts, executor)
+}
+
+// IsExecutorAllowed is a free data retrieval call binding the contract method 0x7d701102.
+//
+// Solidity: function isExecutorAllowed(address executor) view returns(bool)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) IsExecutorAllowed(executor common.Address) (bool, error) {
+	return _Fastsettlementv2.Contract.IsExecutorAllowed(&_Fastsettlementv2.CallOpts, executor)
+}
+
+// IsExecutorWhitelistActive is a free data retrieval call binding the contract method 0x7972c760.
+//
+// Solidity: function isExecutorWhitelistActive() view returns(bool)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) IsExecutorWhitelistActive(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "isExecutorWhitelistActive")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsExecutorWhitelistActive is a free data retrieval call binding the contract method 0x7972c760.
+//
+// Solidity: function isExecutorWhitelistActive() view returns(bool)
+func (_Fastsettlementv2 *Fastsettlementv2Session) IsExecutorWhitelistActive() (bool, error) {
+	return _Fastsettlementv2.Contract.IsExecutorWhitelistActive(&_Fastsettlementv2.CallOpts)
+}
+
+// IsExecutorWhitelistActive is a free data retrieval call binding the contract method 0x7972c760.
+//
+// Solidity: function isExecutorWhitelistActive() view returns(bool)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) IsExecutorWhitelistActive() (bool, error) {
+	return _Fastsettlementv2.Contract.IsExecutorWhitelistActive(&_Fastsettlementv2.CallOpts)
+}
+
+// IsNonceUsed is a free data retrieval call binding the contract method 0xcab7e8eb.
+//
+// Solidity: function isNonceUsed(address maker, uint256 nonce) view returns(bool)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) IsNonceUsed(opts *bind.CallOpts, maker common.Address, nonce *big.Int) (bool, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "isNonceUsed", maker, nonce)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsNonceUsed is a free data retrieval call binding the contract method 0xcab7e8eb.
+//
+// Solidity: function isNonceUsed(address maker, uint256 nonce) view returns(bool)
+func (_Fastsettlementv2 *Fastsettlementv2Session) IsNonceUsed(maker common.Address, nonce *big.Int) (bool, error) {
+	return _Fastsettlementv2.Contract.IsNonceUsed(&_Fastsettlementv2.CallOpts, maker, nonce)
+}
+
+// IsNonceUsed is a free data retrieval call binding the contract method 0xcab7e8eb.
+//
+// Solidity: function isNonceUsed(address maker, uint256 nonce) view returns(bool)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) IsNonceUsed(maker common.Address, nonce *big.Int) (bool, error) {
+	return _Fastsettlementv2.Contract.IsNonceUsed(&_Fastsettlementv2.CallOpts, maker, nonce)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Fastsettlementv2 *Fastsettlementv2Session) Owner() (common.Address, error) {
+	return _Fastsettlementv2.Contract.Owner(&_Fastsettlementv2.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) Owner() (common.Address, error) {
+	return _Fastsettlementv2.Contract.Owner(&_Fastsettlementv2.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_Fastsettlementv2 *Fastsettlementv2Session) Paused() (bool, error) {
+	return _Fastsettlementv2.Contract.Paused(&_Fastsettlementv2.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) Paused() (bool, error) {
+	return _Fastsettlementv2.Contract.Paused(&_Fastsettlementv2.CallOpts)
+}
+
+// PendingOwner is a free data retrieval call binding the contract method 0xe30c3978.
+//
+// Solidity: function pendingOwner() view returns(address)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) PendingOwner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "pendingOwner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PendingOwner is a free data retrieval call binding the contract method 0xe30c3978.
+//
+// Solidity: function pendingOwner() view returns(address)
+func (_Fastsettlementv2 *Fastsettlementv2Session) PendingOwner() (common.Address, error) {
+	return _Fastsettlementv2.Contract.PendingOwner(&_Fastsettlementv2.CallOpts)
+}
+
+// PendingOwner is a free data retrieval call binding the contract method 0xe30c3978.
+//
+// Solidity: function pendingOwner() view returns(address)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) PendingOwner() (common.Address, error) {
+	return _Fastsettlementv2.Contract.PendingOwner(&_Fastsettlementv2.CallOpts)
+}
+
+// Validate is a free data retrieval call binding the contract method 0x5893a2ec.
+//
+// Solidity: function validate((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes signature) view returns(bool isValid, string reason)
+func (_Fastsettlementv2 *Fastsettlementv2Caller) Validate(opts *bind.CallOpts, intent IFastSettlementV2Intent, signature []byte) (struct {
+	IsValid bool
+	Reason  string
+}, error) {
+	var out []interface{}
+	err := _Fastsettlementv2.contract.Call(opts, &out, "validate", intent, signature)
+
+	outstruct := new(struct {
+		IsValid bool
+		Reason  string
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.IsValid = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Reason = *abi.ConvertType(out[1], new(string)).(*string)
+
+	return *outstruct, err
+
+}
+
+// Validate is a free data retrieval call binding the contract method 0x5893a2ec.
+//
+// Solidity: function validate((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes signature) view returns(bool isValid, string reason)
+func (_Fastsettlementv2 *Fastsettlementv2Session) Validate(intent IFastSettlementV2Intent, signature []byte) (struct {
+	IsValid bool
+	Reason  string
+}, error) {
+	return _Fastsettlementv2.Contract.Validate(&_Fastsettlementv2.CallOpts, intent, signature)
+}
+
+// Validate is a free data retrieval call binding the contract method 0x5893a2ec.
+//
+// Solidity: function validate((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes signature) view returns(bool isValid, string reason)
+func (_Fastsettlementv2 *Fastsettlementv2CallerSession) Validate(intent IFastSettlementV2Intent, signature []byte) (struct {
+	IsValid bool
+	Reason  string
+}, error) {
+	return _Fastsettlementv2.Contract.Validate(&_Fastsettlementv2.CallOpts, intent, signature)
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_Fastsettlementv2 *Fastsettlementv2Transactor) AcceptOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Fastsettlementv2.contract.Transact(opts, "acceptOwnership")
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_Fastsettlementv2 *Fastsettlementv2Session) AcceptOwnership() (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.AcceptOwnership(&_Fastsettlementv2.TransactOpts)
+}
+
+// AcceptOwnership is a paid mutator transaction binding the contract method 0x79ba5097.
+//
+// Solidity: function acceptOwnership() returns()
+func (_Fastsettlementv2 *Fastsettlementv2TransactorSession) AcceptOwnership() (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.AcceptOwnership(&_Fastsettlementv2.TransactOpts)
+}
+
+// InvalidateNoncesUpTo is a paid mutator transaction binding the contract method 0x070f0449.
+//
+// Solidity: function invalidateNoncesUpTo(uint256 newMinNonce) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Transactor) InvalidateNoncesUpTo(opts *bind.TransactOpts, newMinNonce *big.Int) (*types.Transaction, error) {
+	return _Fastsettlementv2.contract.Transact(opts, "invalidateNoncesUpTo", newMinNonce)
+}
+
+// InvalidateNoncesUpTo is a paid mutator transaction binding the contract method 0x070f0449.
+//
+// Solidity: function invalidateNoncesUpTo(uint256 newMinNonce) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Session) InvalidateNoncesUpTo(newMinNonce *big.Int) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.InvalidateNoncesUpTo(&_Fastsettlementv2.TransactOpts, newMinNonce)
+}
+
+// InvalidateNoncesUpTo is a paid mutator transaction binding the contract method 0x070f0449.
+//
+// Solidity: function invalidateNoncesUpTo(uint256 newMinNonce) returns()
+func (_Fastsettlementv2 *Fastsettlementv2TransactorSession) InvalidateNoncesUpTo(newMinNonce *big.Int) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.InvalidateNoncesUpTo(&_Fastsettlementv2.TransactOpts, newMinNonce)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_Fastsettlementv2 *Fastsettlementv2Transactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Fastsettlementv2.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_Fastsettlementv2 *Fastsettlementv2Session) Pause() (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.Pause(&_Fastsettlementv2.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_Fastsettlementv2 *Fastsettlementv2TransactorSession) Pause() (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.Pause(&_Fastsettlementv2.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Fastsettlementv2 *Fastsettlementv2Transactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Fastsettlementv2.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Fastsettlementv2 *Fastsettlementv2Session) RenounceOwnership() (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.RenounceOwnership(&_Fastsettlementv2.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Fastsettlementv2 *Fastsettlementv2TransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.RenounceOwnership(&_Fastsettlementv2.TransactOpts)
+}
+
+// RescueTokens is a paid mutator transaction binding the contract method 0xcea9d26f.
+//
+// Solidity: function rescueTokens(address token, address to, uint256 amount) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Transactor) RescueTokens(opts *bind.TransactOpts, token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Fastsettlementv2.contract.Transact(opts, "rescueTokens", token, to, amount)
+}
+
+// RescueTokens is a paid mutator transaction binding the contract method 0xcea9d26f.
+//
+// Solidity: function rescueTokens(address token, address to, uint256 amount) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Session) RescueTokens(token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.RescueTokens(&_Fastsettlementv2.TransactOpts, token, to, amount)
+}
+
+// RescueTokens is a paid mutator transaction binding the contract method 0xcea9d26f.
+//
+// Solidity: function rescueTokens(address token, address to, uint256 amount) returns()
+func (_Fastsettlementv2 *Fastsettlementv2TransactorSession) RescueTokens(token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.RescueTokens(&_Fastsettlementv2.TransactOpts, token, to, amount)
+}
+
+// SetExecutor is a paid mutator transaction binding the contract method 0x1e1bff3f.
+//
+// Solidity: function setExecutor(address executor, bool allowed) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Transactor) SetExecutor(opts *bind.TransactOpts, executor common.Address, allowed bool) (*types.Transaction, error) {
+	return _Fastsettlementv2.contract.Transact(opts, "setExecutor", executor, allowed)
+}
+
+// SetExecutor is a paid mutator transaction binding the contract method 0x1e1bff3f.
+//
+// Solidity: function setExecutor(address executor, bool allowed) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Session) SetExecutor(executor common.Address, allowed bool) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.SetExecutor(&_Fastsettlementv2.TransactOpts, executor, allowed)
+}
+
+// SetExecutor is a paid mutator transaction binding the contract method 0x1e1bff3f.
+//
+// Solidity: function setExecutor(address executor, bool allowed) returns()
+func (_Fastsettlementv2 *Fastsettlementv2TransactorSession) SetExecutor(executor common.Address, allowed bool) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.SetExecutor(&_Fastsettlementv2.TransactOpts, executor, allowed)
+}
+
+// SetExecutorWhitelistActive is a paid mutator transaction binding the contract method 0xc61d5949.
+//
+// Solidity: function setExecutorWhitelistActive(bool active) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Transactor) SetExecutorWhitelistActive(opts *bind.TransactOpts, active bool) (*types.Transaction, error) {
+	return _Fastsettlementv2.contract.Transact(opts, "setExecutorWhitelistActive", active)
+}
+
+// SetExecutorWhitelistActive is a paid mutator transaction binding the contract method 0xc61d5949.
+//
+// Solidity: function setExecutorWhitelistActive(bool active) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Session) SetExecutorWhitelistActive(active bool) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.SetExecutorWhitelistActive(&_Fastsettlementv2.TransactOpts, active)
+}
+
+// SetExecutorWhitelistActive is a paid mutator transaction binding the contract method 0xc61d5949.
+//
+// Solidity: function setExecutorWhitelistActive(bool active) returns()
+func (_Fastsettlementv2 *Fastsettlementv2TransactorSession) SetExecutorWhitelistActive(active bool) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.SetExecutorWhitelistActive(&_Fastsettlementv2.TransactOpts, active)
+}
+
+// SetSurplusRecipient is a paid mutator transaction binding the contract method 0x20307981.
+//
+// Solidity: function setSurplusRecipient(address recipient) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Transactor) SetSurplusRecipient(opts *bind.TransactOpts, recipient common.Address) (*types.Transaction, error) {
+	return _Fastsettlementv2.contract.Transact(opts, "setSurplusRecipient", recipient)
+}
+
+// SetSurplusRecipient is a paid mutator transaction binding the contract method 0x20307981.
+//
+// Solidity: function setSurplusRecipient(address recipient) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Session) SetSurplusRecipient(recipient common.Address) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.SetSurplusRecipient(&_Fastsettlementv2.TransactOpts, recipient)
+}
+
+// SetSurplusRecipient is a paid mutator transaction binding the contract method 0x20307981.
+//
+// Solidity: function setSurplusRecipient(address recipient) returns()
+func (_Fastsettlementv2 *Fastsettlementv2TransactorSession) SetSurplusRecipient(recipient common.Address) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.SetSurplusRecipient(&_Fastsettlementv2.TransactOpts, recipient)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x0fd3d95a.
+//
+// Solidity: function settle((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes signature, bytes executorData) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Transactor) Settle(opts *bind.TransactOpts, intent IFastSettlementV2Intent, signature []byte, executorData []byte) (*types.Transaction, error) {
+	return _Fastsettlementv2.contract.Transact(opts, "settle", intent, signature, executorData)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x0fd3d95a.
+//
+// Solidity: function settle((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes signature, bytes executorData) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Session) Settle(intent IFastSettlementV2Intent, signature []byte, executorData []byte) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.Settle(&_Fastsettlementv2.TransactOpts, intent, signature, executorData)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x0fd3d95a.
+//
+// Solidity: function settle((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes signature, bytes executorData) returns()
+func (_Fastsettlementv2 *Fastsettlementv2TransactorSession) Settle(intent IFastSettlementV2Intent, signature []byte, executorData []byte) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.Settle(&_Fastsettlementv2.TransactOpts, intent, signature, executorData)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Transactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Fastsettlementv2.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Fastsettlementv2 *Fastsettlementv2Session) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.TransferOwnership(&_Fastsettlementv2.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Fastsettlementv2 *Fastsettlementv2TransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.TransferOwnership(&_Fastsettlementv2.TransactOpts, newOwner)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_Fastsettlementv2 *Fastsettlementv2Transactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Fastsettlementv2.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_Fastsettlementv2 *Fastsettlementv2Session) Unpause() (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.Unpause(&_Fastsettlementv2.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_Fastsettlementv2 *Fastsettlementv2TransactorSession) Unpause() (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.Unpause(&_Fastsettlementv2.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Fastsettlementv2 *Fastsettlementv2Transactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Fastsettlementv2.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Fastsettlementv2 *Fastsettlementv2Session) Receive() (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.Receive(&_Fastsettlementv2.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Fastsettlementv2 *Fastsettlementv2TransactorSession) Receive() (*types.Transaction, error) {
+	return _Fastsettlementv2.Contract.Receive(&_Fastsettlementv2.TransactOpts)
+}
+
+// Fastsettlementv2ExecutorUpdatedIterator is returned from FilterExecutorUpdated and is used to iterate over the raw logs and unpacked data for ExecutorUpdated events raised by the Fastsettlementv2 contract.
+type Fastsettlementv2ExecutorUpdatedIterator struct {
+	Event *Fastsettlementv2ExecutorUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Fastsettlementv2ExecutorUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Fastsettlementv2ExecutorUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Fastsettlementv2ExecutorUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Fastsettlementv2ExecutorUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Fastsettlementv2ExecutorUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Fastsettlementv2ExecutorUpdated represents a ExecutorUpdated event raised by the Fastsettlementv2 contract.
+type Fastsettlementv2ExecutorUpdated struct {
+	Executor common.Address
+	Allowed  bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecutorUpdated is a free log retrieval operation binding the contract event 0x9fdbc2d48b8a0db2f62663bf9312ad02f5b1f6414ad600b55a247d09aeec3ea2.
+//
+// Solidity: event ExecutorUpdated(address indexed executor, bool allowed)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) FilterExecutorUpdated(opts *bind.FilterOpts, executor []common.Address) (*Fastsettlementv2ExecutorUpdatedIterator, error) {
+
+	var executorRule []interface{}
+	for _, executorItem := range executor {
+		executorRule = append(executorRule, executorItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.FilterLogs(opts, "ExecutorUpdated", executorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2ExecutorUpdatedIterator{contract: _Fastsettlementv2.contract, event: "ExecutorUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchExecutorUpdated is a free log subscription operation binding the contract event 0x9fdbc2d48b8a0db2f62663bf9312ad02f5b1f6414ad600b55a247d09aeec3ea2.
+//
+// Solidity: event ExecutorUpdated(address indexed executor, bool allowed)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) WatchExecutorUpdated(opts *bind.WatchOpts, sink chan<- *Fastsettlementv2ExecutorUpdated, executor []common.Address) (event.Subscription, error) {
+
+	var executorRule []interface{}
+	for _, executorItem := range executor {
+		executorRule = append(executorRule, executorItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.WatchLogs(opts, "ExecutorUpdated", executorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Fastsettlementv2ExecutorUpdated)
+				if err := _Fastsettlementv2.contract.UnpackLog(event, "ExecutorUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecutorUpdated is a log parse operation binding the contract event 0x9fdbc2d48b8a0db2f62663bf9312ad02f5b1f6414ad600b55a247d09aeec3ea2.
+//
+// Solidity: event ExecutorUpdated(address indexed executor, bool allowed)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) ParseExecutorUpdated(log types.Log) (*Fastsettlementv2ExecutorUpdated, error) {
+	event := new(Fastsettlementv2ExecutorUpdated)
+	if err := _Fastsettlementv2.contract.UnpackLog(event, "ExecutorUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Fastsettlementv2ExecutorWhitelistActiveUpdatedIterator is returned from FilterExecutorWhitelistActiveUpdated and is used to iterate over the raw logs and unpacked data for ExecutorWhitelistActiveUpdated events raised by the Fastsettlementv2 contract.
+type Fastsettlementv2ExecutorWhitelistActiveUpdatedIterator struct {
+	Event *Fastsettlementv2ExecutorWhitelistActiveUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Fastsettlementv2ExecutorWhitelistActiveUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Fastsettlementv2ExecutorWhitelistActiveUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Fastsettlementv2ExecutorWhitelistActiveUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Fastsettlementv2ExecutorWhitelistActiveUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Fastsettlementv2ExecutorWhitelistActiveUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Fastsettlementv2ExecutorWhitelistActiveUpdated represents a ExecutorWhitelistActiveUpdated event raised by the Fastsettlementv2 contract.
+type Fastsettlementv2ExecutorWhitelistActiveUpdated struct {
+	Active bool
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecutorWhitelistActiveUpdated is a free log retrieval operation binding the contract event 0x1a11753f52e5433c57c61ab1c615bbb5bd9212388a5b464cfc4149a1b5065eef.
+//
+// Solidity: event ExecutorWhitelistActiveUpdated(bool active)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) FilterExecutorWhitelistActiveUpdated(opts *bind.FilterOpts) (*Fastsettlementv2ExecutorWhitelistActiveUpdatedIterator, error) {
+
+	logs, sub, err := _Fastsettlementv2.contract.FilterLogs(opts, "ExecutorWhitelistActiveUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2ExecutorWhitelistActiveUpdatedIterator{contract: _Fastsettlementv2.contract, event: "ExecutorWhitelistActiveUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchExecutorWhitelistActiveUpdated is a free log subscription operation binding the contract event 0x1a11753f52e5433c57c61ab1c615bbb5bd9212388a5b464cfc4149a1b5065eef.
+//
+// Solidity: event ExecutorWhitelistActiveUpdated(bool active)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) WatchExecutorWhitelistActiveUpdated(opts *bind.WatchOpts, sink chan<- *Fastsettlementv2ExecutorWhitelistActiveUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _Fastsettlementv2.contract.WatchLogs(opts, "ExecutorWhitelistActiveUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Fastsettlementv2ExecutorWhitelistActiveUpdated)
+				if err := _Fastsettlementv2.contract.UnpackLog(event, "ExecutorWhitelistActiveUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecutorWhitelistActiveUpdated is a log parse operation binding the contract event 0x1a11753f52e5433c57c61ab1c615bbb5bd9212388a5b464cfc4149a1b5065eef.
+//
+// Solidity: event ExecutorWhitelistActiveUpdated(bool active)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) ParseExecutorWhitelistActiveUpdated(log types.Log) (*Fastsettlementv2ExecutorWhitelistActiveUpdated, error) {
+	event := new(Fastsettlementv2ExecutorWhitelistActiveUpdated)
+	if err := _Fastsettlementv2.contract.UnpackLog(event, "ExecutorWhitelistActiveUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Fastsettlementv2IntentSettledIterator is returned from FilterIntentSettled and is used to iterate over the raw logs and unpacked data for IntentSettled events raised by the Fastsettlementv2 contract.
+type Fastsettlementv2IntentSettledIterator struct {
+	Event *Fastsettlementv2IntentSettled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Fastsettlementv2IntentSettledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Fastsettlementv2IntentSettled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Fastsettlementv2IntentSettled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Fastsettlementv2IntentSettledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Fastsettlementv2IntentSettledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Fastsettlementv2IntentSettled represents a IntentSettled event raised by the Fastsettlementv2 contract.
+type Fastsettlementv2IntentSettled struct {
+	IntentId    [32]byte
+	Maker       common.Address
+	TokenIn     common.Address
+	TokenOut    common.Address
+	AmountIn    *big.Int
+	AmountOut   *big.Int
+	ProtocolAmt *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterIntentSettled is a free log retrieval operation binding the contract event 0x7218be4da585fc2a7ede6968130b808389cc9481f765af39814696f7e7edd287.
+//
+// Solidity: event IntentSettled(bytes32 indexed intentId, address indexed maker, address indexed tokenIn, address tokenOut, uint256 amountIn, uint256 amountOut, uint256 protocolAmt)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) FilterIntentSettled(opts *bind.FilterOpts, intentId [][32]byte, maker []common.Address, tokenIn []common.Address) (*Fastsettlementv2IntentSettledIterator, error) {
+
+	var intentIdRule []interface{}
+	for _, intentIdItem := range intentId {
+		intentIdRule = append(intentIdRule, intentIdItem)
+	}
+	var makerRule []interface{}
+	for _, makerItem := range maker {
+		makerRule = append(makerRule, makerItem)
+	}
+	var tokenInRule []interface{}
+	for _, tokenInItem := range tokenIn {
+		tokenInRule = append(tokenInRule, tokenInItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.FilterLogs(opts, "IntentSettled", intentIdRule, makerRule, tokenInRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2IntentSettledIterator{contract: _Fastsettlementv2.contract, event: "IntentSettled", logs: logs, sub: sub}, nil
+}
+
+// WatchIntentSettled is a free log subscription operation binding the contract event 0x7218be4da585fc2a7ede6968130b808389cc9481f765af39814696f7e7edd287.
+//
+// Solidity: event IntentSettled(bytes32 indexed intentId, address indexed maker, address indexed tokenIn, address tokenOut, uint256 amountIn, uint256 amountOut, uint256 protocolAmt)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) WatchIntentSettled(opts *bind.WatchOpts, sink chan<- *Fastsettlementv2IntentSettled, intentId [][32]byte, maker []common.Address, tokenIn []common.Address) (event.Subscription, error) {
+
+	var intentIdRule []interface{}
+	for _, intentIdItem := range intentId {
+		intentIdRule = append(intentIdRule, intentIdItem)
+	}
+	var makerRule []interface{}
+	for _, makerItem := range maker {
+		makerRule = append(makerRule, makerItem)
+	}
+	var tokenInRule []interface{}
+	for _, tokenInItem := range tokenIn {
+		tokenInRule = append(tokenInRule, tokenInItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.WatchLogs(opts, "IntentSettled", intentIdRule, makerRule, tokenInRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Fastsettlementv2IntentSettled)
+				if err := _Fastsettlementv2.contract.UnpackLog(event, "IntentSettled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseIntentSettled is a log parse operation binding the contract event 0x7218be4da585fc2a7ede6968130b808389cc9481f765af39814696f7e7edd287.
+//
+// Solidity: event IntentSettled(bytes32 indexed intentId, address indexed maker, address indexed tokenIn, address tokenOut, uint256 amountIn, uint256 amountOut, uint256 protocolAmt)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) ParseIntentSettled(log types.Log) (*Fastsettlementv2IntentSettled, error) {
+	event := new(Fastsettlementv2IntentSettled)
+	if err := _Fastsettlementv2.contract.UnpackLog(event, "IntentSettled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Fastsettlementv2NonceInvalidatedIterator is returned from FilterNonceInvalidated and is used to iterate over the raw logs and unpacked data for NonceInvalidated events raised by the Fastsettlementv2 contract.
+type Fastsettlementv2NonceInvalidatedIterator struct {
+	Event *Fastsettlementv2NonceInvalidated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Fastsettlementv2NonceInvalidatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Fastsettlementv2NonceInvalidated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Fastsettlementv2NonceInvalidated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Fastsettlementv2NonceInvalidatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Fastsettlementv2NonceInvalidatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Fastsettlementv2NonceInvalidated represents a NonceInvalidated event raised by the Fastsettlementv2 contract.
+type Fastsettlementv2NonceInvalidated struct {
+	Maker       common.Address
+	NewMinNonce *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterNonceInvalidated is a free log retrieval operation binding the contract event 0x1800cd2301fbc20790ed94f3d55a28ef2306a9c31cd3c72b5b71b6e4cf5c6241.
+//
+// Solidity: event NonceInvalidated(address indexed maker, uint256 newMinNonce)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) FilterNonceInvalidated(opts *bind.FilterOpts, maker []common.Address) (*Fastsettlementv2NonceInvalidatedIterator, error) {
+
+	var makerRule []interface{}
+	for _, makerItem := range maker {
+		makerRule = append(makerRule, makerItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.FilterLogs(opts, "NonceInvalidated", makerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2NonceInvalidatedIterator{contract: _Fastsettlementv2.contract, event: "NonceInvalidated", logs: logs, sub: sub}, nil
+}
+
+// WatchNonceInvalidated is a free log subscription operation binding the contract event 0x1800cd2301fbc20790ed94f3d55a28ef2306a9c31cd3c72b5b71b6e4cf5c6241.
+//
+// Solidity: event NonceInvalidated(address indexed maker, uint256 newMinNonce)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) WatchNonceInvalidated(opts *bind.WatchOpts, sink chan<- *Fastsettlementv2NonceInvalidated, maker []common.Address) (event.Subscription, error) {
+
+	var makerRule []interface{}
+	for _, makerItem := range maker {
+		makerRule = append(makerRule, makerItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.WatchLogs(opts, "NonceInvalidated", makerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Fastsettlementv2NonceInvalidated)
+				if err := _Fastsettlementv2.contract.UnpackLog(event, "NonceInvalidated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNonceInvalidated is a log parse operation binding the contract event 0x1800cd2301fbc20790ed94f3d55a28ef2306a9c31cd3c72b5b71b6e4cf5c6241.
+//
+// Solidity: event NonceInvalidated(address indexed maker, uint256 newMinNonce)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) ParseNonceInvalidated(log types.Log) (*Fastsettlementv2NonceInvalidated, error) {
+	event := new(Fastsettlementv2NonceInvalidated)
+	if err := _Fastsettlementv2.contract.UnpackLog(event, "NonceInvalidated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Fastsettlementv2OwnershipTransferStartedIterator is returned from FilterOwnershipTransferStarted and is used to iterate over the raw logs and unpacked data for OwnershipTransferStarted events raised by the Fastsettlementv2 contract.
+type Fastsettlementv2OwnershipTransferStartedIterator struct {
+	Event *Fastsettlementv2OwnershipTransferStarted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Fastsettlementv2OwnershipTransferStartedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Fastsettlementv2OwnershipTransferStarted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Fastsettlementv2OwnershipTransferStarted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Fastsettlementv2OwnershipTransferStartedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Fastsettlementv2OwnershipTransferStartedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Fastsettlementv2OwnershipTransferStarted represents a OwnershipTransferStarted event raised by the Fastsettlementv2 contract.
+type Fastsettlementv2OwnershipTransferStarted struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferStarted is a free log retrieval operation binding the contract event 0x38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e22700.
+//
+// Solidity: event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) FilterOwnershipTransferStarted(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*Fastsettlementv2OwnershipTransferStartedIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.FilterLogs(opts, "OwnershipTransferStarted", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2OwnershipTransferStartedIterator{contract: _Fastsettlementv2.contract, event: "OwnershipTransferStarted", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferStarted is a free log subscription operation binding the contract event 0x38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e22700.
+//
+// Solidity: event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) WatchOwnershipTransferStarted(opts *bind.WatchOpts, sink chan<- *Fastsettlementv2OwnershipTransferStarted, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.WatchLogs(opts, "OwnershipTransferStarted", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Fastsettlementv2OwnershipTransferStarted)
+				if err := _Fastsettlementv2.contract.UnpackLog(event, "OwnershipTransferStarted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferStarted is a log parse operation binding the contract event 0x38d16b8cac22d99fc7c124b9cd0de2d3fa1faef420bfe791d8c362d765e22700.
+//
+// Solidity: event OwnershipTransferStarted(address indexed previousOwner, address indexed newOwner)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) ParseOwnershipTransferStarted(log types.Log) (*Fastsettlementv2OwnershipTransferStarted, error) {
+	event := new(Fastsettlementv2OwnershipTransferStarted)
+	if err := _Fastsettlementv2.contract.UnpackLog(event, "OwnershipTransferStarted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Fastsettlementv2OwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Fastsettlementv2 contract.
+type Fastsettlementv2OwnershipTransferredIterator struct {
+	Event *Fastsettlementv2OwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Fastsettlementv2OwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Fastsettlementv2OwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Fastsettlementv2OwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Fastsettlementv2OwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Fastsettlementv2OwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Fastsettlementv2OwnershipTransferred represents a OwnershipTransferred event raised by the Fastsettlementv2 contract.
+type Fastsettlementv2OwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*Fastsettlementv2OwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2OwnershipTransferredIterator{contract: _Fastsettlementv2.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *Fastsettlementv2OwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Fastsettlementv2OwnershipTransferred)
+				if err := _Fastsettlementv2.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) ParseOwnershipTransferred(log types.Log) (*Fastsettlementv2OwnershipTransferred, error) {
+	event := new(Fastsettlementv2OwnershipTransferred)
+	if err := _Fastsettlementv2.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Fastsettlementv2PausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the Fastsettlementv2 contract.
+type Fastsettlementv2PausedIterator struct {
+	Event *Fastsettlementv2Paused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Fastsettlementv2PausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Fastsettlementv2Paused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Fastsettlementv2Paused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Fastsettlementv2PausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Fastsettlementv2PausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Fastsettlementv2Paused represents a Paused event raised by the Fastsettlementv2 contract.
+type Fastsettlementv2Paused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) FilterPaused(opts *bind.FilterOpts) (*Fastsettlementv2PausedIterator, error) {
+
+	logs, sub, err := _Fastsettlementv2.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2PausedIterator{contract: _Fastsettlementv2.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *Fastsettlementv2Paused) (event.Subscription, error) {
+
+	logs, sub, err := _Fastsettlementv2.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Fastsettlementv2Paused)
+				if err := _Fastsettlementv2.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) ParsePaused(log types.Log) (*Fastsettlementv2Paused, error) {
+	event := new(Fastsettlementv2Paused)
+	if err := _Fastsettlementv2.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Fastsettlementv2SurplusRecipientUpdatedIterator is returned from FilterSurplusRecipientUpdated and is used to iterate over the raw logs and unpacked data for SurplusRecipientUpdated events raised by the Fastsettlementv2 contract.
+type Fastsettlementv2SurplusRecipientUpdatedIterator struct {
+	Event *Fastsettlementv2SurplusRecipientUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Fastsettlementv2SurplusRecipientUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Fastsettlementv2SurplusRecipientUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Fastsettlementv2SurplusRecipientUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Fastsettlementv2SurplusRecipientUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Fastsettlementv2SurplusRecipientUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Fastsettlementv2SurplusRecipientUpdated represents a SurplusRecipientUpdated event raised by the Fastsettlementv2 contract.
+type Fastsettlementv2SurplusRecipientUpdated struct {
+	OldRecipient common.Address
+	NewRecipient common.Address
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterSurplusRecipientUpdated is a free log retrieval operation binding the contract event 0x1245c11d665b8a1b73c2e2fffc5df25470dd23a912092165bb6de7406d50193e.
+//
+// Solidity: event SurplusRecipientUpdated(address indexed oldRecipient, address indexed newRecipient)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) FilterSurplusRecipientUpdated(opts *bind.FilterOpts, oldRecipient []common.Address, newRecipient []common.Address) (*Fastsettlementv2SurplusRecipientUpdatedIterator, error) {
+
+	var oldRecipientRule []interface{}
+	for _, oldRecipientItem := range oldRecipient {
+		oldRecipientRule = append(oldRecipientRule, oldRecipientItem)
+	}
+	var newRecipientRule []interface{}
+	for _, newRecipientItem := range newRecipient {
+		newRecipientRule = append(newRecipientRule, newRecipientItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.FilterLogs(opts, "SurplusRecipientUpdated", oldRecipientRule, newRecipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2SurplusRecipientUpdatedIterator{contract: _Fastsettlementv2.contract, event: "SurplusRecipientUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchSurplusRecipientUpdated is a free log subscription operation binding the contract event 0x1245c11d665b8a1b73c2e2fffc5df25470dd23a912092165bb6de7406d50193e.
+//
+// Solidity: event SurplusRecipientUpdated(address indexed oldRecipient, address indexed newRecipient)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) WatchSurplusRecipientUpdated(opts *bind.WatchOpts, sink chan<- *Fastsettlementv2SurplusRecipientUpdated, oldRecipient []common.Address, newRecipient []common.Address) (event.Subscription, error) {
+
+	var oldRecipientRule []interface{}
+	for _, oldRecipientItem := range oldRecipient {
+		oldRecipientRule = append(oldRecipientRule, oldRecipientItem)
+	}
+	var newRecipientRule []interface{}
+	for _, newRecipientItem := range newRecipient {
+		newRecipientRule = append(newRecipientRule, newRecipientItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.WatchLogs(opts, "SurplusRecipientUpdated", oldRecipientRule, newRecipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Fastsettlementv2SurplusRecipientUpdated)
+				if err := _Fastsettlementv2.contract.UnpackLog(event, "SurplusRecipientUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSurplusRecipientUpdated is a log parse operation binding the contract event 0x1245c11d665b8a1b73c2e2fffc5df25470dd23a912092165bb6de7406d50193e.
+//
+// Solidity: event SurplusRecipientUpdated(address indexed oldRecipient, address indexed newRecipient)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) ParseSurplusRecipientUpdated(log types.Log) (*Fastsettlementv2SurplusRecipientUpdated, error) {
+	event := new(Fastsettlementv2SurplusRecipientUpdated)
+	if err := _Fastsettlementv2.contract.UnpackLog(event, "SurplusRecipientUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Fastsettlementv2TokensRescuedIterator is returned from FilterTokensRescued and is used to iterate over the raw logs and unpacked data for TokensRescued events raised by the Fastsettlementv2 contract.
+type Fastsettlementv2TokensRescuedIterator struct {
+	Event *Fastsettlementv2TokensRescued // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Fastsettlementv2TokensRescuedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Fastsettlementv2TokensRescued)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Fastsettlementv2TokensRescued)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Fastsettlementv2TokensRescuedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Fastsettlementv2TokensRescuedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Fastsettlementv2TokensRescued represents a TokensRescued event raised by the Fastsettlementv2 contract.
+type Fastsettlementv2TokensRescued struct {
+	Token  common.Address
+	To     common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokensRescued is a free log retrieval operation binding the contract event 0x77023e19c7343ad491fd706c36335ca0e738340a91f29b1fd81e2673d44896c4.
+//
+// Solidity: event TokensRescued(address indexed token, address indexed to, uint256 amount)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) FilterTokensRescued(opts *bind.FilterOpts, token []common.Address, to []common.Address) (*Fastsettlementv2TokensRescuedIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.FilterLogs(opts, "TokensRescued", tokenRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2TokensRescuedIterator{contract: _Fastsettlementv2.contract, event: "TokensRescued", logs: logs, sub: sub}, nil
+}
+
+// WatchTokensRescued is a free log subscription operation binding the contract event 0x77023e19c7343ad491fd706c36335ca0e738340a91f29b1fd81e2673d44896c4.
+//
+// Solidity: event TokensRescued(address indexed token, address indexed to, uint256 amount)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) WatchTokensRescued(opts *bind.WatchOpts, sink chan<- *Fastsettlementv2TokensRescued, token []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Fastsettlementv2.contract.WatchLogs(opts, "TokensRescued", tokenRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Fastsettlementv2TokensRescued)
+				if err := _Fastsettlementv2.contract.UnpackLog(event, "TokensRescued", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokensRescued is a log parse operation binding the contract event 0x77023e19c7343ad491fd706c36335ca0e738340a91f29b1fd81e2673d44896c4.
+//
+// Solidity: event TokensRescued(address indexed token, address indexed to, uint256 amount)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) ParseTokensRescued(log types.Log) (*Fastsettlementv2TokensRescued, error) {
+	event := new(Fastsettlementv2TokensRescued)
+	if err := _Fastsettlementv2.contract.UnpackLog(event, "TokensRescued", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Fastsettlementv2UnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the Fastsettlementv2 contract.
+type Fastsettlementv2UnpausedIterator struct {
+	Event *Fastsettlementv2Unpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Fastsettlementv2UnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Fastsettlementv2Unpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Fastsettlementv2Unpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Fastsettlementv2UnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Fastsettlementv2UnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Fastsettlementv2Unpaused represents a Unpaused event raised by the Fastsettlementv2 contract.
+type Fastsettlementv2Unpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) FilterUnpaused(opts *bind.FilterOpts) (*Fastsettlementv2UnpausedIterator, error) {
+
+	logs, sub, err := _Fastsettlementv2.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &Fastsettlementv2UnpausedIterator{contract: _Fastsettlementv2.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *Fastsettlementv2Unpaused) (event.Subscription, error) {
+
+	logs, sub, err := _Fastsettlementv2.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Fastsettlementv2Unpaused)
+				if err := _Fastsettlementv2.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_Fastsettlementv2 *Fastsettlementv2Filterer) ParseUnpaused(log types.Log) (*Fastsettlementv2Unpaused, error) {
+	event := new(Fastsettlementv2Unpaused)
+	if err := _Fastsettlementv2.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,593 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package icowsettlementhelper
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IcowsettlementhelperMetaData contains all meta data concerning the Icowsettlementhelper contract.
+var IcowsettlementhelperMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"arm\",\"inputs\":[{\"name\":\"legs\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"armed\",\"inputs\":[{\"name\":\"leg\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"operator\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"settle\",\"inputs\":[{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"LegArmed\",\"inputs\":[{\"name\":\"leg\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"LegSettled\",\"inputs\":[{\"name\":\"leg\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"LegNotArmed\",\"inputs\":[{\"name\":\"leg\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"Unauthorized\",\"inputs\":[]}]",
+}
+
+// IcowsettlementhelperABI is the input ABI used to generate the binding from.
+// Deprecated: Use IcowsettlementhelperMetaData.ABI instead.
+var IcowsettlementhelperABI = IcowsettlementhelperMetaData.ABI
+
+// Icowsettlementhelper is an auto generated Go binding around an Ethereum contract.
+type Icowsettlementhelper struct {
+	IcowsettlementhelperCaller     // Read-only binding to the contract
+	IcowsettlementhelperTransactor // Write-only binding to the contract
+	IcowsettlementhelperFilterer   // Log filterer for contract events
+}
+
+// IcowsettlementhelperCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IcowsettlementhelperCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IcowsettlementhelperTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IcowsettlementhelperTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IcowsettlementhelperFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IcowsettlementhelperFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IcowsettlementhelperSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IcowsettlementhelperSession struct {
+	Contract     *Icowsettlementhelper // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts         // Call options to use throughout this session
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// IcowsettlementhelperCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IcowsettlementhelperCallerSession struct {
+	Contract *IcowsettlementhelperCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts               // Call options to use throughout this session
+}
+
+// IcowsettlementhelperTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IcowsettlementhelperTransactorSession struct {
+	Contract     *IcowsettlementhelperTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts               // Transaction auth options to use throughout this session
+}
+
+// IcowsettlementhelperRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IcowsettlementhelperRaw struct {
+	Contract *Icowsettlementhelper // Generic contract binding to access the raw methods on
+}
+
+// IcowsettlementhelperCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IcowsettlementhelperCallerRaw struct {
+	Contract *IcowsettlementhelperCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IcowsettlementhelperTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IcowsettlementhelperTransactorRaw struct {
+	Contract *IcowsettlementhelperTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIcowsettlementhelper creates a new instance of Icowsettlementhelper, bound to a specific deployed contract.
+func NewIcowsettlementhelper(address common.Address, backend bind.ContractBackend) (*Icowsettlementhelper, error) {
+	contract, err := bindIcowsettlementhelper(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Icowsettlementhelper{IcowsettlementhelperCaller: IcowsettlementhelperCaller{contract: contract}, IcowsettlementhelperTransactor: IcowsettlementhelperTransactor{contract: contract}, IcowsettlementhelperFilterer: IcowsettlementhelperFilterer{contract: contract}}, nil
+}
+
+// NewIcowsettlementhelperCaller creates a new read-only instance of Icowsettlementhelper, bound to a specific deployed contract.
+func NewIcowsettlementhelperCaller(address common.Address, caller bind.ContractCaller) (*IcowsettlementhelperCaller, error) {
+	contract, err := bindIcowsettlementhelper(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IcowsettlementhelperCaller{contract: contract}, nil
+}
+
+// NewIcowsettlementhelperTransactor creates a new write-only instance of Icowsettlementhelper, bound to a specific deployed contract.
+func NewIcowsettlementhelperTransactor(address common.Address, transactor bind.ContractTransactor) (*IcowsettlementhelperTransactor, error) {
+	contract, err := bindIcowsettlementhelper(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IcowsettlementhelperTransactor{contract: contract}, nil
+}
+
+// NewIcowsettlementhelperFilterer creates a new log filterer instance of Icowsettlementhelper, bound to a specific deployed contract.
+func NewIcowsettlementhelperFilterer(address common.Address, filterer bind.ContractFilterer) (*IcowsettlementhelperFilterer, error) {
+	contract, err := bindIcowsettlementhelper(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IcowsettlementhelperFilterer{contract: contract}, nil
+}
+
+// bindIcowsettlementhelper binds a generic wrapper to an already deployed contract.
+func bindIcowsettlementhelper(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IcowsettlementhelperMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Icowsettlementhelper *IcowsettlementhelperRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Icowsettlementhelper.Contract.IcowsettlementhelperCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Icowsettlementhelper *IcowsettlementhelperRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Icowsettlementhelper.Contract.IcowsettlementhelperTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Icowsettlementhelper *IcowsettlementhelperRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Icowsettlementhelper.Contract.IcowsettlementhelperTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Icowsettlementhelper *IcowsettlementhelperCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Icowsettlementhelper.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Icowsettlementhelper *IcowsettlementhelperTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Icowsettlementhelper.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Icowsettlementhelper *IcowsettlementhelperTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Icowsettlementhelper.Contract.contract.Transact(opts, method, params...)
+}
+
+// Armed is a free data retrieval call binding the contract method 0x85e2a7ec.
+//
+// Solidity: function armed(bytes32 leg) view returns(bool)
+func (_Icowsettlementhelper *IcowsettlementhelperCaller) Armed(opts *bind.CallOpts, leg [32]byte) (bool, error) {
+	var out []interface{}
+	err := _Icowsettlementhelper.contract.Call(opts, &out, "armed", leg)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Armed is a free data retrieval call binding the contract method 0x85e2a7ec.
+//
+// Solidity: function armed(bytes32 leg) view returns(bool)
+func (_Icowsettlementhelper *IcowsettlementhelperSession) Armed(leg [32]byte) (bool, error) {
+	return _Icowsettlementhelper.Contract.Armed(&_Icowsettlementhelper.CallOpts, leg)
+}
+
+// Armed is a free data retrieval call binding the contract method 0x85e2a7ec.
+//
+// Solidity: function armed(bytes32 leg) view returns(bool)
+func (_Icowsettlementhelper *IcowsettlementhelperCallerSession) Armed(leg [32]byte) (bool, error) {
+	return _Icowsettlementhelper.Contract.Armed(&_Icowsettlementhelper.CallOpts, leg)
+}
+
+// Operator is a free data retrieval call binding the contract method 0x570ca735.
+//
+// Solidity: function operator() view returns(address)
+func (_Icowsettlementhelper *IcowsettlementhelperCaller) Operator(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Icowsettlementhelper.contract.Call(opts, &out, "operator")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Operator is a free data retrieval call binding the contract method 0x570ca735.
+//
+// Solidity: function operator() view returns(address)
+func (_Icowsettlementhelper *IcowsettlementhelperSession) Operator() (common.Address, error) {
+	return _Icowsettlementhelper.Contract.Operator(&_Icowsettlementhelper.CallOpts)
+}
+
+// Operator is a free data retrieval call binding the contract method 0x570ca735.
+//
+// Solidity: function operator() view returns(address)
+func (_Icowsettlementhelper *IcowsettlementhelperCallerSession) Operator() (common.Address, error) {
+	return _Icowsettlementhelper.Contract.Operator(&_Icowsettlementhelper.CallOpts)
+}
+
+// Arm is a paid mutator transaction binding the contract method 0x6585de2c.
+//
+// Solidity: function arm(bytes32[] legs) returns()
+func (_Icowsettlementhelper *IcowsettlementhelperTransactor) Arm(opts *bind.TransactOpts, legs [][32]byte) (*types.Transaction, error) {
+	return _Icowsettlementhelper.contract.Transact(opts, "arm", legs)
+}
+
+// Arm is a paid mutator transaction binding the contract method 0x6585de2c.
+//
+// Solidity: function arm(bytes32[] legs) returns()
+func (_Icowsettlementhelper *IcowsettlementhelperSession) Arm(legs [][32]byte) (*types.Transaction, error) {
+	return _Icowsettlementhelper.Contract.Arm(&_Icowsettlementhelper.TransactOpts, legs)
+}
+
+// Arm is a paid mutator transaction binding the contract method 0x6585de2c.
+//
+// Solidity: function arm(bytes32[] legs) returns()
+func (_Icowsettlementhelper *IcowsettlementhelperTransactorSession) Arm(legs [][32]byte) (*types.Transaction, error) {
+	return _Icowsettlementhelper.Contract.Arm(&_Icowsettlementhelper.TransactOpts, legs)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x3232688e.
+//
+// Solidity: function settle(address tokenIn, uint256 amountIn, address tokenOut, uint256 amountOut) returns()
+func (_Icowsettlementhelper *IcowsettlementhelperTransactor) Settle(opts *bind.TransactOpts, tokenIn common.Address, amountIn *big.Int, tokenOut common.Address, amountOut *big.Int) (*types.Transaction, error) {
+	return _Icowsettlementhelper.contract.Transact(opts, "settle", tokenIn, amountIn, tokenOut, amountOut)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x3232688e.
+//
+// Solidity: function settle(address tokenIn, uint256 amountIn, address tokenOut, uint256 amountOut) returns()
+func (_Icowsettlementhelper *IcowsettlementhelperSession) Settle(tokenIn common.Address, amountIn *big.Int, tokenOut common.Address, amountOut *big.Int) (*types.Transaction, error) {
+	return _Icowsettlementhelper.Contract.Settle(&_Icowsettlementhelper.TransactOpts, tokenIn, amountIn, tokenOut, amountOut)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x3232688e.
+//
+// Solidity: function settle(address tokenIn, uint256 amountIn, address tokenOut, uint256 amountOut) returns()
+func (_Icowsettlementhelper *IcowsettlementhelperTransactorSession) Settle(tokenIn common.Address, amountIn *big.Int, tokenOut common.Address, amountOut *big.Int) (*types.Transaction, error) {
+	return _Icowsettlementhelper.Contract.Settle(&_Icowsettlementhelper.TransactOpts, tokenIn, amountIn, tokenOut, amountOut)
+}
+
+// IcowsettlementhelperLegArmedIterator is returned from FilterLegArmed and is used to iterate over the raw logs and unpacked data for LegArmed events raised by the Icowsettlementhelper contract.
+type IcowsettlementhelperLegArmedIterator struct {
+	Event *IcowsettlementhelperLegArmed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IcowsettlementhelperLegArmedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IcowsettlementhelperLegArmed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IcowsettlementhelperLegArmed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IcowsettlementhelperLegArmedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IcowsettlementhelperLegArmedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IcowsettlementhelperLegArmed represents a LegArmed event raised by the Icowsettlementhelper contract.
+type IcowsettlementhelperLegArmed struct {
+	Leg [32]byte
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterLegArmed is a free log retrieval operation binding the contract event 0x37107826717f512f64d6050bb453ab5cd7b82789770fa2f0a185a1a369bee7fa.
+//
+// Solidity: event LegArmed(bytes32 indexed leg)
+func (_Icowsettlementhelper *IcowsettlementhelperFilterer) FilterLegArmed(opts *bind.FilterOpts, leg [][32]byte) (*IcowsettlementhelperLegArmedIterator, error) {
+
+	var legRule []interface{}
+	for _, legItem := range leg {
+		legRule = append(legRule, legItem)
+	}
+
+	logs, sub, err := _Icowsettlementhelper.contract.FilterLogs(opts, "LegArmed", legRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IcowsettlementhelperLegArmedIterator{contract: _Icowsettlementhelper.contract, event: "LegArmed", logs: logs, sub: sub}, nil
+}
+
+// WatchLegArmed is a free log subscription operation binding the contract event 0x37107826717f512f64d6050bb453ab5cd7b82789770fa2f0a185a1a369bee7fa.
+//
+// Solidity: event LegArmed(bytes32 indexed leg)
+func (_Icowsettlementhelper *IcowsettlementhelperFilterer) WatchLegArmed(opts *bind.WatchOpts, sink chan<- *IcowsettlementhelperLegArmed, leg [][32]byte) (event.Subscription, error) {
+
+	var legRule []interface{}
+	for _, legItem := range leg {
+		legRule = append(legRule, legItem)
+	}
+
+	logs, sub, err := _Icowsettlementhelper.contract.WatchLogs(opts, "LegArmed", legRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IcowsettlementhelperLegArmed)
+				if err := _Icowsettlementhelper.contract.UnpackLog(event, "LegArmed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLegArmed is a log parse operation binding the contract event 0x37107826717f512f64d6050bb453ab5cd7b82789770fa2f0a185a1a369bee7fa.
+//
+// Solidity: event LegArmed(bytes32 indexed leg)
+func (_Icowsettlementhelper *IcowsettlementhelperFilterer) ParseLegArmed(log types.Log) (*IcowsettlementhelperLegArmed, error) {
+	event := new(IcowsettlementhelperLegArmed)
+	if err := _Icowsettlementhelper.contract.UnpackLog(event, "LegArmed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IcowsettlementhelperLegSettledIterator is returned from FilterLegSettled and is used to iterate over the raw logs and unpacked data for LegSettled events raised by the Icowsettlementhelper contract.
+type IcowsettlementhelperLegSettledIterator struct {
+	Event *IcowsettlementhelperLegSettled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IcowsettlementhelperLegSettledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IcowsettlementhelperLegSettled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IcowsettlementhelperLegSettled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IcowsettlementhelperLegSettledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IcowsettlementhelperLegSettledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IcowsettlementhelperLegSettled represents a LegSettled event raised by the Icowsettlementhelper contract.
+type IcowsettlementhelperLegSettled struct {
+	Leg       [32]byte
+	TokenIn   common.Address
+	TokenOut  common.Address
+	AmountIn  *big.Int
+	AmountOut *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterLegSettled is a free log retrieval operation binding the contract event 0x885c0e003be11008d1a039fdf6d22190c957015cccb90fbe0a3d56a730d05f90.
+//
+// Solidity: event LegSettled(bytes32 indexed leg, address indexed tokenIn, address indexed tokenOut, uint256 amountIn, uint256 amountOut)
+func (_Icowsettlementhelper *IcowsettlementhelperFilterer) FilterLegSettled(opts *bind.FilterOpts, leg [][32]byte, tokenIn []common.Address, tokenOut []common.Address) (*IcowsettlementhelperLegSettledIterator, error) {
+
+	var legRule []interface{}
+	for _, legItem := range leg {
+		legRule = append(legRule, legItem)
+	}
+	var tokenInRule []interface{}
+	for _, tokenInItem := range tokenIn {
+		tokenInRule = append(tokenInRule, tokenInItem)
+	}
+	var tokenOutRule []interface{}
+	for _, tokenOutItem := range tokenOut {
+		tokenOutRule = append(tokenOutRule, tokenOutItem)
+	}
+
+	logs, sub, err := _Icowsettlementhelper.contract.FilterLogs(opts, "LegSettled", legRule, tokenInRule, tokenOutRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IcowsettlementhelperLegSettledIterator{contract: _Icowsettlementhelper.contract, event: "LegSettled", logs: logs, sub: sub}, nil
+}
+
+// WatchLegSettled is a free log subscription operation binding the contract event 0x885c0e003be11008d1a039fdf6d22190c957015cccb90fbe0a3d56a730d05f90.
+//
+// Solidity: event LegSettled(bytes32 indexed leg, address indexed tokenIn, address indexed tokenOut, uint256 amountIn, uint256 amountOut)
+func (_Icowsettlementhelper *IcowsettlementhelperFilterer) WatchLegSettled(opts *bind.WatchOpts, sink chan<- *IcowsettlementhelperLegSettled, leg [][32]byte, tokenIn []common.Address, tokenOut []common.Address) (event.Subscription, error) {
+
+	var legRule []interface{}
+	for _, legItem := range leg {
+		legRule = append(legRule, legItem)
+	}
+	var tokenInRule []interface{}
+	for _, tokenInItem := range tokenIn {
+		tokenInRule = append(tokenInRule, tokenInItem)
+	}
+	var tokenOutRule []interface{}
+	for _, tokenOutItem := range tokenOut {
+		tokenOutRule = append(tokenOutRule, tokenOutItem)
+	}
+
+	logs, sub, err := _Icowsettlementhelper.contract.WatchLogs(opts, "LegSettled", legRule, tokenInRule, tokenOutRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IcowsettlementhelperLegSettled)
+				if err := _Icowsettlementhelper.contract.UnpackLog(event, "LegSettled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLegSettled is a log parse operation binding the contract event 0x885c0e003be11008d1a039fdf6d22190c957015cccb90fbe0a3d56a730d05f90.
+//
+// Solidity: event LegSettled(bytes32 indexed leg, address indexed tokenIn, address indexed tokenOut, uint256 amountIn, uint256 amountOut)
+func (_Icowsettlementhelper *IcowsettlementhelperFilterer) ParseLegSettled(log types.Log) (*IcowsettlementhelperLegSettled, error) {
+	event := new(IcowsettlementhelperLegSettled)
+	if err := _Icowsettlementhelper.contract.UnpackLog(event, "LegSettled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
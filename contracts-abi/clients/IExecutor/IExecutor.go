@@ -0,0 +1,207 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package iexecutor
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	sharedtypes "github.com/primev/fastprotocolapp/contracts-abi/clients/SharedTypes"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IFastSettlementV2Intent is an auto generated low-level Go binding around an user-defined struct.
+// It is declared once in sharedtypes and shared by every binding that uses it.
+type IFastSettlementV2Intent = sharedtypes.IFastSettlementV2Intent
+
+// IexecutorMetaData contains all meta data concerning the Iexecutor contract.
+var IexecutorMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"execute\",\"inputs\":[{\"name\":\"intent\",\"type\":\"tuple\",\"internalType\":\"structIFastSettlementV2.Intent\",\"components\":[{\"name\":\"maker\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"refId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"name\":\"inputData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"}]",
+}
+
+// IexecutorABI is the input ABI used to generate the binding from.
+// Deprecated: Use IexecutorMetaData.ABI instead.
+var IexecutorABI = IexecutorMetaData.ABI
+
+// Iexecutor is an auto generated Go binding around an Ethereum contract.
+type Iexecutor struct {
+	IexecutorCaller     // Read-only binding to the contract
+	IexecutorTransactor // Write-only binding to the contract
+	IexecutorFilterer   // Log filterer for contract events
+}
+
+// IexecutorCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IexecutorCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IexecutorTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IexecutorTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IexecutorFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IexecutorFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IexecutorSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IexecutorSession struct {
+	Contract     *Iexecutor        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IexecutorCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IexecutorCallerSession struct {
+	Contract *IexecutorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// IexecutorTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IexecutorTransactorSession struct {
+	Contract     *IexecutorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// IexecutorRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IexecutorRaw struct {
+	Contract *Iexecutor // Generic contract binding to access the raw methods on
+}
+
+// IexecutorCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IexecutorCallerRaw struct {
+	Contract *IexecutorCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IexecutorTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IexecutorTransactorRaw struct {
+	Contract *IexecutorTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIexecutor creates a new instance of Iexecutor, bound to a specific deployed contract.
+func NewIexecutor(address common.Address, backend bind.ContractBackend) (*Iexecutor, error) {
+	contract, err := bindIexecutor(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Iexecutor{IexecutorCaller: IexecutorCaller{contract: contract}, IexecutorTransactor: IexecutorTransactor{contract: contract}, IexecutorFilterer: IexecutorFilterer{contract: contract}}, nil
+}
+
+// NewIexecutorCaller creates a new read-only instance of Iexecutor, bound to a specific deployed contract.
+func NewIexecutorCaller(address common.Address, caller bind.ContractCaller) (*IexecutorCaller, error) {
+	contract, err := bindIexecutor(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IexecutorCaller{contract: contract}, nil
+}
+
+// NewIexecutorTransactor creates a new write-only instance of Iexecutor, bound to a specific deployed contract.
+func NewIexecutorTransactor(address common.Address, transactor bind.ContractTransactor) (*IexecutorTransactor, error) {
+	contract, err := bindIexecutor(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IexecutorTransactor{contract: contract}, nil
+}
+
+// NewIexecutorFilterer creates a new log filterer instance of Iexecutor, bound to a specific deployed contract.
+func NewIexecutorFilterer(address common.Address, filterer bind.ContractFilterer) (*IexecutorFilterer, error) {
+	contract, err := bindIexecutor(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IexecutorFilterer{contract: contract}, nil
+}
+
+// bindIexecutor binds a generic wrapper to an already deployed contract.
+func bindIexecutor(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IexecutorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Iexecutor *IexecutorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Iexecutor.Contract.IexecutorCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Iexecutor *IexecutorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Iexecutor.Contract.IexecutorTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Iexecutor *IexecutorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Iexecutor.Contract.IexecutorTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Iexecutor *IexecutorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Iexecutor.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Iexecutor *IexecutorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Iexecutor.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Iexecutor *IexecutorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Iexecutor.Contract.contract.Transact(opts, method, params...)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xd1396eae.
+//
+// Solidity: function execute((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes inputData) returns()
+func (_Iexecutor *IexecutorTransactor) Execute(opts *bind.TransactOpts, intent IFastSettlementV2Intent, inputData []byte) (*types.Transaction, error) {
+	return _Iexecutor.contract.Transact(opts, "execute", intent, inputData)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xd1396eae.
+//
+// Solidity: function execute((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes inputData) returns()
+func (_Iexecutor *IexecutorSession) Execute(intent IFastSettlementV2Intent, inputData []byte) (*types.Transaction, error) {
+	return _Iexecutor.Contract.Execute(&_Iexecutor.TransactOpts, intent, inputData)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xd1396eae.
+//
+// Solidity: function execute((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes inputData) returns()
+func (_Iexecutor *IexecutorTransactorSession) Execute(intent IFastSettlementV2Intent, inputData []byte) (*types.Transaction, error) {
+	return _Iexecutor.Contract.Execute(&_Iexecutor.TransactOpts, intent, inputData)
+}
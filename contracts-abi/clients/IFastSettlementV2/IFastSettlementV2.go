@@ -0,0 +1,1482 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ifastsettlementv2
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	sharedtypes "github.com/primev/fastprotocolapp/contracts-abi/clients/SharedTypes"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IFastSettlementV2Intent is an auto generated low-level Go binding around an user-defined struct.
+// It is declared once in sharedtypes and shared by every binding that uses it.
+type IFastSettlementV2Intent = sharedtypes.IFastSettlementV2Intent
+
+// Ifastsettlementv2MetaData contains all meta data concerning the Ifastsettlementv2 contract.
+var Ifastsettlementv2MetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"DOMAIN_SEPARATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getIntentId\",\"inputs\":[{\"name\":\"intent\",\"type\":\"tuple\",\"internalType\":\"structIFastSettlementV2.Intent\",\"components\":[{\"name\":\"maker\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"refId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getMinNonce\",\"inputs\":[{\"name\":\"maker\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getSurplusRecipient\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"invalidateNoncesUpTo\",\"inputs\":[{\"name\":\"newMinNonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isExecutorAllowed\",\"inputs\":[{\"name\":\"executor\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isNonceUsed\",\"inputs\":[{\"name\":\"maker\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"rescueTokens\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutor\",\"inputs\":[{\"name\":\"executor\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"allowed\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutorWhitelistActive\",\"inputs\":[{\"name\":\"active\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setSurplusRecipient\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"settle\",\"inputs\":[{\"name\":\"intent\",\"type\":\"tuple\",\"internalType\":\"structIFastSettlementV2.Intent\",\"components\":[{\"name\":\"maker\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"refId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"executorData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"unpause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"validate\",\"inputs\":[{\"name\":\"intent\",\"type\":\"tuple\",\"internalType\":\"structIFastSettlementV2.Intent\",\"components\":[{\"name\":\"maker\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"refId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"isValid\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"reason\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"ExecutorUpdated\",\"inputs\":[{\"name\":\"executor\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"allowed\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ExecutorWhitelistActiveUpdated\",\"inputs\":[{\"name\":\"active\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"IntentSettled\",\"inputs\":[{\"name\":\"intentId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"maker\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"protocolAmt\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"NonceInvalidated\",\"inputs\":[{\"name\":\"maker\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newMinNonce\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SurplusRecipientUpdated\",\"inputs\":[{\"name\":\"oldRecipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newRecipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TokensRescued\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InsufficientOutput\",\"inputs\":[{\"name\":\"received\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"required\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidExecutorAddress\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidNonceIncrement\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidOwnerAddress\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidPermit2Address\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidRecipient\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidSurplusBps\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidSurplusRecipient\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NonceAlreadyUsed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NonceTooHigh\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"Permit2TransferFailed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SettlementFailed\",\"inputs\":[{\"name\":\"intentId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"reason\",\"type\":\"string\",\"internalType\":\"string\"}]},{\"type\":\"error\",\"name\":\"TransactionExpired\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UnauthorizedExecutor\",\"inputs\":[]}]",
+}
+
+// Ifastsettlementv2ABI is the input ABI used to generate the binding from.
+// Deprecated: Use Ifastsettlementv2MetaData.ABI instead.
+var Ifastsettlementv2ABI = Ifastsettlementv2MetaData.ABI
+
+// Ifastsettlementv2 is an auto generated Go binding around an Ethereum contract.
+type Ifastsettlementv2 struct {
+	Ifastsettlementv2Caller     // Read-only binding to the contract
+	Ifastsettlementv2Transactor // Write-only binding to the contract
+	Ifastsettlementv2Filterer   // Log filterer for contract events
+}
+
+// Ifastsettlementv2Caller is an auto generated read-only Go binding around an Ethereum contract.
+type Ifastsettlementv2Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Ifastsettlementv2Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type Ifastsettlementv2Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Ifastsettlementv2Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Ifastsettlementv2Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Ifastsettlementv2Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Ifastsettlementv2Session struct {
+	Contract     *Ifastsettlementv2 // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// Ifastsettlementv2CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Ifastsettlementv2CallerSession struct {
+	Contract *Ifastsettlementv2Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// Ifastsettlementv2TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Ifastsettlementv2TransactorSession struct {
+	Contract     *Ifastsettlementv2Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// Ifastsettlementv2Raw is an auto generated low-level Go binding around an Ethereum contract.
+type Ifastsettlementv2Raw struct {
+	Contract *Ifastsettlementv2 // Generic contract binding to access the raw methods on
+}
+
+// Ifastsettlementv2CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Ifastsettlementv2CallerRaw struct {
+	Contract *Ifastsettlementv2Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// Ifastsettlementv2TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Ifastsettlementv2TransactorRaw struct {
+	Contract *Ifastsettlementv2Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIfastsettlementv2 creates a new instance of Ifastsettlementv2, bound to a specific deployed contract.
+func NewIfastsettlementv2(address common.Address, backend bind.ContractBackend) (*Ifastsettlementv2, error) {
+	contract, err := bindIfastsettlementv2(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Ifastsettlementv2{Ifastsettlementv2Caller: Ifastsettlementv2Caller{contract: contract}, Ifastsettlementv2Transactor: Ifastsettlementv2Transactor{contract: contract}, Ifastsettlementv2Filterer: Ifastsettlementv2Filterer{contract: contract}}, nil
+}
+
+// NewIfastsettlementv2Caller creates a new read-only instance of Ifastsettlementv2, bound to a specific deployed contract.
+func NewIfastsettlementv2Caller(address common.Address, caller bind.ContractCaller) (*Ifastsettlementv2Caller, error) {
+	contract, err := bindIfastsettlementv2(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Ifastsettlementv2Caller{contract: contract}, nil
+}
+
+// NewIfastsettlementv2Transactor creates a new write-only instance of Ifastsettlementv2, bound to a specific deployed contract.
+func NewIfastsettlementv2Transactor(address common.Address, transactor bind.ContractTransactor) (*Ifastsettlementv2Transactor, error) {
+	contract, err := bindIfastsettlementv2(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Ifastsettlementv2Transactor{contract: contract}, nil
+}
+
+// NewIfastsettlementv2Filterer creates a new log filterer instance of Ifastsettlementv2, bound to a specific deployed contract.
+func NewIfastsettlementv2Filterer(address common.Address, filterer bind.ContractFilterer) (*Ifastsettlementv2Filterer, error) {
+	contract, err := bindIfastsettlementv2(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Ifastsettlementv2Filterer{contract: contract}, nil
+}
+
+// bindIfastsettlementv2 binds a generic wrapper to an already deployed contract.
+func bindIfastsettlementv2(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Ifastsettlementv2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Ifastsettlementv2 *Ifastsettlementv2Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Ifastsettlementv2.Contract.Ifastsettlementv2Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Ifastsettlementv2 *Ifastsettlementv2Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.Ifastsettlementv2Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Ifastsettlementv2 *Ifastsettlementv2Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.Ifastsettlementv2Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Ifastsettlementv2 *Ifastsettlementv2CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Ifastsettlementv2.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Ifastsettlementv2 *Ifastsettlementv2TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Ifastsettlementv2 *Ifastsettlementv2TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.contract.Transact(opts, method, params...)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_Ifastsettlementv2 *Ifastsettlementv2Caller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Ifastsettlementv2.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) DOMAINSEPARATOR() ([32]byte, error) {
+	return _Ifastsettlementv2.Contract.DOMAINSEPARATOR(&_Ifastsettlementv2.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_Ifastsettlementv2 *Ifastsettlementv2CallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _Ifastsettlementv2.Contract.DOMAINSEPARATOR(&_Ifastsettlementv2.CallOpts)
+}
+
+// GetIntentId is a free data retrieval call binding the contract method 0x3744f6e8.
+//
+// Solidity: function getIntentId((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent) view returns(bytes32)
+func (_Ifastsettlementv2 *Ifastsettlementv2Caller) GetIntentId(opts *bind.CallOpts, intent IFastSettlementV2Intent) ([32]byte, error) {
+	var out []interface{}
+	err := _Ifastsettlementv2.contract.Call(opts, &out, "getIntentId", intent)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetIntentId is a free data retrieval call binding the contract method 0x3744f6e8.
+//
+// Solidity: function getIntentId((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent) view returns(bytes32)
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) GetIntentId(intent IFastSettlementV2Intent) ([32]byte, error) {
+	return _Ifastsettlementv2.Contract.GetIntentId(&_Ifastsettlementv2.CallOpts, intent)
+}
+
+// GetIntentId is a free data retrieval call binding the contract method 0x3744f6e8.
+//
+// Solidity: function getIntentId((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent) view returns(bytes32)
+func (_Ifastsettlementv2 *Ifastsettlementv2CallerSession) GetIntentId(intent IFastSettlementV2Intent) ([32]byte, error) {
+	return _Ifastsettlementv2.Contract.GetIntentId(&_Ifastsettlementv2.CallOpts, intent)
+}
+
+// GetMinNonce is a free data retrieval call binding the contract method 0x896909dc.
+//
+// Solidity: function getMinNonce(address maker) view returns(uint256)
+func (_Ifastsettlementv2 *Ifastsettlementv2Caller) GetMinNonce(opts *bind.CallOpts, maker common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Ifastsettlementv2.contract.Call(opts, &out, "getMinNonce", maker)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinNonce is a free data retrieval call binding the contract method 0x896909dc.
+//
+// Solidity: function getMinNonce(address maker) view returns(uint256)
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) GetMinNonce(maker common.Address) (*big.Int, error) {
+	return _Ifastsettlementv2.Contract.GetMinNonce(&_Ifastsettlementv2.CallOpts, maker)
+}
+
+// GetMinNonce is a free data retrieval call binding the contract method 0x896909dc.
+//
+// Solidity: function getMinNonce(address maker) view returns(uint256)
+func (_Ifastsettlementv2 *Ifastsettlementv2CallerSession) GetMinNonce(maker common.Address) (*big.Int, error) {
+	return _Ifastsettlementv2.Contract.GetMinNonce(&_Ifastsettlementv2.CallOpts, maker)
+}
+
+// GetSurplusRecipient is a free data retrieval call binding the contract method 0x217a16a5.
+//
+// Solidity: function getSurplusRecipient() view returns(address)
+func (_Ifastsettlementv2 *Ifastsettlementv2Caller) GetSurplusRecipient(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Ifastsettlementv2.contract.Call(opts, &out, "getSurplusRecipient")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetSurplusRecipient is a free data retrieval call binding the contract method 0x217a16a5.
+//
+// Solidity: function getSurplusRecipient() view returns(address)
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) GetSurplusRecipient() (common.Address, error) {
+	return _Ifastsettlementv2.Contract.GetSurplusRecipient(&_Ifastsettlementv2.CallOpts)
+}
+
+// GetSurplusRecipient is a free data retrieval call binding the contract method 0x217a16a5.
+//
+// Solidity: function getSurplusRecipient() view returns(address)
+func (_Ifastsettlementv2 *Ifastsettlementv2CallerSession) GetSurplusRecipient() (common.Address, error) {
+	return _Ifastsettlementv2.Contract.GetSurplusRecipient(&_Ifastsettlementv2.CallOpts)
+}
+
+// IsExecutorAllowed is a free data retrieval call binding the contract method 0x7d701102.
+//
+// Solidity: function isExecutorAllowed(address executor) view returns(bool)
+func (_Ifastsettlementv2 *Ifastsettlementv2Caller) IsExecutorAllowed(opts *bind.CallOpts, executor common.Address) (bool, error) {
+	var out []interface{}
+	err := _Ifastsettlementv2.contract.Call(opts, &out, "isExecutorAllowed", executor)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsExecutorAllowed is a free data retrieval call binding the contract method 0x7d701102.
+//
+// Solidity: function isExecutorAllowed(address executor) view returns(bool)
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) IsExecutorAllowed(executor common.Address) (bool, error) {
+	return _Ifastsettlementv2.Contract.IsExecutorAllowed(&_Ifastsettlementv2.CallOpts, executor)
+}
+
+// IsExecutorAllowed is a free data retrieval call binding the contract method 0x7d701102.
+//
+// Solidity: function isExecutorAllowed(address executor) view returns(bool)
+func (_Ifastsettlementv2 *Ifastsettlementv2CallerSession) IsExecutorAllowed(executor common.Address) (bool, error) {
+	return _Ifastsettlementv2.Contract.IsExecutorAllowed(&_Ifastsettlementv2.CallOpts, executor)
+}
+
+// IsNonceUsed is a free data retrieval call binding the contract method 0xcab7e8eb.
+//
+// Solidity: function isNonceUsed(address maker, uint256 nonce) view returns(bool)
+func (_Ifastsettlementv2 *Ifastsettlementv2Caller) IsNonceUsed(opts *bind.CallOpts, maker common.Address, nonce *big.Int) (bool, error) {
+	var out []interface{}
+	err := _Ifastsettlementv2.contract.Call(opts, &out, "isNonceUsed", maker, nonce)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsNonceUsed is a free data retrieval call binding the contract method 0xcab7e8eb.
+//
+// Solidity: function isNonceUsed(address maker, uint256 nonce) view returns(bool)
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) IsNonceUsed(maker common.Address, nonce *big.Int) (bool, error) {
+	return _Ifastsettlementv2.Contract.IsNonceUsed(&_Ifastsettlementv2.CallOpts, maker, nonce)
+}
+
+// IsNonceUsed is a free data retrieval call binding the contract method 0xcab7e8eb.
+//
+// Solidity: function isNonceUsed(address maker, uint256 nonce) view returns(bool)
+func (_Ifastsettlementv2 *Ifastsettlementv2CallerSession) IsNonceUsed(maker common.Address, nonce *big.Int) (bool, error) {
+	return _Ifastsettlementv2.Contract.IsNonceUsed(&_Ifastsettlementv2.CallOpts, maker, nonce)
+}
+
+// Validate is a free data retrieval call binding the contract method 0x5893a2ec.
+//
+// Solidity: function validate((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes signature) view returns(bool isValid, string reason)
+func (_Ifastsettlementv2 *Ifastsettlementv2Caller) Validate(opts *bind.CallOpts, intent IFastSettlementV2Intent, signature []byte) (struct {
+	IsValid bool
+	Reason  string
+}, error) {
+	var out []interface{}
+	err := _Ifastsettlementv2.contract.Call(opts, &out, "validate", intent, signature)
+
+	outstruct := new(struct {
+		IsValid bool
+		Reason  string
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.IsValid = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Reason = *abi.ConvertType(out[1], new(string)).(*string)
+
+	return *outstruct, err
+
+}
+
+// Validate is a free data retrieval call binding the contract method 0x5893a2ec.
+//
+// Solidity: function validate((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes signature) view returns(bool isValid, string reason)
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) Validate(intent IFastSettlementV2Intent, signature []byte) (struct {
+	IsValid bool
+	Reason  string
+}, error) {
+	return _Ifastsettlementv2.Contract.Validate(&_Ifastsettlementv2.CallOpts, intent, signature)
+}
+
+// Validate is a free data retrieval call binding the contract method 0x5893a2ec.
+//
+// Solidity: function validate((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes signature) view returns(bool isValid, string reason)
+func (_Ifastsettlementv2 *Ifastsettlementv2CallerSession) Validate(intent IFastSettlementV2Intent, signature []byte) (struct {
+	IsValid bool
+	Reason  string
+}, error) {
+	return _Ifastsettlementv2.Contract.Validate(&_Ifastsettlementv2.CallOpts, intent, signature)
+}
+
+// InvalidateNoncesUpTo is a paid mutator transaction binding the contract method 0x070f0449.
+//
+// Solidity: function invalidateNoncesUpTo(uint256 newMinNonce) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Transactor) InvalidateNoncesUpTo(opts *bind.TransactOpts, newMinNonce *big.Int) (*types.Transaction, error) {
+	return _Ifastsettlementv2.contract.Transact(opts, "invalidateNoncesUpTo", newMinNonce)
+}
+
+// InvalidateNoncesUpTo is a paid mutator transaction binding the contract method 0x070f0449.
+//
+// Solidity: function invalidateNoncesUpTo(uint256 newMinNonce) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) InvalidateNoncesUpTo(newMinNonce *big.Int) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.InvalidateNoncesUpTo(&_Ifastsettlementv2.TransactOpts, newMinNonce)
+}
+
+// InvalidateNoncesUpTo is a paid mutator transaction binding the contract method 0x070f0449.
+//
+// Solidity: function invalidateNoncesUpTo(uint256 newMinNonce) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2TransactorSession) InvalidateNoncesUpTo(newMinNonce *big.Int) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.InvalidateNoncesUpTo(&_Ifastsettlementv2.TransactOpts, newMinNonce)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Transactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Ifastsettlementv2.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) Pause() (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.Pause(&_Ifastsettlementv2.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2TransactorSession) Pause() (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.Pause(&_Ifastsettlementv2.TransactOpts)
+}
+
+// RescueTokens is a paid mutator transaction binding the contract method 0xcea9d26f.
+//
+// Solidity: function rescueTokens(address token, address to, uint256 amount) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Transactor) RescueTokens(opts *bind.TransactOpts, token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Ifastsettlementv2.contract.Transact(opts, "rescueTokens", token, to, amount)
+}
+
+// RescueTokens is a paid mutator transaction binding the contract method 0xcea9d26f.
+//
+// Solidity: function rescueTokens(address token, address to, uint256 amount) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) RescueTokens(token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.RescueTokens(&_Ifastsettlementv2.TransactOpts, token, to, amount)
+}
+
+// RescueTokens is a paid mutator transaction binding the contract method 0xcea9d26f.
+//
+// Solidity: function rescueTokens(address token, address to, uint256 amount) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2TransactorSession) RescueTokens(token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.RescueTokens(&_Ifastsettlementv2.TransactOpts, token, to, amount)
+}
+
+// SetExecutor is a paid mutator transaction binding the contract method 0x1e1bff3f.
+//
+// Solidity: function setExecutor(address executor, bool allowed) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Transactor) SetExecutor(opts *bind.TransactOpts, executor common.Address, allowed bool) (*types.Transaction, error) {
+	return _Ifastsettlementv2.contract.Transact(opts, "setExecutor", executor, allowed)
+}
+
+// SetExecutor is a paid mutator transaction binding the contract method 0x1e1bff3f.
+//
+// Solidity: function setExecutor(address executor, bool allowed) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) SetExecutor(executor common.Address, allowed bool) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.SetExecutor(&_Ifastsettlementv2.TransactOpts, executor, allowed)
+}
+
+// SetExecutor is a paid mutator transaction binding the contract method 0x1e1bff3f.
+//
+// Solidity: function setExecutor(address executor, bool allowed) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2TransactorSession) SetExecutor(executor common.Address, allowed bool) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.SetExecutor(&_Ifastsettlementv2.TransactOpts, executor, allowed)
+}
+
+// SetExecutorWhitelistActive is a paid mutator transaction binding the contract method 0xc61d5949.
+//
+// Solidity: function setExecutorWhitelistActive(bool active) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Transactor) SetExecutorWhitelistActive(opts *bind.TransactOpts, active bool) (*types.Transaction, error) {
+	return _Ifastsettlementv2.contract.Transact(opts, "setExecutorWhitelistActive", active)
+}
+
+// SetExecutorWhitelistActive is a paid mutator transaction binding the contract method 0xc61d5949.
+//
+// Solidity: function setExecutorWhitelistActive(bool active) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) SetExecutorWhitelistActive(active bool) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.SetExecutorWhitelistActive(&_Ifastsettlementv2.TransactOpts, active)
+}
+
+// SetExecutorWhitelistActive is a paid mutator transaction binding the contract method 0xc61d5949.
+//
+// Solidity: function setExecutorWhitelistActive(bool active) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2TransactorSession) SetExecutorWhitelistActive(active bool) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.SetExecutorWhitelistActive(&_Ifastsettlementv2.TransactOpts, active)
+}
+
+// SetSurplusRecipient is a paid mutator transaction binding the contract method 0x20307981.
+//
+// Solidity: function setSurplusRecipient(address recipient) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Transactor) SetSurplusRecipient(opts *bind.TransactOpts, recipient common.Address) (*types.Transaction, error) {
+	return _Ifastsettlementv2.contract.Transact(opts, "setSurplusRecipient", recipient)
+}
+
+// SetSurplusRecipient is a paid mutator transaction binding the contract method 0x20307981.
+//
+// Solidity: function setSurplusRecipient(address recipient) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) SetSurplusRecipient(recipient common.Address) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.SetSurplusRecipient(&_Ifastsettlementv2.TransactOpts, recipient)
+}
+
+// SetSurplusRecipient is a paid mutator transaction binding the contract method 0x20307981.
+//
+// Solidity: function setSurplusRecipient(address recipient) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2TransactorSession) SetSurplusRecipient(recipient common.Address) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.SetSurplusRecipient(&_Ifastsettlementv2.TransactOpts, recipient)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x0fd3d95a.
+//
+// Solidity: function settle((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes signature, bytes executorData) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Transactor) Settle(opts *bind.TransactOpts, intent IFastSettlementV2Intent, signature []byte, executorData []byte) (*types.Transaction, error) {
+	return _Ifastsettlementv2.contract.Transact(opts, "settle", intent, signature, executorData)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x0fd3d95a.
+//
+// Solidity: function settle((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes signature, bytes executorData) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) Settle(intent IFastSettlementV2Intent, signature []byte, executorData []byte) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.Settle(&_Ifastsettlementv2.TransactOpts, intent, signature, executorData)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x0fd3d95a.
+//
+// Solidity: function settle((address,address,address,address,uint256,uint256,uint256,uint256,bytes32) intent, bytes signature, bytes executorData) returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2TransactorSession) Settle(intent IFastSettlementV2Intent, signature []byte, executorData []byte) (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.Settle(&_Ifastsettlementv2.TransactOpts, intent, signature, executorData)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Transactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Ifastsettlementv2.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2Session) Unpause() (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.Unpause(&_Ifastsettlementv2.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_Ifastsettlementv2 *Ifastsettlementv2TransactorSession) Unpause() (*types.Transaction, error) {
+	return _Ifastsettlementv2.Contract.Unpause(&_Ifastsettlementv2.TransactOpts)
+}
+
+// Ifastsettlementv2ExecutorUpdatedIterator is returned from FilterExecutorUpdated and is used to iterate over the raw logs and unpacked data for ExecutorUpdated events raised by the Ifastsettlementv2 contract.
+type Ifastsettlementv2ExecutorUpdatedIterator struct {
+	Event *Ifastsettlementv2ExecutorUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Ifastsettlementv2ExecutorUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Ifastsettlementv2ExecutorUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Ifastsettlementv2ExecutorUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Ifastsettlementv2ExecutorUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Ifastsettlementv2ExecutorUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Ifastsettlementv2ExecutorUpdated represents a ExecutorUpdated event raised by the Ifastsettlementv2 contract.
+type Ifastsettlementv2ExecutorUpdated struct {
+	Executor common.Address
+	Allowed  bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecutorUpdated is a free log retrieval operation binding the contract event 0x9fdbc2d48b8a0db2f62663bf9312ad02f5b1f6414ad600b55a247d09aeec3ea2.
+//
+// Solidity: event ExecutorUpdated(address indexed executor, bool allowed)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) FilterExecutorUpdated(opts *bind.FilterOpts, executor []common.Address) (*Ifastsettlementv2ExecutorUpdatedIterator, error) {
+
+	var executorRule []interface{}
+	for _, executorItem := range executor {
+		executorRule = append(executorRule, executorItem)
+	}
+
+	logs, sub, err := _Ifastsettlementv2.contract.FilterLogs(opts, "ExecutorUpdated", executorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Ifastsettlementv2ExecutorUpdatedIterator{contract: _Ifastsettlementv2.contract, event: "ExecutorUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchExecutorUpdated is a free log subscription operation binding the contract event 0x9fdbc2d48b8a0db2f62663bf9312ad02f5b1f6414ad600b55a247d09aeec3ea2.
+//
+// Solidity: event ExecutorUpdated(address indexed executor, bool allowed)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) WatchExecutorUpdated(opts *bind.WatchOpts, sink chan<- *Ifastsettlementv2ExecutorUpdated, executor []common.Address) (event.Subscription, error) {
+
+	var executorRule []interface{}
+	for _, executorItem := range executor {
+		executorRule = append(executorRule, executorItem)
+	}
+
+	logs, sub, err := _Ifastsettlementv2.contract.WatchLogs(opts, "ExecutorUpdated", executorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Ifastsettlementv2ExecutorUpdated)
+				if err := _Ifastsettlementv2.contract.UnpackLog(event, "ExecutorUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecutorUpdated is a log parse operation binding the contract event 0x9fdbc2d48b8a0db2f62663bf9312ad02f5b1f6414ad600b55a247d09aeec3ea2.
+//
+// Solidity: event ExecutorUpdated(address indexed executor, bool allowed)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) ParseExecutorUpdated(log types.Log) (*Ifastsettlementv2ExecutorUpdated, error) {
+	event := new(Ifastsettlementv2ExecutorUpdated)
+	if err := _Ifastsettlementv2.contract.UnpackLog(event, "ExecutorUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Ifastsettlementv2ExecutorWhitelistActiveUpdatedIterator is returned from FilterExecutorWhitelistActiveUpdated and is used to iterate over the raw logs and unpacked data for ExecutorWhitelistActiveUpdated events raised by the Ifastsettlementv2 contract.
+type Ifastsettlementv2ExecutorWhitelistActiveUpdatedIterator struct {
+	Event *Ifastsettlementv2ExecutorWhitelistActiveUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Ifastsettlementv2ExecutorWhitelistActiveUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Ifastsettlementv2ExecutorWhitelistActiveUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Ifastsettlementv2ExecutorWhitelistActiveUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Ifastsettlementv2ExecutorWhitelistActiveUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Ifastsettlementv2ExecutorWhitelistActiveUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Ifastsettlementv2ExecutorWhitelistActiveUpdated represents a ExecutorWhitelistActiveUpdated event raised by the Ifastsettlementv2 contract.
+type Ifastsettlementv2ExecutorWhitelistActiveUpdated struct {
+	Active bool
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecutorWhitelistActiveUpdated is a free log retrieval operation binding the contract event 0x1a11753f52e5433c57c61ab1c615bbb5bd9212388a5b464cfc4149a1b5065eef.
+//
+// Solidity: event ExecutorWhitelistActiveUpdated(bool active)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) FilterExecutorWhitelistActiveUpdated(opts *bind.FilterOpts) (*Ifastsettlementv2ExecutorWhitelistActiveUpdatedIterator, error) {
+
+	logs, sub, err := _Ifastsettlementv2.contract.FilterLogs(opts, "ExecutorWhitelistActiveUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &Ifastsettlementv2ExecutorWhitelistActiveUpdatedIterator{contract: _Ifastsettlementv2.contract, event: "ExecutorWhitelistActiveUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchExecutorWhitelistActiveUpdated is a free log subscription operation binding the contract event 0x1a11753f52e5433c57c61ab1c615bbb5bd9212388a5b464cfc4149a1b5065eef.
+//
+// Solidity: event ExecutorWhitelistActiveUpdated(bool active)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) WatchExecutorWhitelistActiveUpdated(opts *bind.WatchOpts, sink chan<- *Ifastsettlementv2ExecutorWhitelistActiveUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _Ifastsettlementv2.contract.WatchLogs(opts, "ExecutorWhitelistActiveUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Ifastsettlementv2ExecutorWhitelistActiveUpdated)
+				if err := _Ifastsettlementv2.contract.UnpackLog(event, "ExecutorWhitelistActiveUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecutorWhitelistActiveUpdated is a log parse operation binding the contract event 0x1a11753f52e5433c57c61ab1c615bbb5bd9212388a5b464cfc4149a1b5065eef.
+//
+// Solidity: event ExecutorWhitelistActiveUpdated(bool active)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) ParseExecutorWhitelistActiveUpdated(log types.Log) (*Ifastsettlementv2ExecutorWhitelistActiveUpdated, error) {
+	event := new(Ifastsettlementv2ExecutorWhitelistActiveUpdated)
+	if err := _Ifastsettlementv2.contract.UnpackLog(event, "ExecutorWhitelistActiveUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Ifastsettlementv2IntentSettledIterator is returned from FilterIntentSettled and is used to iterate over the raw logs and unpacked data for IntentSettled events raised by the Ifastsettlementv2 contract.
+type Ifastsettlementv2IntentSettledIterator struct {
+	Event *Ifastsettlementv2IntentSettled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Ifastsettlementv2IntentSettledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Ifastsettlementv2IntentSettled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Ifastsettlementv2IntentSettled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Ifastsettlementv2IntentSettledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Ifastsettlementv2IntentSettledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Ifastsettlementv2IntentSettled represents a IntentSettled event raised by the Ifastsettlementv2 contract.
+type Ifastsettlementv2IntentSettled struct {
+	IntentId    [32]byte
+	Maker       common.Address
+	TokenIn     common.Address
+	TokenOut    common.Address
+	AmountIn    *big.Int
+	AmountOut   *big.Int
+	ProtocolAmt *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterIntentSettled is a free log retrieval operation binding the contract event 0x7218be4da585fc2a7ede6968130b808389cc9481f765af39814696f7e7edd287.
+//
+// Solidity: event IntentSettled(bytes32 indexed intentId, address indexed maker, address indexed tokenIn, address tokenOut, uint256 amountIn, uint256 amountOut, uint256 protocolAmt)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) FilterIntentSettled(opts *bind.FilterOpts, intentId [][32]byte, maker []common.Address, tokenIn []common.Address) (*Ifastsettlementv2IntentSettledIterator, error) {
+
+	var intentIdRule []interface{}
+	for _, intentIdItem := range intentId {
+		intentIdRule = append(intentIdRule, intentIdItem)
+	}
+	var makerRule []interface{}
+	for _, makerItem := range maker {
+		makerRule = append(makerRule, makerItem)
+	}
+	var tokenInRule []interface{}
+	for _, tokenInItem := range tokenIn {
+		tokenInRule = append(tokenInRule, tokenInItem)
+	}
+
+	logs, sub, err := _Ifastsettlementv2.contract.FilterLogs(opts, "IntentSettled", intentIdRule, makerRule, tokenInRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Ifastsettlementv2IntentSettledIterator{contract: _Ifastsettlementv2.contract, event: "IntentSettled", logs: logs, sub: sub}, nil
+}
+
+// WatchIntentSettled is a free log subscription operation binding the contract event 0x7218be4da585fc2a7ede6968130b808389cc9481f765af39814696f7e7edd287.
+//
+// Solidity: event IntentSettled(bytes32 indexed intentId, address indexed maker, address indexed tokenIn, address tokenOut, uint256 amountIn, uint256 amountOut, uint256 protocolAmt)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) WatchIntentSettled(opts *bind.WatchOpts, sink chan<- *Ifastsettlementv2IntentSettled, intentId [][32]byte, maker []common.Address, tokenIn []common.Address) (event.Subscription, error) {
+
+	var intentIdRule []interface{}
+	for _, intentIdItem := range intentId {
+		intentIdRule = append(intentIdRule, intentIdItem)
+	}
+	var makerRule []interface{}
+	for _, makerItem := range maker {
+		makerRule = append(makerRule, makerItem)
+	}
+	var tokenInRule []interface{}
+	for _, tokenInItem := range tokenIn {
+		tokenInRule = append(tokenInRule, tokenInItem)
+	}
+
+	logs, sub, err := _Ifastsettlementv2.contract.WatchLogs(opts, "IntentSettled", intentIdRule, makerRule, tokenInRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Ifastsettlementv2IntentSettled)
+				if err := _Ifastsettlementv2.contract.UnpackLog(event, "IntentSettled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseIntentSettled is a log parse operation binding the contract event 0x7218be4da585fc2a7ede6968130b808389cc9481f765af39814696f7e7edd287.
+//
+// Solidity: event IntentSettled(bytes32 indexed intentId, address indexed maker, address indexed tokenIn, address tokenOut, uint256 amountIn, uint256 amountOut, uint256 protocolAmt)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) ParseIntentSettled(log types.Log) (*Ifastsettlementv2IntentSettled, error) {
+	event := new(Ifastsettlementv2IntentSettled)
+	if err := _Ifastsettlementv2.contract.UnpackLog(event, "IntentSettled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Ifastsettlementv2NonceInvalidatedIterator is returned from FilterNonceInvalidated and is used to iterate over the raw logs and unpacked data for NonceInvalidated events raised by the Ifastsettlementv2 contract.
+type Ifastsettlementv2NonceInvalidatedIterator struct {
+	Event *Ifastsettlementv2NonceInvalidated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Ifastsettlementv2NonceInvalidatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Ifastsettlementv2NonceInvalidated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Ifastsettlementv2NonceInvalidated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Ifastsettlementv2NonceInvalidatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Ifastsettlementv2NonceInvalidatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Ifastsettlementv2NonceInvalidated represents a NonceInvalidated event raised by the Ifastsettlementv2 contract.
+type Ifastsettlementv2NonceInvalidated struct {
+	Maker       common.Address
+	NewMinNonce *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterNonceInvalidated is a free log retrieval operation binding the contract event 0x1800cd2301fbc20790ed94f3d55a28ef2306a9c31cd3c72b5b71b6e4cf5c6241.
+//
+// Solidity: event NonceInvalidated(address indexed maker, uint256 newMinNonce)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) FilterNonceInvalidated(opts *bind.FilterOpts, maker []common.Address) (*Ifastsettlementv2NonceInvalidatedIterator, error) {
+
+	var makerRule []interface{}
+	for _, makerItem := range maker {
+		makerRule = append(makerRule, makerItem)
+	}
+
+	logs, sub, err := _Ifastsettlementv2.contract.FilterLogs(opts, "NonceInvalidated", makerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Ifastsettlementv2NonceInvalidatedIterator{contract: _Ifastsettlementv2.contract, event: "NonceInvalidated", logs: logs, sub: sub}, nil
+}
+
+// WatchNonceInvalidated is a free log subscription operation binding the contract event 0x1800cd2301fbc20790ed94f3d55a28ef2306a9c31cd3c72b5b71b6e4cf5c6241.
+//
+// Solidity: event NonceInvalidated(address indexed maker, uint256 newMinNonce)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) WatchNonceInvalidated(opts *bind.WatchOpts, sink chan<- *Ifastsettlementv2NonceInvalidated, maker []common.Address) (event.Subscription, error) {
+
+	var makerRule []interface{}
+	for _, makerItem := range maker {
+		makerRule = append(makerRule, makerItem)
+	}
+
+	logs, sub, err := _Ifastsettlementv2.contract.WatchLogs(opts, "NonceInvalidated", makerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Ifastsettlementv2NonceInvalidated)
+				if err := _Ifastsettlementv2.contract.UnpackLog(event, "NonceInvalidated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNonceInvalidated is a log parse operation binding the contract event 0x1800cd2301fbc20790ed94f3d55a28ef2306a9c31cd3c72b5b71b6e4cf5c6241.
+//
+// Solidity: event NonceInvalidated(address indexed maker, uint256 newMinNonce)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) ParseNonceInvalidated(log types.Log) (*Ifastsettlementv2NonceInvalidated, error) {
+	event := new(Ifastsettlementv2NonceInvalidated)
+	if err := _Ifastsettlementv2.contract.UnpackLog(event, "NonceInvalidated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Ifastsettlementv2SurplusRecipientUpdatedIterator is returned from FilterSurplusRecipientUpdated and is used to iterate over the raw logs and unpacked data for SurplusRecipientUpdated events raised by the Ifastsettlementv2 contract.
+type Ifastsettlementv2SurplusRecipientUpdatedIterator struct {
+	Event *Ifastsettlementv2SurplusRecipientUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Ifastsettlementv2SurplusRecipientUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Ifastsettlementv2SurplusRecipientUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Ifastsettlementv2SurplusRecipientUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Ifastsettlementv2SurplusRecipientUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Ifastsettlementv2SurplusRecipientUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Ifastsettlementv2SurplusRecipientUpdated represents a SurplusRecipientUpdated event raised by the Ifastsettlementv2 contract.
+type Ifastsettlementv2SurplusRecipientUpdated struct {
+	OldRecipient common.Address
+	NewRecipient common.Address
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterSurplusRecipientUpdated is a free log retrieval operation binding the contract event 0x1245c11d665b8a1b73c2e2fffc5df25470dd23a912092165bb6de7406d50193e.
+//
+// Solidity: event SurplusRecipientUpdated(address indexed oldRecipient, address indexed newRecipient)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) FilterSurplusRecipientUpdated(opts *bind.FilterOpts, oldRecipient []common.Address, newRecipient []common.Address) (*Ifastsettlementv2SurplusRecipientUpdatedIterator, error) {
+
+	var oldRecipientRule []interface{}
+	for _, oldRecipientItem := range oldRecipient {
+		oldRecipientRule = append(oldRecipientRule, oldRecipientItem)
+	}
+	var newRecipientRule []interface{}
+	for _, newRecipientItem := range newRecipient {
+		newRecipientRule = append(newRecipientRule, newRecipientItem)
+	}
+
+	logs, sub, err := _Ifastsettlementv2.contract.FilterLogs(opts, "SurplusRecipientUpdated", oldRecipientRule, newRecipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Ifastsettlementv2SurplusRecipientUpdatedIterator{contract: _Ifastsettlementv2.contract, event: "SurplusRecipientUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchSurplusRecipientUpdated is a free log subscription operation binding the contract event 0x1245c11d665b8a1b73c2e2fffc5df25470dd23a912092165bb6de7406d50193e.
+//
+// Solidity: event SurplusRecipientUpdated(address indexed oldRecipient, address indexed newRecipient)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) WatchSurplusRecipientUpdated(opts *bind.WatchOpts, sink chan<- *Ifastsettlementv2SurplusRecipientUpdated, oldRecipient []common.Address, newRecipient []common.Address) (event.Subscription, error) {
+
+	var oldRecipientRule []interface{}
+	for _, oldRecipientItem := range oldRecipient {
+		oldRecipientRule = append(oldRecipientRule, oldRecipientItem)
+	}
+	var newRecipientRule []interface{}
+	for _, newRecipientItem := range newRecipient {
+		newRecipientRule = append(newRecipientRule, newRecipientItem)
+	}
+
+	logs, sub, err := _Ifastsettlementv2.contract.WatchLogs(opts, "SurplusRecipientUpdated", oldRecipientRule, newRecipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Ifastsettlementv2SurplusRecipientUpdated)
+				if err := _Ifastsettlementv2.contract.UnpackLog(event, "SurplusRecipientUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSurplusRecipientUpdated is a log parse operation binding the contract event 0x1245c11d665b8a1b73c2e2fffc5df25470dd23a912092165bb6de7406d50193e.
+//
+// Solidity: event SurplusRecipientUpdated(address indexed oldRecipient, address indexed newRecipient)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) ParseSurplusRecipientUpdated(log types.Log) (*Ifastsettlementv2SurplusRecipientUpdated, error) {
+	event := new(Ifastsettlementv2SurplusRecipientUpdated)
+	if err := _Ifastsettlementv2.contract.UnpackLog(event, "SurplusRecipientUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// Ifastsettlementv2TokensRescuedIterator is returned from FilterTokensRescued and is used to iterate over the raw logs and unpacked data for TokensRescued events raised by the Ifastsettlementv2 contract.
+type Ifastsettlementv2TokensRescuedIterator struct {
+	Event *Ifastsettlementv2TokensRescued // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Ifastsettlementv2TokensRescuedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Ifastsettlementv2TokensRescued)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Ifastsettlementv2TokensRescued)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Ifastsettlementv2TokensRescuedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Ifastsettlementv2TokensRescuedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Ifastsettlementv2TokensRescued represents a TokensRescued event raised by the Ifastsettlementv2 contract.
+type Ifastsettlementv2TokensRescued struct {
+	Token  common.Address
+	To     common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokensRescued is a free log retrieval operation binding the contract event 0x77023e19c7343ad491fd706c36335ca0e738340a91f29b1fd81e2673d44896c4.
+//
+// Solidity: event TokensRescued(address indexed token, address indexed to, uint256 amount)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) FilterTokensRescued(opts *bind.FilterOpts, token []common.Address, to []common.Address) (*Ifastsettlementv2TokensRescuedIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Ifastsettlementv2.contract.FilterLogs(opts, "TokensRescued", tokenRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Ifastsettlementv2TokensRescuedIterator{contract: _Ifastsettlementv2.contract, event: "TokensRescued", logs: logs, sub: sub}, nil
+}
+
+// WatchTokensRescued is a free log subscription operation binding the contract event 0x77023e19c7343ad491fd706c36335ca0e738340a91f29b1fd81e2673d44896c4.
+//
+// Solidity: event TokensRescued(address indexed token, address indexed to, uint256 amount)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) WatchTokensRescued(opts *bind.WatchOpts, sink chan<- *Ifastsettlementv2TokensRescued, token []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _Ifastsettlementv2.contract.WatchLogs(opts, "TokensRescued", tokenRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Ifastsettlementv2TokensRescued)
+				if err := _Ifastsettlementv2.contract.UnpackLog(event, "TokensRescued", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokensRescued is a log parse operation binding the contract event 0x77023e19c7343ad491fd706c36335ca0e738340a91f29b1fd81e2673d44896c4.
+//
+// Solidity: event TokensRescued(address indexed token, address indexed to, uint256 amount)
+func (_Ifastsettlementv2 *Ifastsettlementv2Filterer) ParseTokensRescued(log types.Log) (*Ifastsettlementv2TokensRescued, error) {
+	event := new(Ifastsettlementv2TokensRescued)
+	if err := _Ifastsettlementv2.contract.UnpackLog(event, "TokensRescued", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
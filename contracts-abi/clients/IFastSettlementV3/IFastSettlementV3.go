@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
+	sharedtypes "github.com/primev/fastprotocolapp/contracts-abi/clients/SharedTypes"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -30,23 +31,12 @@ var (
 )
 
 // IFastSettlementV3Intent is an auto generated low-level Go binding around an user-defined struct.
-type IFastSettlementV3Intent struct {
-	User        common.Address
-	InputToken  common.Address
-	OutputToken common.Address
-	InputAmt    *big.Int
-	UserAmtOut  *big.Int
-	Recipient   common.Address
-	Deadline    *big.Int
-	Nonce       *big.Int
-}
+// It is declared once in sharedtypes and shared by every binding that uses it.
+type IFastSettlementV3Intent = sharedtypes.IFastSettlementV3Intent
 
 // IFastSettlementV3SwapCall is an auto generated low-level Go binding around an user-defined struct.
-type IFastSettlementV3SwapCall struct {
-	To    common.Address
-	Value *big.Int
-	Data  []byte
-}
+// It is declared once in sharedtypes and shared by every binding that uses it.
+type IFastSettlementV3SwapCall = sharedtypes.IFastSettlementV3SwapCall
 
 // Ifastsettlementv3MetaData contains all meta data concerning the Ifastsettlementv3 contract.
 var Ifastsettlementv3MetaData = &bind.MetaData{
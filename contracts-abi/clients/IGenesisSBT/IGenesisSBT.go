@@ -0,0 +1,1418 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package igenesissbt
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IgenesissbtMetaData contains all meta data concerning the Igenesissbt contract.
+var IgenesissbtMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"adminMint\",\"inputs\":[{\"name\":\"to\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"approve\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"balanceOf\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"balance\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getApproved\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"asset\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isApprovedForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"mint\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"ownerOf\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setApprovalForAll\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setAssetURI\",\"inputs\":[{\"name\":\"assetURI\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setNftDescription\",\"inputs\":[{\"name\":\"nftDescription\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setNftName\",\"inputs\":[{\"name\":\"nftName\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenURI\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalSupply\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"unpause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Approval\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ApprovalForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BatchMetadataUpdate\",\"inputs\":[{\"name\":\"_fromTokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"_toTokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MetadataUpdate\",\"inputs\":[{\"name\":\"_tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Transfer\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidFallback\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidReceive\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidRecipients\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SoulBoundToken_ApprovalNotAllowed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SoulBoundToken_TransferNotAllowed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"TokenAlreadyMinted\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"TokenNotFound\",\"inputs\":[]}]",
+}
+
+// IgenesissbtABI is the input ABI used to generate the binding from.
+// Deprecated: Use IgenesissbtMetaData.ABI instead.
+var IgenesissbtABI = IgenesissbtMetaData.ABI
+
+// Igenesissbt is an auto generated Go binding around an Ethereum contract.
+type Igenesissbt struct {
+	IgenesissbtCaller     // Read-only binding to the contract
+	IgenesissbtTransactor // Write-only binding to the contract
+	IgenesissbtFilterer   // Log filterer for contract events
+}
+
+// IgenesissbtCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IgenesissbtCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IgenesissbtTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IgenesissbtTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IgenesissbtFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IgenesissbtFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IgenesissbtSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IgenesissbtSession struct {
+	Contract     *Igenesissbt      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IgenesissbtCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IgenesissbtCallerSession struct {
+	Contract *IgenesissbtCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// IgenesissbtTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IgenesissbtTransactorSession struct {
+	Contract     *IgenesissbtTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// IgenesissbtRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IgenesissbtRaw struct {
+	Contract *Igenesissbt // Generic contract binding to access the raw methods on
+}
+
+// IgenesissbtCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IgenesissbtCallerRaw struct {
+	Contract *IgenesissbtCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IgenesissbtTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IgenesissbtTransactorRaw struct {
+	Contract *IgenesissbtTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIgenesissbt creates a new instance of Igenesissbt, bound to a specific deployed contract.
+func NewIgenesissbt(address common.Address, backend bind.ContractBackend) (*Igenesissbt, error) {
+	contract, err := bindIgenesissbt(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Igenesissbt{IgenesissbtCaller: IgenesissbtCaller{contract: contract}, IgenesissbtTransactor: IgenesissbtTransactor{contract: contract}, IgenesissbtFilterer: IgenesissbtFilterer{contract: contract}}, nil
+}
+
+// NewIgenesissbtCaller creates a new read-only instance of Igenesissbt, bound to a specific deployed contract.
+func NewIgenesissbtCaller(address common.Address, caller bind.ContractCaller) (*IgenesissbtCaller, error) {
+	contract, err := bindIgenesissbt(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IgenesissbtCaller{contract: contract}, nil
+}
+
+// NewIgenesissbtTransactor creates a new write-only instance of Igenesissbt, bound to a specific deployed contract.
+func NewIgenesissbtTransactor(address common.Address, transactor bind.ContractTransactor) (*IgenesissbtTransactor, error) {
+	contract, err := bindIgenesissbt(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IgenesissbtTransactor{contract: contract}, nil
+}
+
+// NewIgenesissbtFilterer creates a new log filterer instance of Igenesissbt, bound to a specific deployed contract.
+func NewIgenesissbtFilterer(address common.Address, filterer bind.ContractFilterer) (*IgenesissbtFilterer, error) {
+	contract, err := bindIgenesissbt(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IgenesissbtFilterer{contract: contract}, nil
+}
+
+// bindIgenesissbt binds a generic wrapper to an already deployed contract.
+func bindIgenesissbt(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IgenesissbtMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Igenesissbt *IgenesissbtRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Igenesissbt.Contract.IgenesissbtCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Igenesissbt *IgenesissbtRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.IgenesissbtTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Igenesissbt *IgenesissbtRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.IgenesissbtTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Igenesissbt *IgenesissbtCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Igenesissbt.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Igenesissbt *IgenesissbtTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Igenesissbt *IgenesissbtTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256 balance)
+func (_Igenesissbt *IgenesissbtCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Igenesissbt.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256 balance)
+func (_Igenesissbt *IgenesissbtSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _Igenesissbt.Contract.BalanceOf(&_Igenesissbt.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256 balance)
+func (_Igenesissbt *IgenesissbtCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _Igenesissbt.Contract.BalanceOf(&_Igenesissbt.CallOpts, owner)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address operator)
+func (_Igenesissbt *IgenesissbtCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _Igenesissbt.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address operator)
+func (_Igenesissbt *IgenesissbtSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _Igenesissbt.Contract.GetApproved(&_Igenesissbt.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address operator)
+func (_Igenesissbt *IgenesissbtCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _Igenesissbt.Contract.GetApproved(&_Igenesissbt.CallOpts, tokenId)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_Igenesissbt *IgenesissbtCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _Igenesissbt.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_Igenesissbt *IgenesissbtSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _Igenesissbt.Contract.IsApprovedForAll(&_Igenesissbt.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_Igenesissbt *IgenesissbtCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _Igenesissbt.Contract.IsApprovedForAll(&_Igenesissbt.CallOpts, owner, operator)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address owner)
+func (_Igenesissbt *IgenesissbtCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _Igenesissbt.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address owner)
+func (_Igenesissbt *IgenesissbtSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _Igenesissbt.Contract.OwnerOf(&_Igenesissbt.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address owner)
+func (_Igenesissbt *IgenesissbtCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _Igenesissbt.Contract.OwnerOf(&_Igenesissbt.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_Igenesissbt *IgenesissbtCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _Igenesissbt.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_Igenesissbt *IgenesissbtSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _Igenesissbt.Contract.SupportsInterface(&_Igenesissbt.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_Igenesissbt *IgenesissbtCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _Igenesissbt.Contract.SupportsInterface(&_Igenesissbt.CallOpts, interfaceId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_Igenesissbt *IgenesissbtCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _Igenesissbt.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_Igenesissbt *IgenesissbtSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _Igenesissbt.Contract.TokenURI(&_Igenesissbt.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_Igenesissbt *IgenesissbtCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _Igenesissbt.Contract.TokenURI(&_Igenesissbt.CallOpts, tokenId)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_Igenesissbt *IgenesissbtCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Igenesissbt.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_Igenesissbt *IgenesissbtSession) TotalSupply() (*big.Int, error) {
+	return _Igenesissbt.Contract.TotalSupply(&_Igenesissbt.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_Igenesissbt *IgenesissbtCallerSession) TotalSupply() (*big.Int, error) {
+	return _Igenesissbt.Contract.TotalSupply(&_Igenesissbt.CallOpts)
+}
+
+// AdminMint is a paid mutator transaction binding the contract method 0x21cbb5bd.
+//
+// Solidity: function adminMint(address[] to) returns()
+func (_Igenesissbt *IgenesissbtTransactor) AdminMint(opts *bind.TransactOpts, to []common.Address) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "adminMint", to)
+}
+
+// AdminMint is a paid mutator transaction binding the contract method 0x21cbb5bd.
+//
+// Solidity: function adminMint(address[] to) returns()
+func (_Igenesissbt *IgenesissbtSession) AdminMint(to []common.Address) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.AdminMint(&_Igenesissbt.TransactOpts, to)
+}
+
+// AdminMint is a paid mutator transaction binding the contract method 0x21cbb5bd.
+//
+// Solidity: function adminMint(address[] to) returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) AdminMint(to []common.Address) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.AdminMint(&_Igenesissbt.TransactOpts, to)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_Igenesissbt *IgenesissbtTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_Igenesissbt *IgenesissbtSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.Approve(&_Igenesissbt.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.Approve(&_Igenesissbt.TransactOpts, to, tokenId)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x7ab4339d.
+//
+// Solidity: function initialize(string asset, address owner) returns()
+func (_Igenesissbt *IgenesissbtTransactor) Initialize(opts *bind.TransactOpts, asset string, owner common.Address) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "initialize", asset, owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x7ab4339d.
+//
+// Solidity: function initialize(string asset, address owner) returns()
+func (_Igenesissbt *IgenesissbtSession) Initialize(asset string, owner common.Address) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.Initialize(&_Igenesissbt.TransactOpts, asset, owner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x7ab4339d.
+//
+// Solidity: function initialize(string asset, address owner) returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) Initialize(asset string, owner common.Address) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.Initialize(&_Igenesissbt.TransactOpts, asset, owner)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x1249c58b.
+//
+// Solidity: function mint() returns()
+func (_Igenesissbt *IgenesissbtTransactor) Mint(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "mint")
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x1249c58b.
+//
+// Solidity: function mint() returns()
+func (_Igenesissbt *IgenesissbtSession) Mint() (*types.Transaction, error) {
+	return _Igenesissbt.Contract.Mint(&_Igenesissbt.TransactOpts)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x1249c58b.
+//
+// Solidity: function mint() returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) Mint() (*types.Transaction, error) {
+	return _Igenesissbt.Contract.Mint(&_Igenesissbt.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_Igenesissbt *IgenesissbtTransactor) Pause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "pause")
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_Igenesissbt *IgenesissbtSession) Pause() (*types.Transaction, error) {
+	return _Igenesissbt.Contract.Pause(&_Igenesissbt.TransactOpts)
+}
+
+// Pause is a paid mutator transaction binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) Pause() (*types.Transaction, error) {
+	return _Igenesissbt.Contract.Pause(&_Igenesissbt.TransactOpts)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_Igenesissbt *IgenesissbtTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_Igenesissbt *IgenesissbtSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.SafeTransferFrom(&_Igenesissbt.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.SafeTransferFrom(&_Igenesissbt.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_Igenesissbt *IgenesissbtTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_Igenesissbt *IgenesissbtSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.SafeTransferFrom0(&_Igenesissbt.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.SafeTransferFrom0(&_Igenesissbt.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_Igenesissbt *IgenesissbtTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_Igenesissbt *IgenesissbtSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.SetApprovalForAll(&_Igenesissbt.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.SetApprovalForAll(&_Igenesissbt.TransactOpts, operator, approved)
+}
+
+// SetAssetURI is a paid mutator transaction binding the contract method 0x42cc9c73.
+//
+// Solidity: function setAssetURI(string assetURI) returns()
+func (_Igenesissbt *IgenesissbtTransactor) SetAssetURI(opts *bind.TransactOpts, assetURI string) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "setAssetURI", assetURI)
+}
+
+// SetAssetURI is a paid mutator transaction binding the contract method 0x42cc9c73.
+//
+// Solidity: function setAssetURI(string assetURI) returns()
+func (_Igenesissbt *IgenesissbtSession) SetAssetURI(assetURI string) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.SetAssetURI(&_Igenesissbt.TransactOpts, assetURI)
+}
+
+// SetAssetURI is a paid mutator transaction binding the contract method 0x42cc9c73.
+//
+// Solidity: function setAssetURI(string assetURI) returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) SetAssetURI(assetURI string) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.SetAssetURI(&_Igenesissbt.TransactOpts, assetURI)
+}
+
+// SetNftDescription is a paid mutator transaction binding the contract method 0x42b545fc.
+//
+// Solidity: function setNftDescription(string nftDescription) returns()
+func (_Igenesissbt *IgenesissbtTransactor) SetNftDescription(opts *bind.TransactOpts, nftDescription string) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "setNftDescription", nftDescription)
+}
+
+// SetNftDescription is a paid mutator transaction binding the contract method 0x42b545fc.
+//
+// Solidity: function setNftDescription(string nftDescription) returns()
+func (_Igenesissbt *IgenesissbtSession) SetNftDescription(nftDescription string) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.SetNftDescription(&_Igenesissbt.TransactOpts, nftDescription)
+}
+
+// SetNftDescription is a paid mutator transaction binding the contract method 0x42b545fc.
+//
+// Solidity: function setNftDescription(string nftDescription) returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) SetNftDescription(nftDescription string) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.SetNftDescription(&_Igenesissbt.TransactOpts, nftDescription)
+}
+
+// SetNftName is a paid mutator transaction binding the contract method 0x0ad7c86f.
+//
+// Solidity: function setNftName(string nftName) returns()
+func (_Igenesissbt *IgenesissbtTransactor) SetNftName(opts *bind.TransactOpts, nftName string) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "setNftName", nftName)
+}
+
+// SetNftName is a paid mutator transaction binding the contract method 0x0ad7c86f.
+//
+// Solidity: function setNftName(string nftName) returns()
+func (_Igenesissbt *IgenesissbtSession) SetNftName(nftName string) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.SetNftName(&_Igenesissbt.TransactOpts, nftName)
+}
+
+// SetNftName is a paid mutator transaction binding the contract method 0x0ad7c86f.
+//
+// Solidity: function setNftName(string nftName) returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) SetNftName(nftName string) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.SetNftName(&_Igenesissbt.TransactOpts, nftName)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_Igenesissbt *IgenesissbtTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_Igenesissbt *IgenesissbtSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.TransferFrom(&_Igenesissbt.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _Igenesissbt.Contract.TransferFrom(&_Igenesissbt.TransactOpts, from, to, tokenId)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_Igenesissbt *IgenesissbtTransactor) Unpause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Igenesissbt.contract.Transact(opts, "unpause")
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_Igenesissbt *IgenesissbtSession) Unpause() (*types.Transaction, error) {
+	return _Igenesissbt.Contract.Unpause(&_Igenesissbt.TransactOpts)
+}
+
+// Unpause is a paid mutator transaction binding the contract method 0x3f4ba83a.
+//
+// Solidity: function unpause() returns()
+func (_Igenesissbt *IgenesissbtTransactorSession) Unpause() (*types.Transaction, error) {
+	return _Igenesissbt.Contract.Unpause(&_Igenesissbt.TransactOpts)
+}
+
+// IgenesissbtApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the Igenesissbt contract.
+type IgenesissbtApprovalIterator struct {
+	Event *IgenesissbtApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IgenesissbtApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IgenesissbtApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IgenesissbtApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IgenesissbtApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IgenesissbtApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IgenesissbtApproval represents a Approval event raised by the Igenesissbt contract.
+type IgenesissbtApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_Igenesissbt *IgenesissbtFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*IgenesissbtApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _Igenesissbt.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IgenesissbtApprovalIterator{contract: _Igenesissbt.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_Igenesissbt *IgenesissbtFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *IgenesissbtApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _Igenesissbt.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IgenesissbtApproval)
+				if err := _Igenesissbt.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_Igenesissbt *IgenesissbtFilterer) ParseApproval(log types.Log) (*IgenesissbtApproval, error) {
+	event := new(IgenesissbtApproval)
+	if err := _Igenesissbt.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IgenesissbtApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the Igenesissbt contract.
+type IgenesissbtApprovalForAllIterator struct {
+	Event *IgenesissbtApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IgenesissbtApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IgenesissbtApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IgenesissbtApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IgenesissbtApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IgenesissbtApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IgenesissbtApprovalForAll represents a ApprovalForAll event raised by the Igenesissbt contract.
+type IgenesissbtApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_Igenesissbt *IgenesissbtFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*IgenesissbtApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _Igenesissbt.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IgenesissbtApprovalForAllIterator{contract: _Igenesissbt.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_Igenesissbt *IgenesissbtFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *IgenesissbtApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _Igenesissbt.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IgenesissbtApprovalForAll)
+				if err := _Igenesissbt.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_Igenesissbt *IgenesissbtFilterer) ParseApprovalForAll(log types.Log) (*IgenesissbtApprovalForAll, error) {
+	event := new(IgenesissbtApprovalForAll)
+	if err := _Igenesissbt.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IgenesissbtBatchMetadataUpdateIterator is returned from FilterBatchMetadataUpdate and is used to iterate over the raw logs and unpacked data for BatchMetadataUpdate events raised by the Igenesissbt contract.
+type IgenesissbtBatchMetadataUpdateIterator struct {
+	Event *IgenesissbtBatchMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IgenesissbtBatchMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IgenesissbtBatchMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IgenesissbtBatchMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IgenesissbtBatchMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IgenesissbtBatchMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IgenesissbtBatchMetadataUpdate represents a BatchMetadataUpdate event raised by the Igenesissbt contract.
+type IgenesissbtBatchMetadataUpdate struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchMetadataUpdate is a free log retrieval operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_Igenesissbt *IgenesissbtFilterer) FilterBatchMetadataUpdate(opts *bind.FilterOpts) (*IgenesissbtBatchMetadataUpdateIterator, error) {
+
+	logs, sub, err := _Igenesissbt.contract.FilterLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &IgenesissbtBatchMetadataUpdateIterator{contract: _Igenesissbt.contract, event: "BatchMetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchMetadataUpdate is a free log subscription operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_Igenesissbt *IgenesissbtFilterer) WatchBatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *IgenesissbtBatchMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _Igenesissbt.contract.WatchLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IgenesissbtBatchMetadataUpdate)
+				if err := _Igenesissbt.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchMetadataUpdate is a log parse operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_Igenesissbt *IgenesissbtFilterer) ParseBatchMetadataUpdate(log types.Log) (*IgenesissbtBatchMetadataUpdate, error) {
+	event := new(IgenesissbtBatchMetadataUpdate)
+	if err := _Igenesissbt.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IgenesissbtMetadataUpdateIterator is returned from FilterMetadataUpdate and is used to iterate over the raw logs and unpacked data for MetadataUpdate events raised by the Igenesissbt contract.
+type IgenesissbtMetadataUpdateIterator struct {
+	Event *IgenesissbtMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IgenesissbtMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IgenesissbtMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IgenesissbtMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IgenesissbtMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IgenesissbtMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IgenesissbtMetadataUpdate represents a MetadataUpdate event raised by the Igenesissbt contract.
+type IgenesissbtMetadataUpdate struct {
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMetadataUpdate is a free log retrieval operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_Igenesissbt *IgenesissbtFilterer) FilterMetadataUpdate(opts *bind.FilterOpts) (*IgenesissbtMetadataUpdateIterator, error) {
+
+	logs, sub, err := _Igenesissbt.contract.FilterLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &IgenesissbtMetadataUpdateIterator{contract: _Igenesissbt.contract, event: "MetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchMetadataUpdate is a free log subscription operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_Igenesissbt *IgenesissbtFilterer) WatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *IgenesissbtMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _Igenesissbt.contract.WatchLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IgenesissbtMetadataUpdate)
+				if err := _Igenesissbt.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMetadataUpdate is a log parse operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_Igenesissbt *IgenesissbtFilterer) ParseMetadataUpdate(log types.Log) (*IgenesissbtMetadataUpdate, error) {
+	event := new(IgenesissbtMetadataUpdate)
+	if err := _Igenesissbt.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IgenesissbtTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the Igenesissbt contract.
+type IgenesissbtTransferIterator struct {
+	Event *IgenesissbtTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IgenesissbtTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IgenesissbtTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IgenesissbtTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IgenesissbtTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IgenesissbtTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IgenesissbtTransfer represents a Transfer event raised by the Igenesissbt contract.
+type IgenesissbtTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_Igenesissbt *IgenesissbtFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*IgenesissbtTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _Igenesissbt.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IgenesissbtTransferIterator{contract: _Igenesissbt.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_Igenesissbt *IgenesissbtFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *IgenesissbtTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _Igenesissbt.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IgenesissbtTransfer)
+				if err := _Igenesissbt.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_Igenesissbt *IgenesissbtFilterer) ParseTransfer(log types.Log) (*IgenesissbtTransfer, error) {
+	event := new(IgenesissbtTransfer)
+	if err := _Igenesissbt.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,236 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package imulticall3
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	sharedtypes "github.com/primev/fastprotocolapp/contracts-abi/clients/SharedTypes"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IMulticall3Call3 is an auto generated low-level Go binding around an user-defined struct.
+// It is declared once in sharedtypes and shared by every binding that uses it.
+type IMulticall3Call3 = sharedtypes.IMulticall3Call3
+
+// IMulticall3Call3Value is an auto generated low-level Go binding around an user-defined struct.
+// It is declared once in sharedtypes and shared by every binding that uses it.
+type IMulticall3Call3Value = sharedtypes.IMulticall3Call3Value
+
+// IMulticall3Result is an auto generated low-level Go binding around an user-defined struct.
+// It is declared once in sharedtypes and shared by every binding that uses it.
+type IMulticall3Result = sharedtypes.IMulticall3Result
+
+// Imulticall3MetaData contains all meta data concerning the Imulticall3 contract.
+var Imulticall3MetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"aggregate3\",\"inputs\":[{\"name\":\"calls\",\"type\":\"tuple[]\",\"internalType\":\"structIMulticall3.Call3[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"allowFailure\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[{\"name\":\"returnData\",\"type\":\"tuple[]\",\"internalType\":\"structIMulticall3.Result[]\",\"components\":[{\"name\":\"success\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"returnData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"aggregate3Value\",\"inputs\":[{\"name\":\"calls\",\"type\":\"tuple[]\",\"internalType\":\"structIMulticall3.Call3Value[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"allowFailure\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[{\"name\":\"returnData\",\"type\":\"tuple[]\",\"internalType\":\"structIMulticall3.Result[]\",\"components\":[{\"name\":\"success\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"returnData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"payable\"}]",
+}
+
+// Imulticall3ABI is the input ABI used to generate the binding from.
+// Deprecated: Use Imulticall3MetaData.ABI instead.
+var Imulticall3ABI = Imulticall3MetaData.ABI
+
+// Imulticall3 is an auto generated Go binding around an Ethereum contract.
+type Imulticall3 struct {
+	Imulticall3Caller     // Read-only binding to the contract
+	Imulticall3Transactor // Write-only binding to the contract
+	Imulticall3Filterer   // Log filterer for contract events
+}
+
+// Imulticall3Caller is an auto generated read-only Go binding around an Ethereum contract.
+type Imulticall3Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Imulticall3Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type Imulticall3Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Imulticall3Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Imulticall3Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Imulticall3Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Imulticall3Session struct {
+	Contract     *Imulticall3      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// Imulticall3CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Imulticall3CallerSession struct {
+	Contract *Imulticall3Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// Imulticall3TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Imulticall3TransactorSession struct {
+	Contract     *Imulticall3Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// Imulticall3Raw is an auto generated low-level Go binding around an Ethereum contract.
+type Imulticall3Raw struct {
+	Contract *Imulticall3 // Generic contract binding to access the raw methods on
+}
+
+// Imulticall3CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Imulticall3CallerRaw struct {
+	Contract *Imulticall3Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// Imulticall3TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Imulticall3TransactorRaw struct {
+	Contract *Imulticall3Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewImulticall3 creates a new instance of Imulticall3, bound to a specific deployed contract.
+func NewImulticall3(address common.Address, backend bind.ContractBackend) (*Imulticall3, error) {
+	contract, err := bindImulticall3(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Imulticall3{Imulticall3Caller: Imulticall3Caller{contract: contract}, Imulticall3Transactor: Imulticall3Transactor{contract: contract}, Imulticall3Filterer: Imulticall3Filterer{contract: contract}}, nil
+}
+
+// NewImulticall3Caller creates a new read-only instance of Imulticall3, bound to a specific deployed contract.
+func NewImulticall3Caller(address common.Address, caller bind.ContractCaller) (*Imulticall3Caller, error) {
+	contract, err := bindImulticall3(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Imulticall3Caller{contract: contract}, nil
+}
+
+// NewImulticall3Transactor creates a new write-only instance of Imulticall3, bound to a specific deployed contract.
+func NewImulticall3Transactor(address common.Address, transactor bind.ContractTransactor) (*Imulticall3Transactor, error) {
+	contract, err := bindImulticall3(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Imulticall3Transactor{contract: contract}, nil
+}
+
+// NewImulticall3Filterer creates a new log filterer instance of Imulticall3, bound to a specific deployed contract.
+func NewImulticall3Filterer(address common.Address, filterer bind.ContractFilterer) (*Imulticall3Filterer, error) {
+	contract, err := bindImulticall3(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Imulticall3Filterer{contract: contract}, nil
+}
+
+// bindImulticall3 binds a generic wrapper to an already deployed contract.
+func bindImulticall3(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Imulticall3MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Imulticall3 *Imulticall3Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Imulticall3.Contract.Imulticall3Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Imulticall3 *Imulticall3Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Imulticall3.Contract.Imulticall3Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Imulticall3 *Imulticall3Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Imulticall3.Contract.Imulticall3Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Imulticall3 *Imulticall3CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Imulticall3.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Imulticall3 *Imulticall3TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Imulticall3.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Imulticall3 *Imulticall3TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Imulticall3.Contract.contract.Transact(opts, method, params...)
+}
+
+// Aggregate3 is a paid mutator transaction binding the contract method 0x82ad56cb.
+//
+// Solidity: function aggregate3((address,bool,bytes)[] calls) payable returns((bool,bytes)[] returnData)
+func (_Imulticall3 *Imulticall3Transactor) Aggregate3(opts *bind.TransactOpts, calls []IMulticall3Call3) (*types.Transaction, error) {
+	return _Imulticall3.contract.Transact(opts, "aggregate3", calls)
+}
+
+// Aggregate3 is a paid mutator transaction binding the contract method 0x82ad56cb.
+//
+// Solidity: function aggregate3((address,bool,bytes)[] calls) payable returns((bool,bytes)[] returnData)
+func (_Imulticall3 *Imulticall3Session) Aggregate3(calls []IMulticall3Call3) (*types.Transaction, error) {
+	return _Imulticall3.Contract.Aggregate3(&_Imulticall3.TransactOpts, calls)
+}
+
+// Aggregate3 is a paid mutator transaction binding the contract method 0x82ad56cb.
+//
+// Solidity: function aggregate3((address,bool,bytes)[] calls) payable returns((bool,bytes)[] returnData)
+func (_Imulticall3 *Imulticall3TransactorSession) Aggregate3(calls []IMulticall3Call3) (*types.Transaction, error) {
+	return _Imulticall3.Contract.Aggregate3(&_Imulticall3.TransactOpts, calls)
+}
+
+// Aggregate3Value is a paid mutator transaction binding the contract method 0x174dea71.
+//
+// Solidity: function aggregate3Value((address,bool,uint256,bytes)[] calls) payable returns((bool,bytes)[] returnData)
+func (_Imulticall3 *Imulticall3Transactor) Aggregate3Value(opts *bind.TransactOpts, calls []IMulticall3Call3Value) (*types.Transaction, error) {
+	return _Imulticall3.contract.Transact(opts, "aggregate3Value", calls)
+}
+
+// Aggregate3Value is a paid mutator transaction binding the contract method 0x174dea71.
+//
+// Solidity: function aggregate3Value((address,bool,uint256,bytes)[] calls) payable returns((bool,bytes)[] returnData)
+func (_Imulticall3 *Imulticall3Session) Aggregate3Value(calls []IMulticall3Call3Value) (*types.Transaction, error) {
+	return _Imulticall3.Contract.Aggregate3Value(&_Imulticall3.TransactOpts, calls)
+}
+
+// Aggregate3Value is a paid mutator transaction binding the contract method 0x174dea71.
+//
+// Solidity: function aggregate3Value((address,bool,uint256,bytes)[] calls) payable returns((bool,bytes)[] returnData)
+func (_Imulticall3 *Imulticall3TransactorSession) Aggregate3Value(calls []IMulticall3Call3Value) (*types.Transaction, error) {
+	return _Imulticall3.Contract.Aggregate3Value(&_Imulticall3.TransactOpts, calls)
+}
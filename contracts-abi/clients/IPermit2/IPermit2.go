@@ -0,0 +1,242 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ipermit2
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IPermit2PermitTransferFrom is an auto generated low-level Go binding around an user-defined struct.
+type IPermit2PermitTransferFrom struct {
+	Permitted IPermit2TokenPermissions
+	Nonce     *big.Int
+	Deadline  *big.Int
+}
+
+// IPermit2SignatureTransferDetails is an auto generated low-level Go binding around an user-defined struct.
+type IPermit2SignatureTransferDetails struct {
+	To              common.Address
+	RequestedAmount *big.Int
+}
+
+// IPermit2TokenPermissions is an auto generated low-level Go binding around an user-defined struct.
+type IPermit2TokenPermissions struct {
+	Token  common.Address
+	Amount *big.Int
+}
+
+// Ipermit2MetaData contains all meta data concerning the Ipermit2 contract.
+var Ipermit2MetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"permitTransferFrom\",\"inputs\":[{\"name\":\"permit\",\"type\":\"tuple\",\"internalType\":\"structIPermit2.PermitTransferFrom\",\"components\":[{\"name\":\"permitted\",\"type\":\"tuple\",\"internalType\":\"structIPermit2.TokenPermissions\",\"components\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"transferDetails\",\"type\":\"tuple\",\"internalType\":\"structIPermit2.SignatureTransferDetails\",\"components\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"requestedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"permitWitnessTransferFrom\",\"inputs\":[{\"name\":\"permit\",\"type\":\"tuple\",\"internalType\":\"structIPermit2.PermitTransferFrom\",\"components\":[{\"name\":\"permitted\",\"type\":\"tuple\",\"internalType\":\"structIPermit2.TokenPermissions\",\"components\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"transferDetails\",\"type\":\"tuple\",\"internalType\":\"structIPermit2.SignatureTransferDetails\",\"components\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"requestedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"witness\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"witnessTypeString\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"}]",
+}
+
+// Ipermit2ABI is the input ABI used to generate the binding from.
+// Deprecated: Use Ipermit2MetaData.ABI instead.
+var Ipermit2ABI = Ipermit2MetaData.ABI
+
+// Ipermit2 is an auto generated Go binding around an Ethereum contract.
+type Ipermit2 struct {
+	Ipermit2Caller     // Read-only binding to the contract
+	Ipermit2Transactor // Write-only binding to the contract
+	Ipermit2Filterer   // Log filterer for contract events
+}
+
+// Ipermit2Caller is an auto generated read-only Go binding around an Ethereum contract.
+type Ipermit2Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Ipermit2Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type Ipermit2Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Ipermit2Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Ipermit2Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Ipermit2Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Ipermit2Session struct {
+	Contract     *Ipermit2         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// Ipermit2CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Ipermit2CallerSession struct {
+	Contract *Ipermit2Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// Ipermit2TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Ipermit2TransactorSession struct {
+	Contract     *Ipermit2Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// Ipermit2Raw is an auto generated low-level Go binding around an Ethereum contract.
+type Ipermit2Raw struct {
+	Contract *Ipermit2 // Generic contract binding to access the raw methods on
+}
+
+// Ipermit2CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Ipermit2CallerRaw struct {
+	Contract *Ipermit2Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// Ipermit2TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Ipermit2TransactorRaw struct {
+	Contract *Ipermit2Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIpermit2 creates a new instance of Ipermit2, bound to a specific deployed contract.
+func NewIpermit2(address common.Address, backend bind.ContractBackend) (*Ipermit2, error) {
+	contract, err := bindIpermit2(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Ipermit2{Ipermit2Caller: Ipermit2Caller{contract: contract}, Ipermit2Transactor: Ipermit2Transactor{contract: contract}, Ipermit2Filterer: Ipermit2Filterer{contract: contract}}, nil
+}
+
+// NewIpermit2Caller creates a new read-only instance of Ipermit2, bound to a specific deployed contract.
+func NewIpermit2Caller(address common.Address, caller bind.ContractCaller) (*Ipermit2Caller, error) {
+	contract, err := bindIpermit2(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Ipermit2Caller{contract: contract}, nil
+}
+
+// NewIpermit2Transactor creates a new write-only instance of Ipermit2, bound to a specific deployed contract.
+func NewIpermit2Transactor(address common.Address, transactor bind.ContractTransactor) (*Ipermit2Transactor, error) {
+	contract, err := bindIpermit2(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Ipermit2Transactor{contract: contract}, nil
+}
+
+// NewIpermit2Filterer creates a new log filterer instance of Ipermit2, bound to a specific deployed contract.
+func NewIpermit2Filterer(address common.Address, filterer bind.ContractFilterer) (*Ipermit2Filterer, error) {
+	contract, err := bindIpermit2(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Ipermit2Filterer{contract: contract}, nil
+}
+
+// bindIpermit2 binds a generic wrapper to an already deployed contract.
+func bindIpermit2(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Ipermit2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Ipermit2 *Ipermit2Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Ipermit2.Contract.Ipermit2Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Ipermit2 *Ipermit2Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Ipermit2.Contract.Ipermit2Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Ipermit2 *Ipermit2Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Ipermit2.Contract.Ipermit2Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Ipermit2 *Ipermit2CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Ipermit2.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Ipermit2 *Ipermit2TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Ipermit2.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Ipermit2 *Ipermit2TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Ipermit2.Contract.contract.Transact(opts, method, params...)
+}
+
+// PermitTransferFrom is a paid mutator transaction binding the contract method 0x30f28b7a.
+//
+// Solidity: function permitTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes signature) returns()
+func (_Ipermit2 *Ipermit2Transactor) PermitTransferFrom(opts *bind.TransactOpts, permit IPermit2PermitTransferFrom, transferDetails IPermit2SignatureTransferDetails, owner common.Address, signature []byte) (*types.Transaction, error) {
+	return _Ipermit2.contract.Transact(opts, "permitTransferFrom", permit, transferDetails, owner, signature)
+}
+
+// PermitTransferFrom is a paid mutator transaction binding the contract method 0x30f28b7a.
+//
+// Solidity: function permitTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes signature) returns()
+func (_Ipermit2 *Ipermit2Session) PermitTransferFrom(permit IPermit2PermitTransferFrom, transferDetails IPermit2SignatureTransferDetails, owner common.Address, signature []byte) (*types.Transaction, error) {
+	return _Ipermit2.Contract.PermitTransferFrom(&_Ipermit2.TransactOpts, permit, transferDetails, owner, signature)
+}
+
+// PermitTransferFrom is a paid mutator transaction binding the contract method 0x30f28b7a.
+//
+// Solidity: function permitTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes signature) returns()
+func (_Ipermit2 *Ipermit2TransactorSession) PermitTransferFrom(permit IPermit2PermitTransferFrom, transferDetails IPermit2SignatureTransferDetails, owner common.Address, signature []byte) (*types.Transaction, error) {
+	return _Ipermit2.Contract.PermitTransferFrom(&_Ipermit2.TransactOpts, permit, transferDetails, owner, signature)
+}
+
+// PermitWitnessTransferFrom is a paid mutator transaction binding the contract method 0x137c29fe.
+//
+// Solidity: function permitWitnessTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes32 witness, string witnessTypeString, bytes signature) returns()
+func (_Ipermit2 *Ipermit2Transactor) PermitWitnessTransferFrom(opts *bind.TransactOpts, permit IPermit2PermitTransferFrom, transferDetails IPermit2SignatureTransferDetails, owner common.Address, witness [32]byte, witnessTypeString string, signature []byte) (*types.Transaction, error) {
+	return _Ipermit2.contract.Transact(opts, "permitWitnessTransferFrom", permit, transferDetails, owner, witness, witnessTypeString, signature)
+}
+
+// PermitWitnessTransferFrom is a paid mutator transaction binding the contract method 0x137c29fe.
+//
+// Solidity: function permitWitnessTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes32 witness, string witnessTypeString, bytes signature) returns()
+func (_Ipermit2 *Ipermit2Session) PermitWitnessTransferFrom(permit IPermit2PermitTransferFrom, transferDetails IPermit2SignatureTransferDetails, owner common.Address, witness [32]byte, witnessTypeString string, signature []byte) (*types.Transaction, error) {
+	return _Ipermit2.Contract.PermitWitnessTransferFrom(&_Ipermit2.TransactOpts, permit, transferDetails, owner, witness, witnessTypeString, signature)
+}
+
+// PermitWitnessTransferFrom is a paid mutator transaction binding the contract method 0x137c29fe.
+//
+// Solidity: function permitWitnessTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes32 witness, string witnessTypeString, bytes signature) returns()
+func (_Ipermit2 *Ipermit2TransactorSession) PermitWitnessTransferFrom(permit IPermit2PermitTransferFrom, transferDetails IPermit2SignatureTransferDetails, owner common.Address, witness [32]byte, witnessTypeString string, signature []byte) (*types.Transaction, error) {
+	return _Ipermit2.Contract.PermitWitnessTransferFrom(&_Ipermit2.TransactOpts, permit, transferDetails, owner, witness, witnessTypeString, signature)
+}
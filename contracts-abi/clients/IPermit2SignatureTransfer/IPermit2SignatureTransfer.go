@@ -0,0 +1,471 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ipermit2signaturetransfer
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IPermit2SignatureTransferPermitTransferFrom is an auto generated low-level Go binding around an user-defined struct.
+type IPermit2SignatureTransferPermitTransferFrom struct {
+	Permitted IPermit2SignatureTransferTokenPermissions
+	Nonce     *big.Int
+	Deadline  *big.Int
+}
+
+// IPermit2SignatureTransferSignatureTransferDetails is an auto generated low-level Go binding around an user-defined struct.
+type IPermit2SignatureTransferSignatureTransferDetails struct {
+	To              common.Address
+	RequestedAmount *big.Int
+}
+
+// IPermit2SignatureTransferTokenPermissions is an auto generated low-level Go binding around an user-defined struct.
+type IPermit2SignatureTransferTokenPermissions struct {
+	Token  common.Address
+	Amount *big.Int
+}
+
+// Ipermit2signaturetransferMetaData contains all meta data concerning the Ipermit2signaturetransfer contract.
+var Ipermit2signaturetransferMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"DOMAIN_SEPARATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"invalidateUnorderedNonces\",\"inputs\":[{\"name\":\"wordPos\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"mask\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"nonceBitmap\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"wordPos\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"permitTransferFrom\",\"inputs\":[{\"name\":\"permit\",\"type\":\"tuple\",\"internalType\":\"structIPermit2SignatureTransfer.PermitTransferFrom\",\"components\":[{\"name\":\"permitted\",\"type\":\"tuple\",\"internalType\":\"structIPermit2SignatureTransfer.TokenPermissions\",\"components\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"transferDetails\",\"type\":\"tuple\",\"internalType\":\"structIPermit2SignatureTransfer.SignatureTransferDetails\",\"components\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"requestedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"permitWitnessTransferFrom\",\"inputs\":[{\"name\":\"permit\",\"type\":\"tuple\",\"internalType\":\"structIPermit2SignatureTransfer.PermitTransferFrom\",\"components\":[{\"name\":\"permitted\",\"type\":\"tuple\",\"internalType\":\"structIPermit2SignatureTransfer.TokenPermissions\",\"components\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"transferDetails\",\"type\":\"tuple\",\"internalType\":\"structIPermit2SignatureTransfer.SignatureTransferDetails\",\"components\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"requestedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"witness\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"witnessTypeString\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"UnorderedNonceInvalidation\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"word\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"mask\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidAmount\",\"inputs\":[{\"name\":\"maxAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"LengthMismatch\",\"inputs\":[]}]",
+}
+
+// Ipermit2signaturetransferABI is the input ABI used to generate the binding from.
+// Deprecated: Use Ipermit2signaturetransferMetaData.ABI instead.
+var Ipermit2signaturetransferABI = Ipermit2signaturetransferMetaData.ABI
+
+// Ipermit2signaturetransfer is an auto generated Go binding around an Ethereum contract.
+type Ipermit2signaturetransfer struct {
+	Ipermit2signaturetransferCaller     // Read-only binding to the contract
+	Ipermit2signaturetransferTransactor // Write-only binding to the contract
+	Ipermit2signaturetransferFilterer   // Log filterer for contract events
+}
+
+// Ipermit2signaturetransferCaller is an auto generated read-only Go binding around an Ethereum contract.
+type Ipermit2signaturetransferCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Ipermit2signaturetransferTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type Ipermit2signaturetransferTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Ipermit2signaturetransferFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Ipermit2signaturetransferFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Ipermit2signaturetransferSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Ipermit2signaturetransferSession struct {
+	Contract     *Ipermit2signaturetransfer // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts              // Call options to use throughout this session
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// Ipermit2signaturetransferCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Ipermit2signaturetransferCallerSession struct {
+	Contract *Ipermit2signaturetransferCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                    // Call options to use throughout this session
+}
+
+// Ipermit2signaturetransferTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Ipermit2signaturetransferTransactorSession struct {
+	Contract     *Ipermit2signaturetransferTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                    // Transaction auth options to use throughout this session
+}
+
+// Ipermit2signaturetransferRaw is an auto generated low-level Go binding around an Ethereum contract.
+type Ipermit2signaturetransferRaw struct {
+	Contract *Ipermit2signaturetransfer // Generic contract binding to access the raw methods on
+}
+
+// Ipermit2signaturetransferCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Ipermit2signaturetransferCallerRaw struct {
+	Contract *Ipermit2signaturetransferCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// Ipermit2signaturetransferTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Ipermit2signaturetransferTransactorRaw struct {
+	Contract *Ipermit2signaturetransferTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIpermit2signaturetransfer creates a new instance of Ipermit2signaturetransfer, bound to a specific deployed contract.
+func NewIpermit2signaturetransfer(address common.Address, backend bind.ContractBackend) (*Ipermit2signaturetransfer, error) {
+	contract, err := bindIpermit2signaturetransfer(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Ipermit2signaturetransfer{Ipermit2signaturetransferCaller: Ipermit2signaturetransferCaller{contract: contract}, Ipermit2signaturetransferTransactor: Ipermit2signaturetransferTransactor{contract: contract}, Ipermit2signaturetransferFilterer: Ipermit2signaturetransferFilterer{contract: contract}}, nil
+}
+
+// NewIpermit2signaturetransferCaller creates a new read-only instance of Ipermit2signaturetransfer, bound to a specific deployed contract.
+func NewIpermit2signaturetransferCaller(address common.Address, caller bind.ContractCaller) (*Ipermit2signaturetransferCaller, error) {
+	contract, err := bindIpermit2signaturetransfer(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Ipermit2signaturetransferCaller{contract: contract}, nil
+}
+
+// NewIpermit2signaturetransferTransactor creates a new write-only instance of Ipermit2signaturetransfer, bound to a specific deployed contract.
+func NewIpermit2signaturetransferTransactor(address common.Address, transactor bind.ContractTransactor) (*Ipermit2signaturetransferTransactor, error) {
+	contract, err := bindIpermit2signaturetransfer(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Ipermit2signaturetransferTransactor{contract: contract}, nil
+}
+
+// NewIpermit2signaturetransferFilterer creates a new log filterer instance of Ipermit2signaturetransfer, bound to a specific deployed contract.
+func NewIpermit2signaturetransferFilterer(address common.Address, filterer bind.ContractFilterer) (*Ipermit2signaturetransferFilterer, error) {
+	contract, err := bindIpermit2signaturetransfer(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Ipermit2signaturetransferFilterer{contract: contract}, nil
+}
+
+// bindIpermit2signaturetransfer binds a generic wrapper to an already deployed contract.
+func bindIpermit2signaturetransfer(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Ipermit2signaturetransferMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Ipermit2signaturetransfer.Contract.Ipermit2signaturetransferCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.Contract.Ipermit2signaturetransferTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.Contract.Ipermit2signaturetransferTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Ipermit2signaturetransfer.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.Contract.contract.Transact(opts, method, params...)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferCaller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Ipermit2signaturetransfer.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _Ipermit2signaturetransfer.Contract.DOMAINSEPARATOR(&_Ipermit2signaturetransfer.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferCallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _Ipermit2signaturetransfer.Contract.DOMAINSEPARATOR(&_Ipermit2signaturetransfer.CallOpts)
+}
+
+// NonceBitmap is a free data retrieval call binding the contract method 0x4fe02b44.
+//
+// Solidity: function nonceBitmap(address owner, uint256 wordPos) view returns(uint256)
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferCaller) NonceBitmap(opts *bind.CallOpts, owner common.Address, wordPos *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _Ipermit2signaturetransfer.contract.Call(opts, &out, "nonceBitmap", owner, wordPos)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NonceBitmap is a free data retrieval call binding the contract method 0x4fe02b44.
+//
+// Solidity: function nonceBitmap(address owner, uint256 wordPos) view returns(uint256)
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferSession) NonceBitmap(owner common.Address, wordPos *big.Int) (*big.Int, error) {
+	return _Ipermit2signaturetransfer.Contract.NonceBitmap(&_Ipermit2signaturetransfer.CallOpts, owner, wordPos)
+}
+
+// NonceBitmap is a free data retrieval call binding the contract method 0x4fe02b44.
+//
+// Solidity: function nonceBitmap(address owner, uint256 wordPos) view returns(uint256)
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferCallerSession) NonceBitmap(owner common.Address, wordPos *big.Int) (*big.Int, error) {
+	return _Ipermit2signaturetransfer.Contract.NonceBitmap(&_Ipermit2signaturetransfer.CallOpts, owner, wordPos)
+}
+
+// InvalidateUnorderedNonces is a paid mutator transaction binding the contract method 0x3ff9dcb1.
+//
+// Solidity: function invalidateUnorderedNonces(uint256 wordPos, uint256 mask) returns()
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferTransactor) InvalidateUnorderedNonces(opts *bind.TransactOpts, wordPos *big.Int, mask *big.Int) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.contract.Transact(opts, "invalidateUnorderedNonces", wordPos, mask)
+}
+
+// InvalidateUnorderedNonces is a paid mutator transaction binding the contract method 0x3ff9dcb1.
+//
+// Solidity: function invalidateUnorderedNonces(uint256 wordPos, uint256 mask) returns()
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferSession) InvalidateUnorderedNonces(wordPos *big.Int, mask *big.Int) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.Contract.InvalidateUnorderedNonces(&_Ipermit2signaturetransfer.TransactOpts, wordPos, mask)
+}
+
+// InvalidateUnorderedNonces is a paid mutator transaction binding the contract method 0x3ff9dcb1.
+//
+// Solidity: function invalidateUnorderedNonces(uint256 wordPos, uint256 mask) returns()
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferTransactorSession) InvalidateUnorderedNonces(wordPos *big.Int, mask *big.Int) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.Contract.InvalidateUnorderedNonces(&_Ipermit2signaturetransfer.TransactOpts, wordPos, mask)
+}
+
+// PermitTransferFrom is a paid mutator transaction binding the contract method 0x30f28b7a.
+//
+// Solidity: function permitTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes signature) returns()
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferTransactor) PermitTransferFrom(opts *bind.TransactOpts, permit IPermit2SignatureTransferPermitTransferFrom, transferDetails IPermit2SignatureTransferSignatureTransferDetails, owner common.Address, signature []byte) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.contract.Transact(opts, "permitTransferFrom", permit, transferDetails, owner, signature)
+}
+
+// PermitTransferFrom is a paid mutator transaction binding the contract method 0x30f28b7a.
+//
+// Solidity: function permitTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes signature) returns()
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferSession) PermitTransferFrom(permit IPermit2SignatureTransferPermitTransferFrom, transferDetails IPermit2SignatureTransferSignatureTransferDetails, owner common.Address, signature []byte) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.Contract.PermitTransferFrom(&_Ipermit2signaturetransfer.TransactOpts, permit, transferDetails, owner, signature)
+}
+
+// PermitTransferFrom is a paid mutator transaction binding the contract method 0x30f28b7a.
+//
+// Solidity: function permitTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes signature) returns()
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferTransactorSession) PermitTransferFrom(permit IPermit2SignatureTransferPermitTransferFrom, transferDetails IPermit2SignatureTransferSignatureTransferDetails, owner common.Address, signature []byte) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.Contract.PermitTransferFrom(&_Ipermit2signaturetransfer.TransactOpts, permit, transferDetails, owner, signature)
+}
+
+// PermitWitnessTransferFrom is a paid mutator transaction binding the contract method 0x137c29fe.
+//
+// Solidity: function permitWitnessTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes32 witness, string witnessTypeString, bytes signature) returns()
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferTransactor) PermitWitnessTransferFrom(opts *bind.TransactOpts, permit IPermit2SignatureTransferPermitTransferFrom, transferDetails IPermit2SignatureTransferSignatureTransferDetails, owner common.Address, witness [32]byte, witnessTypeString string, signature []byte) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.contract.Transact(opts, "permitWitnessTransferFrom", permit, transferDetails, owner, witness, witnessTypeString, signature)
+}
+
+// PermitWitnessTransferFrom is a paid mutator transaction binding the contract method 0x137c29fe.
+//
+// Solidity: function permitWitnessTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes32 witness, string witnessTypeString, bytes signature) returns()
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferSession) PermitWitnessTransferFrom(permit IPermit2SignatureTransferPermitTransferFrom, transferDetails IPermit2SignatureTransferSignatureTransferDetails, owner common.Address, witness [32]byte, witnessTypeString string, signature []byte) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.Contract.PermitWitnessTransferFrom(&_Ipermit2signaturetransfer.TransactOpts, permit, transferDetails, owner, witness, witnessTypeString, signature)
+}
+
+// PermitWitnessTransferFrom is a paid mutator transaction binding the contract method 0x137c29fe.
+//
+// Solidity: function permitWitnessTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes32 witness, string witnessTypeString, bytes signature) returns()
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferTransactorSession) PermitWitnessTransferFrom(permit IPermit2SignatureTransferPermitTransferFrom, transferDetails IPermit2SignatureTransferSignatureTransferDetails, owner common.Address, witness [32]byte, witnessTypeString string, signature []byte) (*types.Transaction, error) {
+	return _Ipermit2signaturetransfer.Contract.PermitWitnessTransferFrom(&_Ipermit2signaturetransfer.TransactOpts, permit, transferDetails, owner, witness, witnessTypeString, signature)
+}
+
+// Ipermit2signaturetransferUnorderedNonceInvalidationIterator is returned from FilterUnorderedNonceInvalidation and is used to iterate over the raw logs and unpacked data for UnorderedNonceInvalidation events raised by the Ipermit2signaturetransfer contract.
+type Ipermit2signaturetransferUnorderedNonceInvalidationIterator struct {
+	Event *Ipermit2signaturetransferUnorderedNonceInvalidation // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Ipermit2signaturetransferUnorderedNonceInvalidationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Ipermit2signaturetransferUnorderedNonceInvalidation)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Ipermit2signaturetransferUnorderedNonceInvalidation)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Ipermit2signaturetransferUnorderedNonceInvalidationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Ipermit2signaturetransferUnorderedNonceInvalidationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Ipermit2signaturetransferUnorderedNonceInvalidation represents a UnorderedNonceInvalidation event raised by the Ipermit2signaturetransfer contract.
+type Ipermit2signaturetransferUnorderedNonceInvalidation struct {
+	Owner common.Address
+	Word  *big.Int
+	Mask  *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnorderedNonceInvalidation is a free log retrieval operation binding the contract event 0x3704902f963766a4e561bbaab6e6cdc1b1dd12f6e9e99648da8843b3f46b918d.
+//
+// Solidity: event UnorderedNonceInvalidation(address indexed owner, uint256 word, uint256 mask)
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferFilterer) FilterUnorderedNonceInvalidation(opts *bind.FilterOpts, owner []common.Address) (*Ipermit2signaturetransferUnorderedNonceInvalidationIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _Ipermit2signaturetransfer.contract.FilterLogs(opts, "UnorderedNonceInvalidation", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Ipermit2signaturetransferUnorderedNonceInvalidationIterator{contract: _Ipermit2signaturetransfer.contract, event: "UnorderedNonceInvalidation", logs: logs, sub: sub}, nil
+}
+
+// WatchUnorderedNonceInvalidation is a free log subscription operation binding the contract event 0x3704902f963766a4e561bbaab6e6cdc1b1dd12f6e9e99648da8843b3f46b918d.
+//
+// Solidity: event UnorderedNonceInvalidation(address indexed owner, uint256 word, uint256 mask)
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferFilterer) WatchUnorderedNonceInvalidation(opts *bind.WatchOpts, sink chan<- *Ipermit2signaturetransferUnorderedNonceInvalidation, owner []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _Ipermit2signaturetransfer.contract.WatchLogs(opts, "UnorderedNonceInvalidation", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Ipermit2signaturetransferUnorderedNonceInvalidation)
+				if err := _Ipermit2signaturetransfer.contract.UnpackLog(event, "UnorderedNonceInvalidation", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnorderedNonceInvalidation is a log parse operation binding the contract event 0x3704902f963766a4e561bbaab6e6cdc1b1dd12f6e9e99648da8843b3f46b918d.
+//
+// Solidity: event UnorderedNonceInvalidation(address indexed owner, uint256 word, uint256 mask)
+func (_Ipermit2signaturetransfer *Ipermit2signaturetransferFilterer) ParseUnorderedNonceInvalidation(log types.Log) (*Ipermit2signaturetransferUnorderedNonceInvalidation, error) {
+	event := new(Ipermit2signaturetransferUnorderedNonceInvalidation)
+	if err := _Ipermit2signaturetransfer.contract.UnpackLog(event, "UnorderedNonceInvalidation", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
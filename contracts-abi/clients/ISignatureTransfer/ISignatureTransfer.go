@@ -0,0 +1,221 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package isignaturetransfer
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ISignatureTransferPermitTransferFrom is an auto generated low-level Go binding around an user-defined struct.
+type ISignatureTransferPermitTransferFrom struct {
+	Permitted ISignatureTransferTokenPermissions
+	Nonce     *big.Int
+	Deadline  *big.Int
+}
+
+// ISignatureTransferSignatureTransferDetails is an auto generated low-level Go binding around an user-defined struct.
+type ISignatureTransferSignatureTransferDetails struct {
+	To              common.Address
+	RequestedAmount *big.Int
+}
+
+// ISignatureTransferTokenPermissions is an auto generated low-level Go binding around an user-defined struct.
+type ISignatureTransferTokenPermissions struct {
+	Token  common.Address
+	Amount *big.Int
+}
+
+// IsignaturetransferMetaData contains all meta data concerning the Isignaturetransfer contract.
+var IsignaturetransferMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"permitWitnessTransferFrom\",\"inputs\":[{\"name\":\"permit\",\"type\":\"tuple\",\"internalType\":\"structISignatureTransfer.PermitTransferFrom\",\"components\":[{\"name\":\"permitted\",\"type\":\"tuple\",\"internalType\":\"structISignatureTransfer.TokenPermissions\",\"components\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"transferDetails\",\"type\":\"tuple\",\"internalType\":\"structISignatureTransfer.SignatureTransferDetails\",\"components\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"requestedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"witness\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"witnessTypeString\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"}]",
+}
+
+// IsignaturetransferABI is the input ABI used to generate the binding from.
+// Deprecated: Use IsignaturetransferMetaData.ABI instead.
+var IsignaturetransferABI = IsignaturetransferMetaData.ABI
+
+// Isignaturetransfer is an auto generated Go binding around an Ethereum contract.
+type Isignaturetransfer struct {
+	IsignaturetransferCaller     // Read-only binding to the contract
+	IsignaturetransferTransactor // Write-only binding to the contract
+	IsignaturetransferFilterer   // Log filterer for contract events
+}
+
+// IsignaturetransferCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IsignaturetransferCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IsignaturetransferTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IsignaturetransferTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IsignaturetransferFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IsignaturetransferFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IsignaturetransferSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IsignaturetransferSession struct {
+	Contract     *Isignaturetransfer // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// IsignaturetransferCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IsignaturetransferCallerSession struct {
+	Contract *IsignaturetransferCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts             // Call options to use throughout this session
+}
+
+// IsignaturetransferTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IsignaturetransferTransactorSession struct {
+	Contract     *IsignaturetransferTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// IsignaturetransferRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IsignaturetransferRaw struct {
+	Contract *Isignaturetransfer // Generic contract binding to access the raw methods on
+}
+
+// IsignaturetransferCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IsignaturetransferCallerRaw struct {
+	Contract *IsignaturetransferCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IsignaturetransferTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IsignaturetransferTransactorRaw struct {
+	Contract *IsignaturetransferTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIsignaturetransfer creates a new instance of Isignaturetransfer, bound to a specific deployed contract.
+func NewIsignaturetransfer(address common.Address, backend bind.ContractBackend) (*Isignaturetransfer, error) {
+	contract, err := bindIsignaturetransfer(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Isignaturetransfer{IsignaturetransferCaller: IsignaturetransferCaller{contract: contract}, IsignaturetransferTransactor: IsignaturetransferTransactor{contract: contract}, IsignaturetransferFilterer: IsignaturetransferFilterer{contract: contract}}, nil
+}
+
+// NewIsignaturetransferCaller creates a new read-only instance of Isignaturetransfer, bound to a specific deployed contract.
+func NewIsignaturetransferCaller(address common.Address, caller bind.ContractCaller) (*IsignaturetransferCaller, error) {
+	contract, err := bindIsignaturetransfer(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IsignaturetransferCaller{contract: contract}, nil
+}
+
+// NewIsignaturetransferTransactor creates a new write-only instance of Isignaturetransfer, bound to a specific deployed contract.
+func NewIsignaturetransferTransactor(address common.Address, transactor bind.ContractTransactor) (*IsignaturetransferTransactor, error) {
+	contract, err := bindIsignaturetransfer(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IsignaturetransferTransactor{contract: contract}, nil
+}
+
+// NewIsignaturetransferFilterer creates a new log filterer instance of Isignaturetransfer, bound to a specific deployed contract.
+func NewIsignaturetransferFilterer(address common.Address, filterer bind.ContractFilterer) (*IsignaturetransferFilterer, error) {
+	contract, err := bindIsignaturetransfer(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IsignaturetransferFilterer{contract: contract}, nil
+}
+
+// bindIsignaturetransfer binds a generic wrapper to an already deployed contract.
+func bindIsignaturetransfer(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IsignaturetransferMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Isignaturetransfer *IsignaturetransferRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Isignaturetransfer.Contract.IsignaturetransferCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Isignaturetransfer *IsignaturetransferRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Isignaturetransfer.Contract.IsignaturetransferTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Isignaturetransfer *IsignaturetransferRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Isignaturetransfer.Contract.IsignaturetransferTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Isignaturetransfer *IsignaturetransferCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Isignaturetransfer.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Isignaturetransfer *IsignaturetransferTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Isignaturetransfer.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Isignaturetransfer *IsignaturetransferTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Isignaturetransfer.Contract.contract.Transact(opts, method, params...)
+}
+
+// PermitWitnessTransferFrom is a paid mutator transaction binding the contract method 0x137c29fe.
+//
+// Solidity: function permitWitnessTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes32 witness, string witnessTypeString, bytes signature) returns()
+func (_Isignaturetransfer *IsignaturetransferTransactor) PermitWitnessTransferFrom(opts *bind.TransactOpts, permit ISignatureTransferPermitTransferFrom, transferDetails ISignatureTransferSignatureTransferDetails, owner common.Address, witness [32]byte, witnessTypeString string, signature []byte) (*types.Transaction, error) {
+	return _Isignaturetransfer.contract.Transact(opts, "permitWitnessTransferFrom", permit, transferDetails, owner, witness, witnessTypeString, signature)
+}
+
+// PermitWitnessTransferFrom is a paid mutator transaction binding the contract method 0x137c29fe.
+//
+// Solidity: function permitWitnessTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes32 witness, string witnessTypeString, bytes signature) returns()
+func (_Isignaturetransfer *IsignaturetransferSession) PermitWitnessTransferFrom(permit ISignatureTransferPermitTransferFrom, transferDetails ISignatureTransferSignatureTransferDetails, owner common.Address, witness [32]byte, witnessTypeString string, signature []byte) (*types.Transaction, error) {
+	return _Isignaturetransfer.Contract.PermitWitnessTransferFrom(&_Isignaturetransfer.TransactOpts, permit, transferDetails, owner, witness, witnessTypeString, signature)
+}
+
+// PermitWitnessTransferFrom is a paid mutator transaction binding the contract method 0x137c29fe.
+//
+// Solidity: function permitWitnessTransferFrom(((address,uint256),uint256,uint256) permit, (address,uint256) transferDetails, address owner, bytes32 witness, string witnessTypeString, bytes signature) returns()
+func (_Isignaturetransfer *IsignaturetransferTransactorSession) PermitWitnessTransferFrom(permit ISignatureTransferPermitTransferFrom, transferDetails ISignatureTransferSignatureTransferDetails, owner common.Address, witness [32]byte, witnessTypeString string, signature []byte) (*types.Transaction, error) {
+	return _Isignaturetransfer.Contract.PermitWitnessTransferFrom(&_Isignaturetransfer.TransactOpts, permit, transferDetails, owner, witness, witnessTypeString, signature)
+}
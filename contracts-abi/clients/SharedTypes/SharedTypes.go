@@ -0,0 +1,64 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+// Package sharedtypes holds the Solidity structs shared by several generated bindings.
+package sharedtypes
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// IFastSettlementV2Intent is an auto generated low-level Go binding around an user-defined struct.
+type IFastSettlementV2Intent struct {
+	Maker     common.Address
+	Recipient common.Address
+	TokenIn   common.Address
+	TokenOut  common.Address
+	AmountIn  *big.Int
+	AmountOut *big.Int
+	Deadline  *big.Int
+	Nonce     *big.Int
+	RefId     [32]byte
+}
+
+// IFastSettlementV3Intent is an auto generated low-level Go binding around an user-defined struct.
+type IFastSettlementV3Intent struct {
+	User        common.Address
+	InputToken  common.Address
+	OutputToken common.Address
+	InputAmt    *big.Int
+	UserAmtOut  *big.Int
+	Recipient   common.Address
+	Deadline    *big.Int
+	Nonce       *big.Int
+}
+
+// IFastSettlementV3SwapCall is an auto generated low-level Go binding around an user-defined struct.
+type IFastSettlementV3SwapCall struct {
+	To    common.Address
+	Value *big.Int
+	Data  []byte
+}
+
+// IMulticall3Call3 is an auto generated low-level Go binding around an user-defined struct.
+type IMulticall3Call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// IMulticall3Call3Value is an auto generated low-level Go binding around an user-defined struct.
+type IMulticall3Call3Value struct {
+	Target       common.Address
+	AllowFailure bool
+	Value        *big.Int
+	CallData     []byte
+}
+
+// IMulticall3Result is an auto generated low-level Go binding around an user-defined struct.
+type IMulticall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
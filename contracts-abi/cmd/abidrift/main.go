@@ -0,0 +1,164 @@
+// Command abidrift checks the FastSettlementV3 bindings for drift: the
+// interface ABI against the contract ABI, and optionally either binding
+// against deployed runtime code.
+//
+//	go run ./cmd/abidrift                                     # bindings only
+//	go run ./cmd/abidrift -rpc $RPC_URL -address 0xProxy      # plus deployed code
+//	go run ./cmd/abidrift -code impl.hex -json                # plus a local artifact
+//	go run ./cmd/abidrift -strict                             # fail on new warnings too
+//
+// With -strict, warnings count as drift unless the baseline accepts them.
+// The default baseline (abidrift.DefaultBaseline) lists the functions the
+// interface deliberately omits; -baseline replaces it with a file, and
+// -write-baseline prints the current warnings in its format.
+//
+// Exit status is 0 when no drift is found, 1 on drift, and 2 when the check
+// could not run.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/primev/fastprotocolapp/contracts-abi/abidrift"
+)
+
+func main() {
+	var (
+		rpcURL   = flag.String("rpc", "", "JSON-RPC endpoint used to fetch deployed code")
+		address  = flag.String("address", "", "settlement proxy or implementation address (requires -rpc)")
+		codeFile = flag.String("code", "", "file holding hex runtime bytecode to check instead of -address")
+		binding  = flag.String("binding", "contract", "binding checked against code: contract or interface")
+		asJSON   = flag.Bool("json", false, "print the reports as JSON")
+		strict   = flag.Bool("strict", false, "treat warnings the baseline does not accept as drift")
+		baseline = flag.String("baseline", "", "file of accepted warnings for -strict (default: the built-in baseline)")
+		writeBL  = flag.Bool("write-baseline", false, "print the current warnings as a baseline and exit")
+		timeout  = flag.Duration("timeout", 30*time.Second, "RPC timeout")
+	)
+	flag.Parse()
+
+	contractABI, err := abidrift.ContractABI()
+	if err != nil {
+		fatalf("parse contract abi: %v", err)
+	}
+	ifaceABI, err := abidrift.InterfaceABI()
+	if err != nil {
+		fatalf("parse interface abi: %v", err)
+	}
+	reports := []*abidrift.Report{abidrift.Compare(contractABI, ifaceABI)}
+
+	var checked *abi.ABI
+	switch *binding {
+	case "contract":
+		checked = contractABI
+	case "interface":
+		checked = ifaceABI
+	default:
+		fatalf("unknown -binding %q", *binding)
+	}
+
+	switch {
+	case *codeFile != "":
+		raw, err := os.ReadFile(*codeFile)
+		if err != nil {
+			fatalf("%v", err)
+		}
+		code, err := hexutil.Decode(ensure0x(strings.TrimSpace(string(raw))))
+		if err != nil {
+			fatalf("decode %s: %v", *codeFile, err)
+		}
+		r := abidrift.CheckCode(*binding, checked, code)
+		r.Right = *codeFile
+		reports = append(reports, r)
+	case *address != "":
+		if *rpcURL == "" {
+			fatalf("-address requires -rpc")
+		}
+		if !common.IsHexAddress(*address) {
+			fatalf("invalid -address %q", *address)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+		defer cancel()
+		client, err := ethclient.DialContext(ctx, *rpcURL)
+		if err != nil {
+			fatalf("dial: %v", err)
+		}
+		defer client.Close()
+		r, err := abidrift.CheckDeployed(ctx, client, common.HexToAddress(*address), *binding, checked)
+		if err != nil {
+			fatalf("%v", err)
+		}
+		reports = append(reports, r)
+	}
+
+	if *writeBL {
+		if err := abidrift.WriteBaseline(os.Stdout, reports...); err != nil {
+			fatalf("%v", err)
+		}
+		return
+	}
+	accepted := abidrift.DefaultBaseline()
+	if *baseline != "" {
+		f, err := os.Open(*baseline)
+		if err != nil {
+			fatalf("%v", err)
+		}
+		accepted, err = abidrift.ParseBaseline(f)
+		f.Close()
+		if err != nil {
+			fatalf("%v", err)
+		}
+	}
+
+	drift := false
+	for _, r := range reports {
+		if r.Drift() {
+			drift = true
+		}
+		if *strict && len(r.Unaccepted(accepted)) > 0 {
+			drift = true
+		}
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(struct {
+			Drift   bool               `json:"drift"`
+			Reports []*abidrift.Report `json:"reports"`
+		}{drift, reports}); err != nil {
+			fatalf("%v", err)
+		}
+	} else {
+		for _, r := range reports {
+			fmt.Printf("%s vs %s: %d finding(s)\n", r.Left, r.Right, len(r.Findings))
+			for _, f := range r.Findings {
+				fmt.Printf("  %s\n", f)
+			}
+		}
+	}
+	if drift {
+		os.Exit(1)
+	}
+}
+
+func ensure0x(s string) string {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return s
+	}
+	return "0x" + s
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "abidrift: "+format+"\n", args...)
+	os.Exit(2)
+}
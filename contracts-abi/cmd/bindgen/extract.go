@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type config struct {
+	srcDirs      []string
+	artifactsDir string
+	abiDir       string
+	outDir       string
+	module       string
+	exclude      map[string]bool
+	extract      bool
+	check        bool
+}
+
+// declRe matches the contracts and interfaces a Solidity file declares.
+// Abstract contracts and libraries are not deployable on their own and are
+// skipped.
+var declRe = regexp.MustCompile(`(?m)^\s*(abstract\s+contract|contract|interface|library)\s+([A-Za-z_][A-Za-z0-9_]*)`)
+
+type source struct {
+	file     string // base name of the .sol file, e.g. FastSettlementV3.sol
+	contract string
+}
+
+// discover lists the bindable contracts declared under srcDirs.
+func discover(srcDirs []string, exclude map[string]bool) ([]source, error) {
+	var out []source
+	for _, dir := range srcDirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || filepath.Ext(path) != ".sol" {
+				return err
+			}
+			code, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			for _, m := range declRe.FindAllSubmatch(code, -1) {
+				kind, name := string(m[1]), string(m[2])
+				if kind != "contract" && kind != "interface" {
+					continue
+				}
+				if exclude[name] {
+					continue
+				}
+				out = append(out, source{file: filepath.Base(path), contract: name})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].contract < out[j].contract })
+	return out, nil
+}
+
+type forgeArtifact struct {
+	ABI      json.RawMessage `json:"abi"`
+	Bytecode struct {
+		Object string `json:"object"`
+	} `json:"bytecode"`
+}
+
+// extract writes abi/<Contract>.abi (formatted like `jq .abi`) and, for
+// deployable contracts, abi/<Contract>.bin from the Forge artifacts.
+func extract(cfg config) error {
+	sources, err := discover(cfg.srcDirs, cfg.exclude)
+	if err != nil {
+		return err
+	}
+	if len(sources) == 0 {
+		return fmt.Errorf("no contracts found under %s", strings.Join(cfg.srcDirs, ", "))
+	}
+	if err := os.MkdirAll(cfg.abiDir, 0o755); err != nil {
+		return err
+	}
+	for _, s := range sources {
+		path := filepath.Join(cfg.artifactsDir, s.file, s.contract+".json")
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("%w (run `forge build` in contracts/)", err)
+		}
+		var a forgeArtifact
+		if err := json.Unmarshal(raw, &a); err != nil {
+			return fmt.Errorf("decode %s: %w", path, err)
+		}
+		var pretty bytes.Buffer
+		if err := json.Indent(&pretty, a.ABI, "", "  "); err != nil {
+			return fmt.Errorf("format abi %s: %w", path, err)
+		}
+		pretty.WriteByte('\n')
+		if err := os.WriteFile(filepath.Join(cfg.abiDir, s.contract+".abi"), pretty.Bytes(), 0o644); err != nil {
+			return err
+		}
+		binPath := filepath.Join(cfg.abiDir, s.contract+".bin")
+		code := strings.TrimPrefix(a.Bytecode.Object, "0x")
+		if code == "" {
+			if err := os.Remove(binPath); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		} else if err := os.WriteFile(binPath, []byte(code+"\n"), 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("extracted %s\n", s.contract)
+	}
+	return nil
+}
+
+// coverage reports the contracts under cfg.srcDirs that have no ABI in
+// cfg.abiDir, and the ABIs that no longer have a contract.
+func coverage(cfg config) ([]string, error) {
+	sources, err := discover(cfg.srcDirs, cfg.exclude)
+	if err != nil {
+		return nil, err
+	}
+	want := map[string]bool{}
+	for _, s := range sources {
+		want[s.contract] = true
+	}
+	abis, err := filepath.Glob(filepath.Join(cfg.abiDir, "*.abi"))
+	if err != nil {
+		return nil, err
+	}
+	have := map[string]bool{}
+	var stale []string
+	for _, path := range abis {
+		contract := strings.TrimSuffix(filepath.Base(path), ".abi")
+		have[contract] = true
+		if !want[contract] {
+			stale = append(stale, path+" (no contract declares it)")
+		}
+	}
+	for _, s := range sources {
+		if !have[s.contract] {
+			stale = append(stale, filepath.Join(cfg.abiDir, s.contract+".abi")+" (missing)")
+		}
+	}
+	return stale, nil
+}
+
+var moduleRe = regexp.MustCompile(`(?m)^module\s+(\S+)`)
+
+func readModulePath(gomod string) (string, error) {
+	raw, err := os.ReadFile(gomod)
+	if err != nil {
+		return "", err
+	}
+	m := moduleRe.FindSubmatch(raw)
+	if m == nil {
+		return "", fmt.Errorf("no module directive in %s", gomod)
+	}
+	return string(m[1]), nil
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+const (
+	sharedDir = "SharedTypes"
+	sharedPkg = "sharedtypes"
+
+	structDocMarker = "user-defined struct"
+	header          = "// Code generated - DO NOT EDIT.\n// This file is a generated binding and any manual changes will be lost.\n\n"
+)
+
+// output is a generated file keyed by its path.
+type output struct {
+	path string
+	code []byte
+}
+
+type binding struct {
+	contract string
+	pkg      string
+	code     string
+	structs  map[string]structDecl
+}
+
+type structDecl struct {
+	start, end int    // byte offsets of the declaration, doc comment included
+	text       string // the declaration without its doc comment
+}
+
+// generate binds every abi/<Contract>.abi and returns the files that belong
+// under cfg.outDir.
+func generate(cfg config) ([]output, error) {
+	abis, err := filepath.Glob(filepath.Join(cfg.abiDir, "*.abi"))
+	if err != nil {
+		return nil, err
+	}
+	if len(abis) == 0 {
+		return nil, fmt.Errorf("no .abi files in %s", cfg.abiDir)
+	}
+	sort.Strings(abis)
+
+	var bindings []*binding
+	for _, path := range abis {
+		contract := strings.TrimSuffix(filepath.Base(path), ".abi")
+		if cfg.exclude[contract] {
+			continue
+		}
+		b, err := bindOne(cfg.abiDir, contract)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", contract, err)
+		}
+		bindings = append(bindings, b)
+	}
+
+	shared, err := sharedStructs(bindings)
+	if err != nil {
+		return nil, err
+	}
+	importPath := cfg.module + "/" + filepath.ToSlash(filepath.Join(filepath.Clean(cfg.outDir), sharedDir))
+
+	var files []output
+	for _, b := range bindings {
+		code, err := dedupe(b, shared, importPath)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", b.contract, err)
+		}
+		files = append(files, output{path: filepath.Join(cfg.outDir, b.contract, b.contract+".go"), code: code})
+	}
+	if len(shared) > 0 {
+		code, err := sharedFile(shared)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, output{path: filepath.Join(cfg.outDir, sharedDir, sharedDir+".go"), code: code})
+	}
+	return files, nil
+}
+
+func bindOne(abiDir, contract string) (*binding, error) {
+	abiJSON, err := os.ReadFile(filepath.Join(abiDir, contract+".abi"))
+	if err != nil {
+		return nil, err
+	}
+	// Interfaces and abstract contracts have no bytecode, and so no .bin.
+	var bin string
+	if raw, err := os.ReadFile(filepath.Join(abiDir, contract+".bin")); err == nil {
+		bin = strings.TrimSpace(string(raw))
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	pkg := strings.ToLower(contract)
+	code, err := bind.Bind([]string{pkg}, []string{string(abiJSON)}, []string{bin}, nil, pkg, bind.LangGo, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	b := &binding{contract: contract, pkg: pkg, code: code}
+	if b.structs, err = findStructs(code); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// findStructs locates the user-defined struct declarations abigen emitted.
+func findStructs(code string) (map[string]structDecl, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", code, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]structDecl{}
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE || gd.Doc == nil || !strings.Contains(gd.Doc.Text(), structDocMarker) {
+			continue
+		}
+		spec := gd.Specs[0].(*ast.TypeSpec)
+		if _, ok := spec.Type.(*ast.StructType); !ok {
+			continue
+		}
+		out[spec.Name.Name] = structDecl{
+			start: fset.Position(gd.Doc.Pos()).Offset,
+			end:   fset.Position(gd.End()).Offset,
+			text:  code[fset.Position(gd.Pos()).Offset:fset.Position(gd.End()).Offset],
+		}
+	}
+	return out, nil
+}
+
+// sharedStructs returns the structs declared by more than one binding. Two
+// bindings declaring the same struct name with different fields is an error:
+// aliasing them would silently change one binding's ABI.
+func sharedStructs(bindings []*binding) (map[string]string, error) {
+	seen := map[string][]*binding{}
+	for _, b := range bindings {
+		for name := range b.structs {
+			seen[name] = append(seen[name], b)
+		}
+	}
+	shared := map[string]string{}
+	for name, owners := range seen {
+		if len(owners) < 2 {
+			continue
+		}
+		text := owners[0].structs[name].text
+		for _, o := range owners[1:] {
+			if o.structs[name].text != text {
+				return nil, fmt.Errorf("struct %s differs between %s and %s", name, owners[0].contract, o.contract)
+			}
+		}
+		shared[name] = text
+	}
+	return shared, nil
+}
+
+// dedupe replaces the binding's shared struct declarations with aliases into
+// the shared package.
+func dedupe(b *binding, shared map[string]string, importPath string) ([]byte, error) {
+	var names []string
+	for name := range b.structs {
+		if _, ok := shared[name]; ok {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return format.Source([]byte(b.code))
+	}
+	// Rewrite back to front so earlier offsets stay valid.
+	sort.Slice(names, func(i, j int) bool { return b.structs[names[i]].start > b.structs[names[j]].start })
+	code := b.code
+	for _, name := range names {
+		d := b.structs[name]
+		alias := fmt.Sprintf("// %s is an auto generated low-level Go binding around an user-defined struct.\n// It is declared once in %s and shared by every binding that uses it.\ntype %s = %s.%s", name, sharedPkg, name, sharedPkg, name)
+		code = code[:d.start] + alias + code[d.end:]
+	}
+	start := strings.Index(code, "import (\n")
+	if start < 0 {
+		return nil, fmt.Errorf("no import block")
+	}
+	i := start + strings.Index(code[start:], "\n)\n") + 1
+	code = code[:i] + fmt.Sprintf("\t%s %q\n", sharedPkg, importPath) + code[i:]
+	return format.Source([]byte(code))
+}
+
+func sharedFile(shared map[string]string) ([]byte, error) {
+	names := make([]string, 0, len(shared))
+	for name := range shared {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var body bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&body, "\n// %s is an auto generated low-level Go binding around an user-defined struct.\n%s\n", name, shared[name])
+	}
+	var imports []string
+	if strings.Contains(body.String(), "big.") {
+		imports = append(imports, `"math/big"`)
+	}
+	if strings.Contains(body.String(), "common.") {
+		if len(imports) > 0 {
+			imports = append(imports, "")
+		}
+		imports = append(imports, `"github.com/ethereum/go-ethereum/common"`)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "// Package %s holds the Solidity structs shared by several generated bindings.\npackage %s\n", sharedPkg, sharedPkg)
+	if len(imports) > 0 {
+		fmt.Fprintf(&buf, "\nimport (\n\t%s\n)\n", strings.Join(imports, "\n\t"))
+	}
+	buf.Write(body.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+// compare reports the outputs whose on-disk content is missing or differs.
+func compare(files []output) ([]string, error) {
+	var stale []string
+	for _, f := range files {
+		current, err := os.ReadFile(f.path)
+		switch {
+		case os.IsNotExist(err):
+			stale = append(stale, f.path+" (missing)")
+		case err != nil:
+			return nil, err
+		case !bytes.Equal(current, f.code):
+			stale = append(stale, f.path)
+		}
+	}
+	return stale, nil
+}
+
+func write(files []output) error {
+	for _, f := range files {
+		if err := os.MkdirAll(filepath.Dir(f.path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(f.path, f.code, 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("wrote %s\n", f.path)
+	}
+	return nil
+}
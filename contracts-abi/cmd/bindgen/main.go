@@ -0,0 +1,104 @@
+// Command bindgen generates the Go bindings under clients/ from Forge build
+// artifacts. It replaces the old script.sh pipeline (forge + jq + abigen) with
+// a single go generate step:
+//
+//	go generate ./...                  # extract abi/*.abi + abi/*.bin, regenerate clients/
+//	go run ./cmd/bindgen -check        # fail if abi/ or clients/ is stale
+//
+// Every contract and interface declared under contracts/src and
+// contracts/test/bindings is bound, except the -exclude list of storage
+// layouts, which declare state but no external surface.
+// contracts/test/bindings holds interfaces that exist only to be bound, so Go
+// code can use a fuller ABI than the contracts themselves need. -check fails
+// when a bound contract has no abi/<Contract>.abi or an ABI has no contract.
+//
+// Deployable contracts also get abi/<Contract>.bin, their creation bytecode,
+// so their bindings include a DeployX function. Interfaces have none.
+//
+// Structs that appear in more than one binding (e.g. IFastSettlementV3Intent)
+// are emitted once into clients/SharedTypes and aliased from each package, so
+// values flow between bindings without conversion.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	var (
+		cfg     config
+		src     string
+		exclude string
+	)
+	flag.StringVar(&src, "src", "../contracts/src,../contracts/test/bindings", "comma-separated Solidity source directories whose contracts are bound")
+	flag.StringVar(&cfg.artifactsDir, "artifacts", "../contracts/out", "Forge output directory")
+	flag.StringVar(&cfg.abiDir, "abi", "./abi", "directory holding the extracted <Contract>.abi and <Contract>.bin files")
+	flag.StringVar(&cfg.outDir, "out", "./clients", "binding output directory")
+	flag.StringVar(&cfg.module, "module", "", "Go module path of the output directory (default: read from ./go.mod)")
+	flag.StringVar(&exclude, "exclude", "FastSettlementV3Storage,GenesisSBTStorage", "comma-separated contracts to skip")
+	flag.BoolVar(&cfg.extract, "extract", false, "refresh abi/ from the Forge artifacts before generating")
+	flag.BoolVar(&cfg.check, "check", false, "do not write; exit non-zero if committed bindings are stale")
+	flag.Parse()
+
+	for _, dir := range strings.Split(src, ",") {
+		if dir = strings.TrimSpace(dir); dir != "" {
+			cfg.srcDirs = append(cfg.srcDirs, dir)
+		}
+	}
+	for _, name := range strings.Split(exclude, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			if cfg.exclude == nil {
+				cfg.exclude = map[string]bool{}
+			}
+			cfg.exclude[name] = true
+		}
+	}
+	if cfg.module == "" {
+		mod, err := readModulePath("go.mod")
+		if err != nil {
+			fatalf("%v (pass -module)", err)
+		}
+		cfg.module = mod
+	}
+
+	if cfg.extract {
+		if err := extract(cfg); err != nil {
+			fatalf("extract: %v", err)
+		}
+	}
+	files, err := generate(cfg)
+	if err != nil {
+		fatalf("generate: %v", err)
+	}
+	if cfg.check {
+		stale, err := coverage(cfg)
+		if err != nil {
+			fatalf("check: %v", err)
+		}
+		changed, err := compare(files)
+		if err != nil {
+			fatalf("check: %v", err)
+		}
+		stale = append(stale, changed...)
+		if len(stale) > 0 {
+			fmt.Fprintln(os.Stderr, "bindgen: bindings are stale; run `go generate ./...`:")
+			for _, s := range stale {
+				fmt.Fprintln(os.Stderr, "  "+s)
+			}
+			os.Exit(1)
+		}
+		fmt.Println("bindgen: bindings are up to date")
+		return
+	}
+	if err := write(files); err != nil {
+		fatalf("write: %v", err)
+	}
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "bindgen: "+format+"\n", args...)
+	os.Exit(1)
+}
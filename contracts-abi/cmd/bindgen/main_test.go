@@ -0,0 +1,104 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testConfig is the default configuration, run like go generate from the
+// module root.
+func testConfig(t *testing.T) config {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir("../.."); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	mod, err := readModulePath("go.mod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return config{
+		srcDirs: []string{"../contracts/src", "../contracts/test/bindings"},
+		abiDir:  "./abi",
+		outDir:  "./clients",
+		module:  mod,
+		exclude: map[string]bool{"FastSettlementV3Storage": true, "GenesisSBTStorage": true},
+	}
+}
+
+// TestCommitted is -check: every contract has an ABI and the committed
+// bindings match what the ABIs generate.
+func TestCommitted(t *testing.T) {
+	cfg := testConfig(t)
+	stale, err := coverage(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := generate(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed, err := compare(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stale = append(stale, changed...); len(stale) > 0 {
+		t.Fatalf("stale, run `go generate ./...`: %v", stale)
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	cfg := testConfig(t)
+	src := t.TempDir()
+	cfg.srcDirs = []string{src}
+	cfg.abiDir = t.TempDir()
+	write := func(path, body string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(filepath.Join(src, "A.sol"), "interface IA {}\nabstract contract Base {}\nlibrary L {}\ncontract A is Base {}\ncontract GenesisSBTStorage {}\n")
+	write(filepath.Join(cfg.abiDir, "A.abi"), "[]\n")
+	write(filepath.Join(cfg.abiDir, "Gone.abi"), "[]\n")
+
+	stale, err := coverage(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		filepath.Join(cfg.abiDir, "Gone.abi") + " (no contract declares it)",
+		filepath.Join(cfg.abiDir, "IA.abi") + " (missing)",
+	}
+	if len(stale) != len(want) || stale[0] != want[0] || stale[1] != want[1] {
+		t.Fatalf("coverage = %q, want %q", stale, want)
+	}
+}
+
+func TestBytecode(t *testing.T) {
+	dir := t.TempDir()
+	for name, body := range map[string]string{
+		"Thing.abi":  `[{"type":"constructor","inputs":[],"stateMutability":"nonpayable"}]`,
+		"Thing.bin":  "6080604052\n",
+		"IThing.abi": "[]\n",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for contract, deploy := range map[string]bool{"Thing": true, "IThing": false} {
+		b, err := bindOne(dir, contract)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.Contains(b.code, "func Deploy"); got != deploy {
+			t.Errorf("%s binding has a Deploy function: %v, want %v", contract, got, deploy)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Command explain traces a settlement with geth's callTracer and prints what
+// happened inside it: labelled calls, token movements, the swap approval,
+// the refund and, for a revert, the frame it came from.
+//
+//	go run ./cmd/explain -rpc $RPC_URL -tx 0xHash
+//	go run ./cmd/explain -rpc $RPC_URL -from 0xExecutor -to 0xProxy -data 0x... \
+//	    -routers 0xRouter -json
+//
+// The node must expose debug_traceTransaction and debug_traceCall.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/primev/fastprotocolapp/contracts-abi/explain"
+)
+
+func main() {
+	var (
+		rpcURL     = flag.String("rpc", "", "JSON-RPC endpoint with the debug namespace")
+		txHash     = flag.String("tx", "", "settlement transaction to explain")
+		from       = flag.String("from", "", "simulated call sender")
+		to         = flag.String("to", "", "simulated call target")
+		data       = flag.String("data", "", "simulated call data")
+		value      = flag.String("value", "", "simulated call value in wei")
+		block      = flag.Int64("block", -1, "block to simulate on (default: latest)")
+		settlement = flag.String("settlement", "", "settlement proxy (default: inferred from the trace)")
+		weth       = flag.String("weth", "", "WETH address")
+		routers    = flag.String("routers", "", "comma-separated swap target addresses to label as routers")
+		asJSON     = flag.Bool("json", false, "print the explanation as JSON")
+		timeout    = flag.Duration("timeout", time.Minute, "overall timeout")
+	)
+	flag.Parse()
+	if *rpcURL == "" || (*txHash == "") == (*to == "") {
+		fatalf("-rpc and exactly one of -tx or -to are required")
+	}
+	cfg := explain.Config{
+		Settlement: address("settlement", *settlement),
+		WETH:       address("weth", *weth),
+	}
+	for _, r := range strings.Split(*routers, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			cfg.Routers = append(cfg.Routers, address("routers", r))
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	client, err := rpc.DialContext(ctx, *rpcURL)
+	if err != nil {
+		fatalf("dial: %v", err)
+	}
+	defer client.Close()
+
+	var root *explain.Frame
+	if *txHash != "" {
+		hash, err := hexutil.Decode(*txHash)
+		if err != nil || len(hash) != common.HashLength {
+			fatalf("invalid -tx %q", *txHash)
+		}
+		root, err = explain.Transaction(ctx, client, common.BytesToHash(hash))
+		if err != nil {
+			fatalf("%v", err)
+		}
+	} else {
+		target := address("to", *to)
+		msg := ethereum.CallMsg{From: address("from", *from), To: &target}
+		if *data != "" {
+			if msg.Data, err = hexutil.Decode(*data); err != nil {
+				fatalf("invalid -data: %v", err)
+			}
+		}
+		if *value != "" {
+			v, ok := new(big.Int).SetString(*value, 10)
+			if !ok {
+				fatalf("invalid -value %q", *value)
+			}
+			msg.Value = v
+		}
+		var at *big.Int
+		if *block >= 0 {
+			at = big.NewInt(*block)
+		}
+		if root, err = explain.Call(ctx, client, msg, at); err != nil {
+			fatalf("%v", err)
+		}
+	}
+
+	ex := explain.New(cfg).Explain(root)
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(ex)
+	} else {
+		err = ex.WriteText(os.Stdout)
+	}
+	if err != nil {
+		fatalf("%v", err)
+	}
+}
+
+// address parses an optional address flag; empty means the zero address.
+func address(name, s string) common.Address {
+	if s == "" {
+		return common.Address{}
+	}
+	if !common.IsHexAddress(s) {
+		fatalf("invalid -%s address %q", name, s)
+	}
+	return common.HexToAddress(s)
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "explain: "+format+"\n", args...)
+	os.Exit(2)
+}
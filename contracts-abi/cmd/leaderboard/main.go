@@ -0,0 +1,109 @@
+// Command leaderboard serves the swap-volume leaderboard computed from the
+// settlement contract's IntentExecuted logs.
+//
+//	go run ./cmd/leaderboard -rpc $RPC_URL -settlement 0xProxy -weth 0xWETH \
+//	    -from 19000000 -eth-usd 3200 -rates 0xUSDC=312500000,0xDAI=1/3200 \
+//	    -listen :8080
+//
+// -rates prices non-ETH tokens in wei per base unit, as a decimal or
+// fraction; swaps of unpriced pairs count toward swap counts with zero
+// volume. The ETH price is fixed for the process's lifetime. The index is
+// synced in the background every -ttl; until the first sync completes,
+// which includes the backfill from -from, requests get 503.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/primev/fastprotocolapp/contracts-abi/leaderboard"
+	"github.com/primev/fastprotocolapp/contracts-abi/profit"
+)
+
+func main() {
+	var (
+		rpcURL     = flag.String("rpc", "", "JSON-RPC endpoint")
+		settlement = flag.String("settlement", "", "FastSettlementV3 proxy address")
+		weth       = flag.String("weth", "", "WETH address, valued 1:1 with ETH")
+		from       = flag.Uint64("from", 0, "first block to index, ideally the deployment block")
+		rates      = flag.String("rates", "", "comma-separated token=weiPerBaseUnit prices")
+		ethUSD     = flag.Float64("eth-usd", leaderboard.DefaultETHPriceUSD, "ETH price in USD")
+		ttl        = flag.Duration("ttl", time.Minute, "snapshot cache lifetime")
+		listen     = flag.String("listen", ":8080", "HTTP listen address")
+	)
+	flag.Parse()
+	if *rpcURL == "" || *settlement == "" {
+		fatalf("-rpc and -settlement are required")
+	}
+	prices := map[common.Address]*big.Rat{}
+	for _, kv := range strings.Split(*rates, ",") {
+		if kv = strings.TrimSpace(kv); kv == "" {
+			continue
+		}
+		token, rate, ok := strings.Cut(kv, "=")
+		r, valid := new(big.Rat).SetString(rate)
+		if !ok || !valid || r.Sign() < 0 {
+			fatalf("invalid -rates entry %q", kv)
+		}
+		prices[address("rates", token)] = r
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	client, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		fatalf("dial: %v", err)
+	}
+	defer client.Close()
+	price := *ethUSD
+	svc, err := leaderboard.New(leaderboard.Config{
+		Backend:     client,
+		Settlement:  address("settlement", *settlement),
+		WETH:        address("weth", *weth),
+		FromBlock:   *from,
+		Oracle:      profit.NewStaticOracle(prices),
+		ETHPriceUSD: func(ctx context.Context) (float64, error) { return price, nil },
+		CacheTTL:    *ttl,
+		Logger:      slog.New(slog.NewTextHandler(os.Stderr, nil)),
+	})
+	if err != nil {
+		fatalf("%v", err)
+	}
+	go svc.Run(ctx)
+	srv := &http.Server{Addr: *listen, Handler: svc, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdown)
+	}()
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		fatalf("%v", err)
+	}
+}
+
+func address(name, s string) common.Address {
+	if s == "" {
+		return common.Address{}
+	}
+	if !common.IsHexAddress(s) {
+		fatalf("invalid -%s address %q", name, s)
+	}
+	return common.HexToAddress(s)
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "leaderboard: "+format+"\n", args...)
+	os.Exit(2)
+}
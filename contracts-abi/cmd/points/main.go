@@ -0,0 +1,110 @@
+// Command points syncs a points ledger from the settlement contract's
+// IntentExecuted logs and prints per-user balances.
+//
+//	go run ./cmd/points -rpc $RPC_URL -settlement 0xProxy -weth 0xWETH \
+//	    -rules rules.json -ledger ledger.json
+//
+// The ledger file is created on first run and updated in place; every
+// award in it records how its points were computed. Running again with the
+// same rules reproduces the same ledger. Token prices are part of the rules
+// file, so editing them, like any other rule, replays the campaign from its
+// first block. GenesisSBT bonuses need an archive node for old blocks.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/primev/fastprotocolapp/contracts-abi/points"
+)
+
+func main() {
+	var (
+		rpcURL        = flag.String("rpc", "", "JSON-RPC endpoint")
+		settlement    = flag.String("settlement", "", "FastSettlementV3 proxy address")
+		weth          = flag.String("weth", "", "WETH address, valued 1:1 with ETH")
+		rulesPath     = flag.String("rules", "", "campaign rules JSON file")
+		ledgerPath    = flag.String("ledger", "points-ledger.json", "ledger file to create or update")
+		confirmations = flag.Uint64("confirmations", 0, "blocks to hold back from the head")
+		chunk         = flag.Uint64("chunk", 10_000, "blocks per log query")
+		asJSON        = flag.Bool("json", false, "print balances as JSON")
+		timeout       = flag.Duration("timeout", 30*time.Minute, "overall timeout")
+	)
+	flag.Parse()
+	if *rpcURL == "" || *settlement == "" || *rulesPath == "" {
+		fatalf("-rpc, -settlement and -rules are required")
+	}
+	rules, err := points.LoadRules(*rulesPath)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	ledger, err := points.LoadLedger(*ledgerPath)
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	client, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		fatalf("dial: %v", err)
+	}
+	defer client.Close()
+
+	engine, err := points.NewEngine(points.Config{
+		Backend:       client,
+		Settlement:    address("settlement", *settlement),
+		Rules:         rules,
+		WETH:          address("weth", *weth),
+		Confirmations: *confirmations,
+		ChunkSize:     *chunk,
+	})
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if err := engine.Sync(ctx, ledger); err != nil {
+		fatalf("%v", err)
+	}
+	if err := ledger.Save(*ledgerPath); err != nil {
+		fatalf("save: %v", err)
+	}
+
+	balances := ledger.Balances()
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(balances)
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintf(w, "USER\tSWAPS\tSWAP\tREFERRAL\tTOTAL\n")
+		for _, b := range balances {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", b.User.Hex(), b.Swaps, b.Swap.Text(), b.Referral.Text(), b.Total.Text())
+		}
+		err = w.Flush()
+	}
+	if err != nil {
+		fatalf("%v", err)
+	}
+}
+
+func address(name, s string) common.Address {
+	if s == "" {
+		return common.Address{}
+	}
+	if !common.IsHexAddress(s) {
+		fatalf("invalid -%s address %q", name, s)
+	}
+	return common.HexToAddress(s)
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "points: "+format+"\n", args...)
+	os.Exit(2)
+}
@@ -0,0 +1,99 @@
+// Command rescueplan finds tokens stranded in the settlement contract and
+// prints a reviewable plan to rescue them and return them to their owners.
+//
+//	go run ./cmd/rescueplan -rpc $RPC_URL -settlement 0xProxy -owner 0xSafe \
+//	    -treasury 0xTreasury -weth 0xWETH -from 19000000 \
+//	    -tokens 0x0000000000000000000000000000000000000000,0xUSDC,0xWETH
+//
+// The plan is printed as text, or as JSON with -json. Nothing is sent.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/primev/fastprotocolapp/contracts-abi/rescue"
+)
+
+func main() {
+	var (
+		rpcURL     = flag.String("rpc", "", "JSON-RPC endpoint")
+		settlement = flag.String("settlement", "", "FastSettlementV3 proxy address")
+		owner      = flag.String("owner", "", "settlement owner, the sender of the planned transactions")
+		treasury   = flag.String("treasury", "", "treasury owed undistributed surplus")
+		weth       = flag.String("weth", "", "WETH address, to replay Deposit and Withdrawal logs")
+		tokens     = flag.String("tokens", "", "comma-separated token addresses; the zero address is ETH")
+		from       = flag.Uint64("from", 0, "first block to replay, ideally the deployment block")
+		to         = flag.Uint64("to", 0, "last block to replay (default: head)")
+		chunk      = flag.Uint64("chunk", 10_000, "blocks per log query")
+		asJSON     = flag.Bool("json", false, "print the plan as JSON")
+		timeout    = flag.Duration("timeout", 5*time.Minute, "overall timeout")
+	)
+	flag.Parse()
+	if *rpcURL == "" || *settlement == "" || *owner == "" || *tokens == "" {
+		fatalf("-rpc, -settlement, -owner and -tokens are required")
+	}
+	cfg := rescue.Config{
+		Settlement: address("settlement", *settlement),
+		WETH:       address("weth", *weth),
+		Treasury:   address("treasury", *treasury),
+		FromBlock:  *from,
+		ChunkSize:  *chunk,
+	}
+	if *to != 0 {
+		cfg.ToBlock = to
+	}
+	for _, t := range strings.Split(*tokens, ",") {
+		cfg.Tokens = append(cfg.Tokens, address("tokens", strings.TrimSpace(t)))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	client, err := ethclient.DialContext(ctx, *rpcURL)
+	if err != nil {
+		fatalf("dial: %v", err)
+	}
+	defer client.Close()
+
+	report, err := rescue.Scan(ctx, client, cfg)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	plan, err := rescue.BuildPlan(report, address("owner", *owner))
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(plan)
+	} else {
+		err = plan.WriteText(os.Stdout)
+	}
+	if err != nil {
+		fatalf("%v", err)
+	}
+}
+
+// address parses an optional address flag; empty means the zero address.
+func address(name, s string) common.Address {
+	if s == "" {
+		return common.Address{}
+	}
+	if !common.IsHexAddress(s) {
+		fatalf("invalid -%s address %q", name, s)
+	}
+	return common.HexToAddress(s)
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "rescueplan: "+format+"\n", args...)
+	os.Exit(2)
+}
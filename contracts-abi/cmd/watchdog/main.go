@@ -0,0 +1,134 @@
+// Command watchdog alerts on unexpected admin changes to a FastSettlementV3
+// deployment and on funds stranded in the contract.
+//
+//	go run ./cmd/watchdog -rpc wss://... -config expected.json
+//	go run ./cmd/watchdog -rpc wss://... -config expected.json \
+//	    -webhook https://hooks.example/alerts -file alerts.jsonl -min-severity warning
+//
+// The RPC endpoint must support subscriptions (websocket or IPC). When a
+// subscription drops, the watchdog reconnects and re-audits the deployment,
+// so changes made while it was disconnected are still reported.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/primev/fastprotocolapp/contracts-abi/watchdog"
+)
+
+func main() {
+	var (
+		rpcURL     = flag.String("rpc", "", "websocket or IPC JSON-RPC endpoint")
+		configPath = flag.String("config", "", "expected configuration JSON file")
+		webhooks   = flag.String("webhook", "", "comma-separated webhook URLs receiving alerts as JSON")
+		authHeader = flag.String("webhook-auth", "", "Authorization header value sent to webhooks")
+		file       = flag.String("file", "", "append alerts to this file as JSON lines")
+		quiet      = flag.Bool("quiet", false, "do not print alerts to stdout")
+		minSev     = flag.String("min-severity", "info", "drop alerts below this severity: info, warning or critical")
+		interval   = flag.Duration("interval", 30*time.Second, "balance poll interval")
+		retry      = flag.Duration("retry", 10*time.Second, "delay before reconnecting after an error")
+		once       = flag.Bool("once", false, "audit configuration and balances once, then exit")
+	)
+	flag.Parse()
+	if *rpcURL == "" || *configPath == "" {
+		fatalf("-rpc and -config are required")
+	}
+	expected, err := watchdog.LoadExpected(*configPath)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	var min watchdog.Severity
+	if err := min.UnmarshalText([]byte(*minSev)); err != nil {
+		fatalf("%v", err)
+	}
+
+	var sinks []watchdog.Sink
+	if !*quiet {
+		sinks = append(sinks, watchdog.Stdout())
+	}
+	if *file != "" {
+		f, err := watchdog.OpenFileSink(*file)
+		if err != nil {
+			fatalf("%v", err)
+		}
+		defer f.Close()
+		sinks = append(sinks, f)
+	}
+	for _, u := range strings.Split(*webhooks, ",") {
+		if u = strings.TrimSpace(u); u == "" {
+			continue
+		}
+		hook := &watchdog.Webhook{URL: u, Client: &http.Client{Timeout: 10 * time.Second}}
+		if *authHeader != "" {
+			hook.Header = http.Header{"Authorization": {*authHeader}}
+		}
+		sinks = append(sinks, hook)
+	}
+	for i, s := range sinks {
+		sinks[i] = watchdog.MinSeverity(min, s)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	log := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	for {
+		err := run(ctx, *rpcURL, watchdog.Config{
+			Expected:     expected,
+			Sinks:        sinks,
+			PollInterval: *interval,
+			Logger:       log,
+		}, *once)
+		if *once {
+			if err != nil {
+				fatalf("%v", err)
+			}
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.Error("watchdog stopped, reconnecting", "err", err, "in", *retry)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(*retry):
+		}
+	}
+}
+
+func run(ctx context.Context, rpcURL string, cfg watchdog.Config, once bool) error {
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	cfg.Backend = client
+	w, err := watchdog.New(cfg)
+	if err != nil {
+		return err
+	}
+	if once {
+		if _, err := w.Audit(ctx); err != nil {
+			return err
+		}
+		_, err := w.Balances(ctx)
+		return err
+	}
+	return w.Run(ctx)
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "watchdog: "+format+"\n", args...)
+	os.Exit(2)
+}
@@ -0,0 +1,58 @@
+package codec
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+)
+
+var (
+	intentArgs   abi.Arguments
+	swapCallArgs abi.Arguments
+	executedArgs abi.Arguments
+	executedData abi.Arguments
+)
+
+func init() {
+	parsed, err := fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	m := parsed.Methods["executeWithETH"]
+	intentArgs = m.Inputs[:1]
+	swapCallArgs = m.Inputs[1:2]
+
+	ev := parsed.Events["IntentExecuted"]
+	executedData = ev.Inputs.NonIndexed()
+	// abi.encode of the event ignores indexing; clear it so Pack and Unpack
+	// see every field.
+	for _, a := range ev.Inputs {
+		a.Indexed = false
+		executedArgs = append(executedArgs, a)
+	}
+}
+
+// unpackCanonical decodes data with args and rejects payloads that do not
+// re-encode to the same bytes: trailing data, dirty address padding and
+// non-minimal offsets are all refused.
+func unpackCanonical(field string, args abi.Arguments, data []byte, repack func(out []interface{}) ([]byte, error)) ([]interface{}, error) {
+	out, err := args.Unpack(data)
+	if err != nil {
+		return nil, invalid(field, "%v", err)
+	}
+	again, err := repack(out)
+	if err != nil {
+		return nil, invalid(field, "%v", err)
+	}
+	if !bytes.Equal(again, data) {
+		return nil, invalid(field, "non-canonical ABI encoding")
+	}
+	return out, nil
+}
+
+// abiConvert converts the anonymous struct abi.Unpack returns for a tuple into
+// the binding type.
+func abiConvert[T any](v interface{}) *T {
+	return abi.ConvertType(v, new(T)).(*T)
+}
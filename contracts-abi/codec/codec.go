@@ -0,0 +1,187 @@
+// Package codec is the canonical wire encoding of the settlement types:
+// IFastSettlementV3Intent, IFastSettlementV3SwapCall and
+// Fastsettlementv3IntentExecuted.
+//
+// Each type has a named codec type convertible to and from the binding type
+// (codec.Intent(intent), settlement.Intent(c)) with four encodings:
+//
+//   - JSON: checksummed addresses and decimal-string integers.
+//   - Text: a single line of space-separated key=value pairs in the JSON
+//     field order, for logs and CLIs.
+//   - Protobuf: the settlementpb schema.
+//   - ABI: the Solidity abi.encode of the struct.
+//
+// All four round-trip losslessly and decode strictly: mixed-case addresses
+// must carry a valid EIP-55 checksum, integers must fit in uint256 and be
+// written canonically, and unknown fields are rejected.
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ErrInvalid wraps every decoding and validation failure.
+var ErrInvalid = errors.New("codec: invalid value")
+
+func invalid(field, format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s: %s", ErrInvalid, field, fmt.Sprintf(format, args...))
+}
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
+
+// ParseAddress parses a 0x-prefixed hex address. All-lowercase and
+// all-uppercase addresses carry no checksum and are accepted; mixed case must
+// match EIP-55.
+func ParseAddress(field, s string) (common.Address, error) {
+	if len(s) != 42 || !strings.HasPrefix(s, "0x") || !common.IsHexAddress(s) {
+		return common.Address{}, invalid(field, "%q is not a 0x-prefixed 20-byte hex address", s)
+	}
+	addr := common.HexToAddress(s)
+	body := s[2:]
+	if body != strings.ToLower(body) && body != strings.ToUpper(body) && s != addr.Hex() {
+		return common.Address{}, invalid(field, "%q has a bad EIP-55 checksum (want %s)", s, addr.Hex())
+	}
+	return addr, nil
+}
+
+// ParseUint256 parses a canonical decimal integer: digits only, no sign, no
+// leading zeros, at most 2^256-1.
+func ParseUint256(field, s string) (*big.Int, error) {
+	if s == "" {
+		return nil, invalid(field, "missing")
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return nil, invalid(field, "%q is not a decimal integer", s)
+		}
+	}
+	if len(s) > 1 && s[0] == '0' {
+		return nil, invalid(field, "%q has leading zeros", s)
+	}
+	n, _ := new(big.Int).SetString(s, 10)
+	if n.Cmp(maxUint256) > 0 {
+		return nil, invalid(field, "%s exceeds uint256", s)
+	}
+	return n, nil
+}
+
+// CheckUint256 reports whether n is representable as a uint256. A nil n is
+// rejected: the binding types never produce one, so it signals a bug.
+func CheckUint256(field string, n *big.Int) error {
+	switch {
+	case n == nil:
+		return invalid(field, "nil")
+	case n.Sign() < 0:
+		return invalid(field, "%s is negative", n)
+	case n.Cmp(maxUint256) > 0:
+		return invalid(field, "%s exceeds uint256", n)
+	}
+	return nil
+}
+
+func parseUint64(field, s string) (uint64, error) {
+	n, err := ParseUint256(field, s)
+	if err != nil {
+		return 0, err
+	}
+	if !n.IsUint64() {
+		return 0, invalid(field, "%s exceeds uint64", s)
+	}
+	return n.Uint64(), nil
+}
+
+func parseHash(field, s string) (common.Hash, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, invalid(field, "%q is not a 0x-prefixed 32-byte hex value", s)
+	}
+	return common.BytesToHash(b), nil
+}
+
+func parseBytes(field, s string) ([]byte, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, invalid(field, "%v", err)
+	}
+	return b, nil
+}
+
+func parseBool(field, s string) (bool, error) {
+	switch s {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, invalid(field, "%q is not true or false", s)
+}
+
+// strictJSON decodes a JSON object into v, rejecting unknown fields and
+// trailing data.
+func strictJSON(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return invalid("json", "%v", err)
+	}
+	if dec.More() {
+		return invalid("json", "trailing data")
+	}
+	return nil
+}
+
+// kv is one field of the text encoding.
+type kv struct{ key, value string }
+
+func encodeText(fields []kv) []byte {
+	var buf bytes.Buffer
+	for i, f := range fields {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(f.key)
+		buf.WriteByte('=')
+		buf.WriteString(f.value)
+	}
+	return buf.Bytes()
+}
+
+// decodeText splits a text encoding into its values, requiring exactly the
+// given keys, each once, in any order.
+func decodeText(text []byte, keys ...string) (map[string]string, error) {
+	want := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		want[k] = true
+	}
+	out := make(map[string]string, len(keys))
+	for _, part := range strings.Fields(string(text)) {
+		k, v, ok := strings.Cut(part, "=")
+		switch {
+		case !ok:
+			return nil, invalid("text", "%q is not key=value", part)
+		case !want[k]:
+			return nil, invalid("text", "unknown field %q", k)
+		}
+		if _, dup := out[k]; dup {
+			return nil, invalid("text", "duplicate field %q", k)
+		}
+		out[k] = v
+	}
+	for _, k := range keys {
+		if _, ok := out[k]; !ok {
+			return nil, invalid("text", "missing field %q", k)
+		}
+	}
+	return out, nil
+}
+
+func u64(n uint64) string { return strconv.FormatUint(n, 10) }
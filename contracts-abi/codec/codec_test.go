@@ -0,0 +1,290 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// encoding is one of the four codecs, as encode and decode functions over a
+// value of the codec type.
+type encoding[T any] struct {
+	name   string
+	encode func(T) ([]byte, error)
+	decode func([]byte) (T, error)
+}
+
+func encodings[T any, P interface {
+	*T
+	MarshalText() ([]byte, error)
+	UnmarshalText([]byte) error
+	MarshalABI() ([]byte, error)
+	UnmarshalABI([]byte) error
+	MarshalProto() ([]byte, error)
+	UnmarshalProto([]byte) error
+}]() []encoding[T] {
+	dec := func(f func(P, []byte) error) func([]byte) (T, error) {
+		return func(b []byte) (T, error) {
+			var v T
+			err := f(&v, b)
+			return v, err
+		}
+	}
+	return []encoding[T]{
+		{"json", func(v T) ([]byte, error) { return json.Marshal(v) }, dec(func(p P, b []byte) error { return json.Unmarshal(b, p) })},
+		{"text", func(v T) ([]byte, error) { return P(&v).MarshalText() }, dec(P.UnmarshalText)},
+		{"abi", func(v T) ([]byte, error) { return P(&v).MarshalABI() }, dec(P.UnmarshalABI)},
+		{"proto", func(v T) ([]byte, error) { return P(&v).MarshalProto() }, dec(P.UnmarshalProto)},
+	}
+}
+
+// roundTrip checks that every encoding of v decodes to a value that encodes
+// to the same bytes, in that encoding and in JSON, which carries every field.
+func roundTrip[T any](t *testing.T, v T, encs []encoding[T], skipJSONCheck map[string]bool) {
+	t.Helper()
+	want, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range encs {
+		b, err := e.encode(v)
+		if err != nil {
+			t.Fatalf("%s: encode: %v", e.name, err)
+		}
+		got, err := e.decode(b)
+		if err != nil {
+			t.Fatalf("%s: decode %s: %v", e.name, b, err)
+		}
+		again, err := e.encode(got)
+		if err != nil {
+			t.Fatalf("%s: re-encode: %v", e.name, err)
+		}
+		if !bytes.Equal(again, b) {
+			t.Fatalf("%s: re-encoded %x, want %x", e.name, again, b)
+		}
+		if skipJSONCheck[e.name] {
+			continue
+		}
+		if j, _ := json.Marshal(got); !bytes.Equal(j, want) {
+			t.Fatalf("%s: decoded to %s, want %s", e.name, j, want)
+		}
+	}
+}
+
+func randUint256(r *rand.Rand) *big.Int {
+	switch r.Intn(8) {
+	case 0:
+		return new(big.Int)
+	case 1:
+		return new(big.Int).Set(maxUint256)
+	}
+	b := make([]byte, 1+r.Intn(32))
+	r.Read(b)
+	return new(big.Int).SetBytes(b)
+}
+
+func randAddress(r *rand.Rand) common.Address {
+	var a common.Address
+	r.Read(a[:])
+	return a
+}
+
+func randHash(r *rand.Rand) common.Hash {
+	var h common.Hash
+	r.Read(h[:])
+	return h
+}
+
+func randIntent(r *rand.Rand) Intent {
+	return Intent{
+		User:        randAddress(r),
+		InputToken:  randAddress(r),
+		OutputToken: randAddress(r),
+		InputAmt:    randUint256(r),
+		UserAmtOut:  randUint256(r),
+		Recipient:   randAddress(r),
+		Deadline:    randUint256(r),
+		Nonce:       randUint256(r),
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	intents := encodings[Intent]()
+	calls := encodings[SwapCall]()
+	executed := encodings[IntentExecuted]()
+	for i := 0; i < 500; i++ {
+		roundTrip(t, randIntent(r), intents, nil)
+
+		data := make([]byte, r.Intn(200))
+		r.Read(data)
+		roundTrip(t, SwapCall{To: randAddress(r), Value: randUint256(r), Data: data}, calls, nil)
+
+		ev := IntentExecuted{
+			User:        randAddress(r),
+			InputToken:  randAddress(r),
+			OutputToken: randAddress(r),
+			InputAmt:    randUint256(r),
+			UserAmtOut:  randUint256(r),
+			Received:    randUint256(r),
+			Surplus:     randUint256(r),
+		}
+		ev.Raw = types.Log{
+			Address:     randAddress(r),
+			BlockNumber: r.Uint64(),
+			BlockHash:   randHash(r),
+			TxHash:      randHash(r),
+			TxIndex:     uint(r.Uint32()),
+			Index:       uint(r.Uint32()),
+			Removed:     r.Intn(2) == 0,
+		}
+		if err := ev.rebuildRaw(); err != nil {
+			t.Fatal(err)
+		}
+		// The ABI form carries the event fields only.
+		roundTrip(t, ev, executed, map[string]bool{"abi": true})
+	}
+}
+
+func TestIntentExecutedFromLog(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	ev := IntentExecuted{
+		User:        randAddress(r),
+		InputToken:  randAddress(r),
+		OutputToken: randAddress(r),
+		InputAmt:    randUint256(r),
+		UserAmtOut:  randUint256(r),
+		Received:    randUint256(r),
+		Surplus:     randUint256(r),
+	}
+	if err := ev.rebuildRaw(); err != nil {
+		t.Fatal(err)
+	}
+	ev.Raw.Address, ev.Raw.BlockNumber, ev.Raw.Index = randAddress(r), 19_000_000, 3
+
+	got, err := IntentExecutedFromLog(ev.Raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, _ := json.Marshal(ev)
+	b, _ := json.Marshal(got)
+	if !bytes.Equal(a, b) {
+		t.Fatalf("parsed %s, want %s", b, a)
+	}
+
+	other := ev.Raw
+	other.Topics = append([]common.Hash{randHash(r)}, other.Topics[1:]...)
+	if _, err := IntentExecutedFromLog(other); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("foreign log: err = %v, want ErrInvalid", err)
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	valid, err := json.Marshal(randIntent(r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]string
+	if err := json.Unmarshal(valid, &fields); err != nil {
+		t.Fatal(err)
+	}
+	with := func(key, value string) []byte {
+		m := make(map[string]interface{}, len(fields)+1)
+		for k, v := range fields {
+			m[k] = v
+		}
+		m[key] = value
+		b, _ := json.Marshal(m)
+		return b
+	}
+	user := fields["user"]
+	badChecksum := []byte(user)
+	for i := 2; i < len(badChecksum); i++ {
+		if c := badChecksum[i]; c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F' {
+			badChecksum[i] ^= 0x20
+			break
+		}
+	}
+
+	for _, tt := range []struct {
+		name  string
+		input []byte
+		ok    bool
+	}{
+		{"valid", valid, true},
+		{"lowercase address", with("user", strings.ToLower(user)), true},
+		{"uppercase address", with("user", "0x"+strings.ToUpper(user[2:])), true},
+		{"bad checksum", with("user", string(badChecksum)), false},
+		{"no 0x", with("user", user[2:]), false},
+		{"short address", with("user", user[:40]), false},
+		{"leading zero", with("nonce", "01"), false},
+		{"sign", with("nonce", "+1"), false},
+		{"hex integer", with("nonce", "0x1"), false},
+		{"max uint256", with("nonce", maxUint256.String()), true},
+		{"overflow", with("nonce", new(big.Int).Add(maxUint256, common.Big1).String()), false},
+		{"missing", with("nonce", ""), false},
+		{"unknown field", with("extra", "1"), false},
+		{"trailing data", append(append([]byte(nil), valid...), []byte(" {}")...), false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Intent
+			err := i.UnmarshalJSON(tt.input)
+			if tt.ok && err != nil {
+				t.Fatalf("err = %v", err)
+			}
+			if !tt.ok && !errors.Is(err, ErrInvalid) {
+				t.Fatalf("err = %v, want ErrInvalid", err)
+			}
+		})
+	}
+}
+
+func TestStrictBinary(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	intent := randIntent(r)
+	raw, err := intent.MarshalABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Intent
+	if err := got.UnmarshalABI(append(raw, 0)); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("trailing byte: err = %v, want ErrInvalid", err)
+	}
+	dirty := append([]byte(nil), raw...)
+	dirty[0] = 1 // high byte of the left-padded user address
+	if err := got.UnmarshalABI(dirty); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("dirty padding: err = %v, want ErrInvalid", err)
+	}
+
+	pb, err := intent.Proto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pb.Nonce = append([]byte{0}, pb.Nonce...)
+	if _, err := IntentFromProto(pb); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("proto leading zero: err = %v, want ErrInvalid", err)
+	}
+	pb.Nonce = make([]byte, 33)
+	pb.Nonce[0] = 1
+	if _, err := IntentFromProto(pb); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("proto overflow: err = %v, want ErrInvalid", err)
+	}
+
+	intent.Nonce = new(big.Int).Add(maxUint256, common.Big1)
+	if _, err := intent.MarshalABI(); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("encoding an overflowing nonce: err = %v, want ErrInvalid", err)
+	}
+
+	var call SwapCall
+	if err := call.UnmarshalText([]byte("to=0x0000000000000000000000000000000000000001 value=1 data=0x value=2")); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("duplicate text field: err = %v, want ErrInvalid", err)
+	}
+}
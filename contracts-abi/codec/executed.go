@@ -0,0 +1,356 @@
+package codec
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/primev/fastprotocolapp/contracts-abi/codec/settlementpb"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"google.golang.org/protobuf/proto"
+)
+
+// IntentExecuted is the codec form of settlement.IntentExecuted.
+//
+// Only the log's position is encoded; Raw.Topics and Raw.Data are re-derived
+// from the event fields on decode, which reproduces them exactly for any log
+// the binding parsed. The ABI encoding carries the event fields alone and
+// leaves the position zero.
+type IntentExecuted settlement.IntentExecuted
+
+type logJSON struct {
+	Address          string `json:"address"`
+	BlockNumber      string `json:"blockNumber"`
+	BlockHash        string `json:"blockHash"`
+	TransactionHash  string `json:"transactionHash"`
+	TransactionIndex string `json:"transactionIndex"`
+	LogIndex         string `json:"logIndex"`
+	Removed          bool   `json:"removed"`
+}
+
+type intentExecutedJSON struct {
+	User        string  `json:"user"`
+	InputToken  string  `json:"inputToken"`
+	OutputToken string  `json:"outputToken"`
+	InputAmt    string  `json:"inputAmt"`
+	UserAmtOut  string  `json:"userAmtOut"`
+	Received    string  `json:"received"`
+	Surplus     string  `json:"surplus"`
+	Log         logJSON `json:"log"`
+}
+
+var executedKeys = []string{
+	"user", "inputToken", "outputToken", "inputAmt", "userAmtOut", "received", "surplus",
+	"address", "blockNumber", "blockHash", "transactionHash", "transactionIndex", "logIndex", "removed",
+}
+
+// Validate checks that every integer field fits in uint256.
+func (e IntentExecuted) Validate() error {
+	if err := CheckUint256("inputAmt", e.InputAmt); err != nil {
+		return err
+	}
+	if err := CheckUint256("userAmtOut", e.UserAmtOut); err != nil {
+		return err
+	}
+	if err := CheckUint256("received", e.Received); err != nil {
+		return err
+	}
+	return CheckUint256("surplus", e.Surplus)
+}
+
+func (e IntentExecuted) fields() []kv {
+	return []kv{
+		{"user", e.User.Hex()},
+		{"inputToken", e.InputToken.Hex()},
+		{"outputToken", e.OutputToken.Hex()},
+		{"inputAmt", e.InputAmt.String()},
+		{"userAmtOut", e.UserAmtOut.String()},
+		{"received", e.Received.String()},
+		{"surplus", e.Surplus.String()},
+		{"address", e.Raw.Address.Hex()},
+		{"blockNumber", u64(e.Raw.BlockNumber)},
+		{"blockHash", e.Raw.BlockHash.Hex()},
+		{"transactionHash", e.Raw.TxHash.Hex()},
+		{"transactionIndex", u64(uint64(e.Raw.TxIndex))},
+		{"logIndex", u64(uint64(e.Raw.Index))},
+		{"removed", boolString(e.Raw.Removed)},
+	}
+}
+
+func executedFromStrings(v map[string]string) (IntentExecuted, error) {
+	var (
+		out IntentExecuted
+		err error
+	)
+	addrs := []struct {
+		key string
+		dst *common.Address
+	}{
+		{"user", &out.User},
+		{"inputToken", &out.InputToken},
+		{"outputToken", &out.OutputToken},
+		{"address", &out.Raw.Address},
+	}
+	for _, f := range addrs {
+		if *f.dst, err = ParseAddress(f.key, v[f.key]); err != nil {
+			return IntentExecuted{}, err
+		}
+	}
+	if out.InputAmt, err = ParseUint256("inputAmt", v["inputAmt"]); err != nil {
+		return IntentExecuted{}, err
+	}
+	if out.UserAmtOut, err = ParseUint256("userAmtOut", v["userAmtOut"]); err != nil {
+		return IntentExecuted{}, err
+	}
+	if out.Received, err = ParseUint256("received", v["received"]); err != nil {
+		return IntentExecuted{}, err
+	}
+	if out.Surplus, err = ParseUint256("surplus", v["surplus"]); err != nil {
+		return IntentExecuted{}, err
+	}
+	if out.Raw.BlockNumber, err = parseUint64("blockNumber", v["blockNumber"]); err != nil {
+		return IntentExecuted{}, err
+	}
+	if out.Raw.BlockHash, err = parseHash("blockHash", v["blockHash"]); err != nil {
+		return IntentExecuted{}, err
+	}
+	if out.Raw.TxHash, err = parseHash("transactionHash", v["transactionHash"]); err != nil {
+		return IntentExecuted{}, err
+	}
+	txIndex, err := parseUint64("transactionIndex", v["transactionIndex"])
+	if err != nil {
+		return IntentExecuted{}, err
+	}
+	logIndex, err := parseUint64("logIndex", v["logIndex"])
+	if err != nil {
+		return IntentExecuted{}, err
+	}
+	out.Raw.TxIndex, out.Raw.Index = uint(txIndex), uint(logIndex)
+	if out.Raw.Removed, err = parseBool("removed", v["removed"]); err != nil {
+		return IntentExecuted{}, err
+	}
+	return out, out.rebuildRaw()
+}
+
+// rebuildRaw derives Raw.Topics and Raw.Data from the event fields.
+func (e *IntentExecuted) rebuildRaw() error {
+	data, err := executedData.Pack(e.InputAmt, e.UserAmtOut, e.Received, e.Surplus)
+	if err != nil {
+		return invalid("intentExecuted", "%v", err)
+	}
+	e.Raw.Topics = []common.Hash{
+		settlement.IntentExecutedTopic,
+		common.BytesToHash(e.User.Bytes()),
+		common.BytesToHash(e.InputToken.Bytes()),
+		common.BytesToHash(e.OutputToken.Bytes()),
+	}
+	e.Raw.Data = data
+	return nil
+}
+
+// IntentExecutedFromLog parses an IntentExecuted log, rejecting logs of other
+// events.
+func IntentExecutedFromLog(log types.Log) (IntentExecuted, error) {
+	if len(log.Topics) != 4 || log.Topics[0] != settlement.IntentExecutedTopic {
+		return IntentExecuted{}, invalid("log", "not an IntentExecuted log")
+	}
+	f, err := settlement.NewV3(log.Address, nil)
+	if err != nil {
+		return IntentExecuted{}, err
+	}
+	ev, err := f.ParseIntentExecuted(log)
+	if err != nil {
+		return IntentExecuted{}, invalid("log", "%v", err)
+	}
+	return IntentExecuted(*ev), nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e IntentExecuted) MarshalJSON() ([]byte, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(intentExecutedJSON{
+		User:        e.User.Hex(),
+		InputToken:  e.InputToken.Hex(),
+		OutputToken: e.OutputToken.Hex(),
+		InputAmt:    e.InputAmt.String(),
+		UserAmtOut:  e.UserAmtOut.String(),
+		Received:    e.Received.String(),
+		Surplus:     e.Surplus.String(),
+		Log: logJSON{
+			Address:          e.Raw.Address.Hex(),
+			BlockNumber:      u64(e.Raw.BlockNumber),
+			BlockHash:        e.Raw.BlockHash.Hex(),
+			TransactionHash:  e.Raw.TxHash.Hex(),
+			TransactionIndex: u64(uint64(e.Raw.TxIndex)),
+			LogIndex:         u64(uint64(e.Raw.Index)),
+			Removed:          e.Raw.Removed,
+		},
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *IntentExecuted) UnmarshalJSON(data []byte) error {
+	var j intentExecutedJSON
+	if err := strictJSON(data, &j); err != nil {
+		return err
+	}
+	out, err := executedFromStrings(map[string]string{
+		"user":             j.User,
+		"inputToken":       j.InputToken,
+		"outputToken":      j.OutputToken,
+		"inputAmt":         j.InputAmt,
+		"userAmtOut":       j.UserAmtOut,
+		"received":         j.Received,
+		"surplus":          j.Surplus,
+		"address":          j.Log.Address,
+		"blockNumber":      j.Log.BlockNumber,
+		"blockHash":        j.Log.BlockHash,
+		"transactionHash":  j.Log.TransactionHash,
+		"transactionIndex": j.Log.TransactionIndex,
+		"logIndex":         j.Log.LogIndex,
+		"removed":          boolString(j.Log.Removed),
+	})
+	if err != nil {
+		return err
+	}
+	*e = out
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (e IntentExecuted) MarshalText() ([]byte, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return encodeText(e.fields()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *IntentExecuted) UnmarshalText(text []byte) error {
+	v, err := decodeText(text, executedKeys...)
+	if err != nil {
+		return err
+	}
+	out, err := executedFromStrings(v)
+	if err != nil {
+		return err
+	}
+	*e = out
+	return nil
+}
+
+// MarshalABI returns abi.encode of the seven event fields.
+func (e IntentExecuted) MarshalABI() ([]byte, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return executedArgs.Pack(e.User, e.InputToken, e.OutputToken, e.InputAmt, e.UserAmtOut, e.Received, e.Surplus)
+}
+
+// UnmarshalABI decodes abi.encode of the seven event fields.
+func (e *IntentExecuted) UnmarshalABI(data []byte) error {
+	v, err := unpackCanonical("intentExecuted", executedArgs, data, func(out []interface{}) ([]byte, error) {
+		return executedArgs.Pack(out...)
+	})
+	if err != nil {
+		return err
+	}
+	var out IntentExecuted
+	if err := executedArgs.Copy(&out, v); err != nil {
+		return invalid("intentExecuted", "%v", err)
+	}
+	if err := out.rebuildRaw(); err != nil {
+		return err
+	}
+	*e = out
+	return nil
+}
+
+// Proto returns the settlementpb form.
+func (e IntentExecuted) Proto() (*settlementpb.IntentExecuted, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return &settlementpb.IntentExecuted{
+		User:        e.User.Bytes(),
+		InputToken:  e.InputToken.Bytes(),
+		OutputToken: e.OutputToken.Bytes(),
+		InputAmt:    e.InputAmt.Bytes(),
+		UserAmtOut:  e.UserAmtOut.Bytes(),
+		Received:    e.Received.Bytes(),
+		Surplus:     e.Surplus.Bytes(),
+		Log:         logToProto(e.Raw),
+	}, nil
+}
+
+// IntentExecutedFromProto validates and converts pb.
+func IntentExecutedFromProto(pb *settlementpb.IntentExecuted) (IntentExecuted, error) {
+	var (
+		out IntentExecuted
+		err error
+	)
+	if out.Raw, err = logFromProto(pb.GetLog()); err != nil {
+		return IntentExecuted{}, err
+	}
+	for _, f := range []struct {
+		name string
+		raw  []byte
+		dst  *common.Address
+	}{
+		{"user", pb.GetUser(), &out.User},
+		{"inputToken", pb.GetInputToken(), &out.InputToken},
+		{"outputToken", pb.GetOutputToken(), &out.OutputToken},
+	} {
+		if *f.dst, err = protoAddress(f.name, f.raw); err != nil {
+			return IntentExecuted{}, err
+		}
+	}
+	if out.InputAmt, err = protoUint256("inputAmt", pb.GetInputAmt()); err != nil {
+		return IntentExecuted{}, err
+	}
+	if out.UserAmtOut, err = protoUint256("userAmtOut", pb.GetUserAmtOut()); err != nil {
+		return IntentExecuted{}, err
+	}
+	if out.Received, err = protoUint256("received", pb.GetReceived()); err != nil {
+		return IntentExecuted{}, err
+	}
+	if out.Surplus, err = protoUint256("surplus", pb.GetSurplus()); err != nil {
+		return IntentExecuted{}, err
+	}
+	return out, out.rebuildRaw()
+}
+
+// MarshalProto returns the protobuf wire encoding.
+func (e IntentExecuted) MarshalProto() ([]byte, error) {
+	pb, err := e.Proto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// UnmarshalProto decodes the protobuf wire encoding.
+func (e *IntentExecuted) UnmarshalProto(data []byte) error {
+	var pb settlementpb.IntentExecuted
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return invalid("intentExecuted", "%v", err)
+	}
+	if len(pb.ProtoReflect().GetUnknown()) > 0 || len(pb.GetLog().ProtoReflect().GetUnknown()) > 0 {
+		return invalid("intentExecuted", "unknown protobuf fields")
+	}
+	out, err := IntentExecutedFromProto(&pb)
+	if err != nil {
+		return err
+	}
+	*e = out
+	return nil
+}
+
+func boolString(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
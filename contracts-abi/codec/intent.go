@@ -0,0 +1,237 @@
+package codec
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/codec/settlementpb"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"google.golang.org/protobuf/proto"
+)
+
+// Intent is the codec form of settlement.Intent.
+type Intent settlement.Intent
+
+type intentJSON struct {
+	User        string `json:"user"`
+	InputToken  string `json:"inputToken"`
+	OutputToken string `json:"outputToken"`
+	InputAmt    string `json:"inputAmt"`
+	UserAmtOut  string `json:"userAmtOut"`
+	Recipient   string `json:"recipient"`
+	Deadline    string `json:"deadline"`
+	Nonce       string `json:"nonce"`
+}
+
+// Validate checks that every integer field fits in uint256.
+func (i Intent) Validate() error {
+	if err := CheckUint256("inputAmt", i.InputAmt); err != nil {
+		return err
+	}
+	if err := CheckUint256("userAmtOut", i.UserAmtOut); err != nil {
+		return err
+	}
+	if err := CheckUint256("deadline", i.Deadline); err != nil {
+		return err
+	}
+	return CheckUint256("nonce", i.Nonce)
+}
+
+func (i Intent) fields() []kv {
+	return []kv{
+		{"user", i.User.Hex()},
+		{"inputToken", i.InputToken.Hex()},
+		{"outputToken", i.OutputToken.Hex()},
+		{"inputAmt", i.InputAmt.String()},
+		{"userAmtOut", i.UserAmtOut.String()},
+		{"recipient", i.Recipient.Hex()},
+		{"deadline", i.Deadline.String()},
+		{"nonce", i.Nonce.String()},
+	}
+}
+
+func intentFromStrings(v map[string]string) (Intent, error) {
+	var (
+		out Intent
+		err error
+	)
+	if out.User, err = ParseAddress("user", v["user"]); err != nil {
+		return Intent{}, err
+	}
+	if out.InputToken, err = ParseAddress("inputToken", v["inputToken"]); err != nil {
+		return Intent{}, err
+	}
+	if out.OutputToken, err = ParseAddress("outputToken", v["outputToken"]); err != nil {
+		return Intent{}, err
+	}
+	if out.InputAmt, err = ParseUint256("inputAmt", v["inputAmt"]); err != nil {
+		return Intent{}, err
+	}
+	if out.UserAmtOut, err = ParseUint256("userAmtOut", v["userAmtOut"]); err != nil {
+		return Intent{}, err
+	}
+	if out.Recipient, err = ParseAddress("recipient", v["recipient"]); err != nil {
+		return Intent{}, err
+	}
+	if out.Deadline, err = ParseUint256("deadline", v["deadline"]); err != nil {
+		return Intent{}, err
+	}
+	if out.Nonce, err = ParseUint256("nonce", v["nonce"]); err != nil {
+		return Intent{}, err
+	}
+	return out, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (i Intent) MarshalJSON() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(intentJSON{
+		User:        i.User.Hex(),
+		InputToken:  i.InputToken.Hex(),
+		OutputToken: i.OutputToken.Hex(),
+		InputAmt:    i.InputAmt.String(),
+		UserAmtOut:  i.UserAmtOut.String(),
+		Recipient:   i.Recipient.Hex(),
+		Deadline:    i.Deadline.String(),
+		Nonce:       i.Nonce.String(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *Intent) UnmarshalJSON(data []byte) error {
+	var j intentJSON
+	if err := strictJSON(data, &j); err != nil {
+		return err
+	}
+	out, err := intentFromStrings(map[string]string{
+		"user": j.User, "inputToken": j.InputToken, "outputToken": j.OutputToken, "inputAmt": j.InputAmt,
+		"userAmtOut": j.UserAmtOut, "recipient": j.Recipient, "deadline": j.Deadline, "nonce": j.Nonce,
+	})
+	if err != nil {
+		return err
+	}
+	*i = out
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (i Intent) MarshalText() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	return encodeText(i.fields()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *Intent) UnmarshalText(text []byte) error {
+	v, err := decodeText(text, "user", "inputToken", "outputToken", "inputAmt", "userAmtOut", "recipient", "deadline", "nonce")
+	if err != nil {
+		return err
+	}
+	out, err := intentFromStrings(v)
+	if err != nil {
+		return err
+	}
+	*i = out
+	return nil
+}
+
+// MarshalABI returns abi.encode(intent).
+func (i Intent) MarshalABI() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	return intentArgs.Pack(settlement.Intent(i))
+}
+
+// UnmarshalABI decodes abi.encode(intent).
+func (i *Intent) UnmarshalABI(data []byte) error {
+	out, err := unpackCanonical("intent", intentArgs, data, func(out []interface{}) ([]byte, error) {
+		return intentArgs.Pack(out...)
+	})
+	if err != nil {
+		return err
+	}
+	*i = Intent(*abiConvert[settlement.Intent](out[0]))
+	return nil
+}
+
+// Proto returns the settlementpb form.
+func (i Intent) Proto() (*settlementpb.Intent, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	return &settlementpb.Intent{
+		User:        i.User.Bytes(),
+		InputToken:  i.InputToken.Bytes(),
+		OutputToken: i.OutputToken.Bytes(),
+		InputAmt:    i.InputAmt.Bytes(),
+		UserAmtOut:  i.UserAmtOut.Bytes(),
+		Recipient:   i.Recipient.Bytes(),
+		Deadline:    i.Deadline.Bytes(),
+		Nonce:       i.Nonce.Bytes(),
+	}, nil
+}
+
+// IntentFromProto validates and converts pb.
+func IntentFromProto(pb *settlementpb.Intent) (Intent, error) {
+	var (
+		out Intent
+		err error
+	)
+	for _, f := range []struct {
+		name string
+		raw  []byte
+		dst  *common.Address
+	}{
+		{"user", pb.GetUser(), &out.User},
+		{"inputToken", pb.GetInputToken(), &out.InputToken},
+		{"outputToken", pb.GetOutputToken(), &out.OutputToken},
+		{"recipient", pb.GetRecipient(), &out.Recipient},
+	} {
+		if *f.dst, err = protoAddress(f.name, f.raw); err != nil {
+			return Intent{}, err
+		}
+	}
+	if out.InputAmt, err = protoUint256("inputAmt", pb.GetInputAmt()); err != nil {
+		return Intent{}, err
+	}
+	if out.UserAmtOut, err = protoUint256("userAmtOut", pb.GetUserAmtOut()); err != nil {
+		return Intent{}, err
+	}
+	if out.Deadline, err = protoUint256("deadline", pb.GetDeadline()); err != nil {
+		return Intent{}, err
+	}
+	if out.Nonce, err = protoUint256("nonce", pb.GetNonce()); err != nil {
+		return Intent{}, err
+	}
+	return out, nil
+}
+
+// MarshalProto returns the protobuf wire encoding.
+func (i Intent) MarshalProto() ([]byte, error) {
+	pb, err := i.Proto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// UnmarshalProto decodes the protobuf wire encoding.
+func (i *Intent) UnmarshalProto(data []byte) error {
+	var pb settlementpb.Intent
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return invalid("intent", "%v", err)
+	}
+	if len(pb.ProtoReflect().GetUnknown()) > 0 {
+		return invalid("intent", "unknown protobuf fields")
+	}
+	out, err := IntentFromProto(&pb)
+	if err != nil {
+		return err
+	}
+	*i = out
+	return nil
+}
@@ -0,0 +1,69 @@
+package codec
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/primev/fastprotocolapp/contracts-abi/codec/settlementpb"
+)
+
+func protoAddress(field string, b []byte) (common.Address, error) {
+	if len(b) != common.AddressLength {
+		return common.Address{}, invalid(field, "address is %d bytes, want 20", len(b))
+	}
+	return common.BytesToAddress(b), nil
+}
+
+func protoUint256(field string, b []byte) (*big.Int, error) {
+	switch {
+	case len(b) > 32:
+		return nil, invalid(field, "%d bytes exceeds uint256", len(b))
+	case len(b) > 0 && b[0] == 0:
+		return nil, invalid(field, "leading zero byte")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func protoHash(field string, b []byte) (common.Hash, error) {
+	if len(b) != common.HashLength {
+		return common.Hash{}, invalid(field, "hash is %d bytes, want 32", len(b))
+	}
+	return common.BytesToHash(b), nil
+}
+
+func logToProto(l types.Log) *settlementpb.Log {
+	return &settlementpb.Log{
+		Address:     l.Address.Bytes(),
+		BlockNumber: l.BlockNumber,
+		BlockHash:   l.BlockHash.Bytes(),
+		TxHash:      l.TxHash.Bytes(),
+		TxIndex:     uint64(l.TxIndex),
+		LogIndex:    uint64(l.Index),
+		Removed:     l.Removed,
+	}
+}
+
+func logFromProto(pb *settlementpb.Log) (types.Log, error) {
+	var (
+		l   types.Log
+		err error
+	)
+	if pb == nil {
+		return l, invalid("log", "missing")
+	}
+	if l.Address, err = protoAddress("log.address", pb.Address); err != nil {
+		return l, err
+	}
+	if l.BlockHash, err = protoHash("log.blockHash", pb.BlockHash); err != nil {
+		return l, err
+	}
+	if l.TxHash, err = protoHash("log.txHash", pb.TxHash); err != nil {
+		return l, err
+	}
+	l.BlockNumber = pb.BlockNumber
+	l.TxIndex = uint(pb.TxIndex)
+	l.Index = uint(pb.LogIndex)
+	l.Removed = pb.Removed
+	return l, nil
+}
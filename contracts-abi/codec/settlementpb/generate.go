@@ -0,0 +1,6 @@
+// Package settlementpb holds the protobuf schema for the settlement types.
+// Use package codec to convert to and from the binding types; it enforces the
+// address and uint256 encoding rules the schema documents.
+package settlementpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative settlement.proto
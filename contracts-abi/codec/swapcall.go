@@ -0,0 +1,160 @@
+package codec
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/primev/fastprotocolapp/contracts-abi/codec/settlementpb"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"google.golang.org/protobuf/proto"
+)
+
+// SwapCall is the codec form of settlement.SwapCall.
+type SwapCall settlement.SwapCall
+
+type swapCallJSON struct {
+	To    string `json:"to"`
+	Value string `json:"value"`
+	Data  string `json:"data"`
+}
+
+// Validate checks that Value fits in uint256.
+func (c SwapCall) Validate() error { return CheckUint256("value", c.Value) }
+
+func swapCallFromStrings(v map[string]string) (SwapCall, error) {
+	var (
+		out SwapCall
+		err error
+	)
+	if out.To, err = ParseAddress("to", v["to"]); err != nil {
+		return SwapCall{}, err
+	}
+	if out.Value, err = ParseUint256("value", v["value"]); err != nil {
+		return SwapCall{}, err
+	}
+	if out.Data, err = parseBytes("data", v["data"]); err != nil {
+		return SwapCall{}, err
+	}
+	return out, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c SwapCall) MarshalJSON() ([]byte, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(swapCallJSON{To: c.To.Hex(), Value: c.Value.String(), Data: hexutil.Encode(c.Data)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *SwapCall) UnmarshalJSON(data []byte) error {
+	var j swapCallJSON
+	if err := strictJSON(data, &j); err != nil {
+		return err
+	}
+	out, err := swapCallFromStrings(map[string]string{"to": j.To, "value": j.Value, "data": j.Data})
+	if err != nil {
+		return err
+	}
+	*c = out
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c SwapCall) MarshalText() ([]byte, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return encodeText([]kv{
+		{"to", c.To.Hex()},
+		{"value", c.Value.String()},
+		{"data", hexutil.Encode(c.Data)},
+	}), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *SwapCall) UnmarshalText(text []byte) error {
+	v, err := decodeText(text, "to", "value", "data")
+	if err != nil {
+		return err
+	}
+	out, err := swapCallFromStrings(v)
+	if err != nil {
+		return err
+	}
+	*c = out
+	return nil
+}
+
+// MarshalABI returns abi.encode(swapCall).
+func (c SwapCall) MarshalABI() ([]byte, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return swapCallArgs.Pack(settlement.SwapCall(c))
+}
+
+// UnmarshalABI decodes abi.encode(swapCall).
+func (c *SwapCall) UnmarshalABI(data []byte) error {
+	out, err := unpackCanonical("swapCall", swapCallArgs, data, func(out []interface{}) ([]byte, error) {
+		return swapCallArgs.Pack(out...)
+	})
+	if err != nil {
+		return err
+	}
+	*c = SwapCall(*abiConvert[settlement.SwapCall](out[0]))
+	return nil
+}
+
+// Proto returns the settlementpb form.
+func (c SwapCall) Proto() (*settlementpb.SwapCall, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &settlementpb.SwapCall{To: c.To.Bytes(), Value: c.Value.Bytes(), Data: c.Data}, nil
+}
+
+// SwapCallFromProto validates and converts pb.
+func SwapCallFromProto(pb *settlementpb.SwapCall) (SwapCall, error) {
+	var (
+		out SwapCall
+		err error
+	)
+	if out.To, err = protoAddress("to", pb.GetTo()); err != nil {
+		return SwapCall{}, err
+	}
+	if out.Value, err = protoUint256("value", pb.GetValue()); err != nil {
+		return SwapCall{}, err
+	}
+	out.Data = pb.GetData()
+	if out.Data == nil {
+		out.Data = []byte{}
+	}
+	return out, nil
+}
+
+// MarshalProto returns the protobuf wire encoding.
+func (c SwapCall) MarshalProto() ([]byte, error) {
+	pb, err := c.Proto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// UnmarshalProto decodes the protobuf wire encoding.
+func (c *SwapCall) UnmarshalProto(data []byte) error {
+	var pb settlementpb.SwapCall
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return invalid("swapCall", "%v", err)
+	}
+	if len(pb.ProtoReflect().GetUnknown()) > 0 {
+		return invalid("swapCall", "unknown protobuf fields")
+	}
+	out, err := SwapCallFromProto(&pb)
+	if err != nil {
+		return err
+	}
+	*c = out
+	return nil
+}
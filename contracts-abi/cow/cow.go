@@ -0,0 +1,323 @@
+// Package cow matches intents against each other. Two users swapping A→B and
+// B→A, or a ring A→B→C→A, can be settled from one another's inputs instead
+// of through an AMM, leaving the whole spread as surplus.
+//
+// Intents are fill-or-kill: Permit2 pulls exactly inputAmt and the user
+// receives exactly userAmtOut, with the rest of the received output going to
+// the treasury. A set of intents, one per edge of a token cycle, is
+// mutually satisfiable when, for every token T in the cycle,
+//
+//	inputAmt(intent selling T) ≥ userAmtOut(intent buying T)
+//
+// Each leg settles through executeWithPermit with an ICowSettlementHelper as
+// swap target. The helper pulls the leg's input and pays its clearing
+// amount: the whole input of the intent selling the output token. The helper
+// so ends every match flat, and each token's excess over userAmtOut reaches
+// the treasury as surplus. Legs executed before their counterpart are paid
+// from the helper's float; Match.Float reports how much of each token that
+// needs.
+//
+// A match must execute atomically, through the batch aggregator that holds
+// the executor role and operates the helper: Match.Calls returns the arm call
+// followed by every leg, none of which may fail. contracts/src's
+// CowSettlementHelper implements the helper; its owner deploys it with the
+// aggregator as operator, funds the float and has it allowlisted as a swap
+// target.
+package cow
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primev/fastprotocolapp/contracts-abi/batch"
+	icowsettlementhelper "github.com/primev/fastprotocolapp/contracts-abi/clients/ICowSettlementHelper"
+	"github.com/primev/fastprotocolapp/contracts-abi/orderbook"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+var (
+	helperABI abi.ABI
+	legArgs   abi.Arguments
+)
+
+func init() {
+	parsed, err := icowsettlementhelper.IcowsettlementhelperMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	helperABI = *parsed
+	reverts.Register(helperABI)
+
+	address, _ := abi.NewType("address", "", nil)
+	uint256, _ := abi.NewType("uint256", "", nil)
+	legArgs = abi.Arguments{{Type: address}, {Type: address}, {Type: uint256}, {Type: address}, {Type: uint256}}
+}
+
+// Leg is one intent of a match.
+type Leg struct {
+	Order *orderbook.Order
+	// AmountOut is the clearing amount the helper pays the settlement
+	// contract: at least Order.Intent.UserAmtOut.
+	AmountOut *big.Int
+}
+
+// Surplus returns what the settlement contract forwards to the treasury.
+func (l Leg) Surplus() *big.Int {
+	return new(big.Int).Sub(l.AmountOut, l.Order.Intent.UserAmtOut)
+}
+
+// Hash returns the helper's identifier for the leg when settled by
+// settlementAddr.
+func (l Leg) Hash(settlementAddr common.Address) common.Hash {
+	in := l.Order.Intent
+	data, err := legArgs.Pack(settlementAddr, in.InputToken, in.InputAmt, in.OutputToken, l.AmountOut)
+	if err != nil {
+		panic(err) // only reachable with amounts outside uint256
+	}
+	return crypto.Keccak256Hash(data)
+}
+
+// SwapCall returns the leg's swap data: a call to helper.settle.
+func (l Leg) SwapCall(helper common.Address) (settlement.SwapCall, error) {
+	in := l.Order.Intent
+	data, err := helperABI.Pack("settle", in.InputToken, in.InputAmt, in.OutputToken, l.AmountOut)
+	if err != nil {
+		return settlement.SwapCall{}, err
+	}
+	return settlement.SwapCall{To: helper, Value: new(big.Int), Data: data}, nil
+}
+
+// Match is a cycle of intents settled against each other, in execution
+// order.
+type Match struct {
+	Legs []Leg
+	// Score is the smallest relative excess over the legs' userAmtOut; matches
+	// are chosen greedily by descending score.
+	Score float64
+}
+
+// Float returns the helper inventory each token needs for the legs to run in
+// order: a leg paid before its token's producer has executed is paid from
+// float, which the later leg replenishes.
+func (m *Match) Float() map[common.Address]*big.Int {
+	bal := map[common.Address]*big.Int{}
+	float := map[common.Address]*big.Int{}
+	get := func(m map[common.Address]*big.Int, t common.Address) *big.Int {
+		if m[t] == nil {
+			m[t] = new(big.Int)
+		}
+		return m[t]
+	}
+	for _, l := range m.Legs {
+		in := l.Order.Intent
+		get(bal, in.InputToken).Add(bal[in.InputToken], in.InputAmt)
+		b := get(bal, in.OutputToken).Sub(bal[in.OutputToken], l.AmountOut)
+		if b.Sign() < 0 && new(big.Int).Neg(b).Cmp(get(float, in.OutputToken)) > 0 {
+			float[in.OutputToken] = new(big.Int).Neg(b)
+		}
+	}
+	for t, f := range float {
+		if f.Sign() == 0 {
+			delete(float, t)
+		}
+	}
+	return float
+}
+
+// Items returns the legs as batch items with their helper swap calls.
+func (m *Match) Items(helper common.Address) ([]batch.Item, error) {
+	items := make([]batch.Item, len(m.Legs))
+	for i, l := range m.Legs {
+		sc, err := l.SwapCall(helper)
+		if err != nil {
+			return nil, err
+		}
+		o := l.Order
+		items[i] = batch.Item{Hash: o.Hash, Intent: o.Intent, Signature: o.Signature, SwapCall: sc}
+	}
+	return items, nil
+}
+
+// Calls returns the aggregate3Value calls settling the match atomically:
+// helper.arm with every leg, then each leg's executeWithPermit.
+func (m *Match) Calls(settlementAddr, helper common.Address) ([]batch.Call3Value, error) {
+	legs := make([][32]byte, len(m.Legs))
+	for i, l := range m.Legs {
+		legs[i] = l.Hash(settlementAddr)
+	}
+	arm, err := helperABI.Pack("arm", legs)
+	if err != nil {
+		return nil, err
+	}
+	items, err := m.Items(helper)
+	if err != nil {
+		return nil, err
+	}
+	calls, err := (&batch.Batch{Items: items}).Calls(settlementAddr)
+	if err != nil {
+		return nil, err
+	}
+	for i := range calls {
+		calls[i].AllowFailure = false
+	}
+	return append([]batch.Call3Value{{Target: helper, Value: new(big.Int), CallData: arm}}, calls...), nil
+}
+
+// Engine finds matches among the orders in a store.
+type Engine struct {
+	Store orderbook.Store
+	// States are the order states eligible for matching. Defaults to
+	// validated and simulated: the signature has been checked and no
+	// transaction is in flight.
+	States []orderbook.State
+	// MaxRing caps the intents per match. Defaults to 3.
+	MaxRing int
+	// MinTimeToDeadline skips intents that would expire before a match
+	// lands. Defaults to 30s.
+	MinTimeToDeadline time.Duration
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+// Find loads the eligible orders and matches them.
+func (e *Engine) Find(ctx context.Context) ([]*Match, error) {
+	states := e.States
+	if len(states) == 0 {
+		states = []orderbook.State{orderbook.Validated, orderbook.Simulated}
+	}
+	orders, err := e.Store.Find(ctx, orderbook.Filter{States: states})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now
+	if e.Now != nil {
+		now = e.Now
+	}
+	minTTL := e.MinTimeToDeadline
+	if minTTL == 0 {
+		minTTL = 30 * time.Second
+	}
+	cutoff := big.NewInt(now().Add(minTTL).Unix())
+	eligible := orders[:0]
+	for _, o := range orders {
+		if o.Intent.Deadline.Cmp(cutoff) < 0 || o.Signature == nil || o.Intent.InputToken == (common.Address{}) {
+			// ETH-input intents settle through executeWithETH, which only
+			// the user may call.
+			continue
+		}
+		eligible = append(eligible, o)
+	}
+	maxRing := e.MaxRing
+	if maxRing == 0 {
+		maxRing = 3
+	}
+	return Solve(eligible, maxRing), nil
+}
+
+// Solve greedily selects disjoint cycles of up to maxRing orders, highest
+// score first, and computes their clearing amounts. Every order takes part
+// in at most one match.
+func Solve(orders []*orderbook.Order, maxRing int) []*Match {
+	byInput := map[common.Address][]int{}
+	for i, o := range orders {
+		byInput[o.Intent.InputToken] = append(byInput[o.Intent.InputToken], i)
+	}
+	var candidates []*Match
+	for start := range orders {
+		path := []int{start}
+		var dfs func()
+		dfs = func() {
+			last := orders[path[len(path)-1]].Intent
+			if len(path) > 1 && last.OutputToken == orders[start].Intent.InputToken {
+				if m := clearRing(orders, path); m != nil {
+					candidates = append(candidates, m)
+				}
+				return
+			}
+			if len(path) == maxRing {
+				return
+			}
+			for _, next := range byInput[last.OutputToken] {
+				// The lowest index starts the cycle, so each is found once,
+				// and tokens may not repeat within it.
+				if next <= start || revisits(orders, path, next) {
+					continue
+				}
+				path = append(path, next)
+				dfs()
+				path = path[:len(path)-1]
+			}
+		}
+		dfs()
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].Score > candidates[j].Score })
+	used := map[common.Hash]bool{}
+	var out []*Match
+	for _, m := range candidates {
+		free := true
+		for _, l := range m.Legs {
+			free = free && !used[l.Order.Hash]
+		}
+		if !free {
+			continue
+		}
+		for _, l := range m.Legs {
+			used[l.Order.Hash] = true
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+func revisits(orders []*orderbook.Order, path []int, next int) bool {
+	out := orders[next].Intent.OutputToken
+	if out == orders[next].Intent.InputToken {
+		return true
+	}
+	for i, p := range path {
+		// The cycle may only return to the starting token, and only at its
+		// end, which the caller checks before extending.
+		if i > 0 && orders[p].Intent.InputToken == out {
+			return true
+		}
+		if p == next {
+			return true
+		}
+	}
+	return false
+}
+
+// clearRing checks that the cycle is mutually satisfiable and clears each
+// leg at the input of the leg producing its output token. In a simple cycle
+// each token has exactly one producer and one consumer.
+func clearRing(orders []*orderbook.Order, path []int) *Match {
+	producer := map[common.Address]*big.Int{}
+	for _, i := range path {
+		producer[orders[i].Intent.InputToken] = orders[i].Intent.InputAmt
+	}
+	m := &Match{Legs: make([]Leg, len(path))}
+	score := -1.0
+	for k, i := range path {
+		in := orders[i].Intent
+		avail := producer[in.OutputToken]
+		if avail == nil || in.UserAmtOut.Sign() <= 0 || avail.Cmp(in.UserAmtOut) < 0 {
+			return nil
+		}
+		m.Legs[k] = Leg{Order: orders[i], AmountOut: new(big.Int).Set(avail)}
+		excess, _ := new(big.Float).Quo(
+			new(big.Float).SetInt(new(big.Int).Sub(avail, in.UserAmtOut)),
+			new(big.Float).SetInt(in.UserAmtOut),
+		).Float64()
+		if score < 0 || excess < score {
+			score = excess
+		}
+	}
+	m.Score = score
+	return m
+}
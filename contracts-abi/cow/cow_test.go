@@ -0,0 +1,185 @@
+package cow_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/cow"
+	"github.com/primev/fastprotocolapp/contracts-abi/orderbook"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"github.com/primev/fastprotocolapp/contracts-abi/testharness"
+)
+
+var (
+	tokenA = common.HexToAddress("0x000000000000000000000000000000000000000a")
+	tokenB = common.HexToAddress("0x000000000000000000000000000000000000000b")
+)
+
+func ether(n int64) *big.Int { return new(big.Int).Mul(big.NewInt(n), testharness.Ether) }
+
+func order(in, out common.Address, inputAmt, userAmtOut *big.Int, nonce int64) *orderbook.Order {
+	intent := settlement.Intent{
+		User:        common.BigToAddress(big.NewInt(nonce)),
+		InputToken:  in,
+		OutputToken: out,
+		InputAmt:    inputAmt,
+		UserAmtOut:  userAmtOut,
+		Recipient:   common.BigToAddress(big.NewInt(nonce)),
+		Deadline:    big.NewInt(1 << 40),
+		Nonce:       big.NewInt(nonce),
+	}
+	return &orderbook.Order{Hash: permit2.WitnessHash(intent), Intent: intent, Signature: []byte{1}}
+}
+
+func TestSolve(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		// The second order sells B for at least wantA of A.
+		wantA   int64
+		matches int
+	}{
+		{"satisfiable", 98, 1},
+		{"counterparty wants more than is sold", 101, 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			orders := []*orderbook.Order{
+				order(tokenA, tokenB, ether(100), ether(90), 1),
+				order(tokenB, tokenA, ether(95), ether(tt.wantA), 2),
+			}
+			matches := cow.Solve(orders, 3)
+			if len(matches) != tt.matches {
+				t.Fatalf("%d matches, want %d", len(matches), tt.matches)
+			}
+			if tt.matches == 0 {
+				return
+			}
+			m := matches[0]
+			// Each leg clears at the input of the other.
+			if got := m.Legs[0].AmountOut; got.Cmp(ether(95)) != 0 {
+				t.Errorf("leg 0 clears at %s, want 95e18", got)
+			}
+			if got := m.Legs[1].AmountOut; got.Cmp(ether(100)) != 0 {
+				t.Errorf("leg 1 clears at %s, want 100e18", got)
+			}
+			// Leg 0 is paid B before leg 1 sells it.
+			float := m.Float()
+			if len(float) != 1 || float[tokenB].Cmp(ether(95)) != 0 {
+				t.Errorf("float %v, want 95e18 of B", float)
+			}
+		})
+	}
+}
+
+// TestRingSettles settles a 2-ring through the aggregator and a
+// CowSettlementHelper: both legs pass _execute's balance-delta check, the
+// spread reaches the treasury, and the helper ends holding its float.
+func TestRingSettles(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{})
+	agg, err := h.DeployAggregator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	helper, err := h.DeployCowHelper(agg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	helperArtifact, _ := h.Artifact("CowSettlementHelper")
+
+	counterparty := testharness.NewAccount("counterparty")
+	if err := h.SendETH(h.Owner, counterparty.Address, testharness.Ether); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Mint(h.TokenOut, counterparty.Address, ether(95)); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.ApprovePermit2(counterparty, h.TokenOut); err != nil {
+		t.Fatal(err)
+	}
+
+	var orders []*orderbook.Order
+	for _, o := range []struct {
+		acct          testharness.Account
+		in, out       common.Address
+		inAmt, minOut *big.Int
+	}{
+		{h.User, h.TokenIn, h.TokenOut, ether(100), ether(90)},
+		{counterparty, h.TokenOut, h.TokenIn, ether(95), ether(98)},
+	} {
+		intent, err := h.NewIntent(o.in, o.out, o.inAmt, o.minOut)
+		if err != nil {
+			t.Fatal(err)
+		}
+		intent.User = o.acct.Address
+		sig, err := h.SignIntent(o.acct, intent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		orders = append(orders, &orderbook.Order{Hash: permit2.WitnessHash(intent), Intent: intent, Signature: sig})
+	}
+	matches := cow.Solve(orders, 3)
+	if len(matches) != 1 {
+		t.Fatalf("%d matches, want 1", len(matches))
+	}
+	m := matches[0]
+	float := m.Float()
+	for token, amt := range float {
+		if err := h.Mint(token, helper, amt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	calls, err := m.Calls(h.Proxy, helper)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the operator arms, and unarmed legs do not settle.
+	if _, err := h.Transact(h.User, helperArtifact, helper, "arm", [][32]byte{m.Legs[0].Hash(h.Proxy)}); err == nil {
+		t.Fatal("arm from a non-operator succeeded")
+	}
+	if _, err := h.Aggregate(agg, calls[1:]); err == nil {
+		t.Fatal("legs settled without being armed")
+	}
+
+	receipt, err := h.Aggregate(agg, calls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := h.IntentExecuted(receipt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != len(m.Legs) {
+		t.Fatalf("%d IntentExecuted events, want %d", len(events), len(m.Legs))
+	}
+	for i, ev := range events {
+		l := m.Legs[i]
+		if ev.Received.Cmp(l.AmountOut) != 0 || ev.Surplus.Cmp(l.Surplus()) != 0 {
+			t.Errorf("leg %d: received %s surplus %s, want %s and %s", i, ev.Received, ev.Surplus, l.AmountOut, l.Surplus())
+		}
+	}
+
+	for _, tt := range []struct {
+		name          string
+		token, holder common.Address
+		want          *big.Int
+	}{
+		{"recipient TokenOut", h.TokenOut, h.Recipient.Address, ether(90)},
+		{"recipient TokenIn", h.TokenIn, h.Recipient.Address, ether(98)},
+		{"treasury TokenOut", h.TokenOut, h.Treasury.Address, ether(5)},
+		{"treasury TokenIn", h.TokenIn, h.Treasury.Address, ether(2)},
+		{"helper TokenOut", h.TokenOut, helper, float[h.TokenOut]},
+		{"helper TokenIn", h.TokenIn, helper, new(big.Int)},
+		{"settlement TokenOut", h.TokenOut, h.Proxy, new(big.Int)},
+		{"settlement TokenIn", h.TokenIn, h.Proxy, new(big.Int)},
+	} {
+		got, err := h.BalanceOf(tt.token, tt.holder)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Cmp(tt.want) != 0 {
+			t.Errorf("%s = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
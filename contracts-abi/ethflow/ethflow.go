@@ -0,0 +1,270 @@
+// Package ethflow prepares executeWithETH transactions for the user's wallet.
+//
+// executeWithETH is not sent by the executor: the contract requires
+// msg.sender == intent.user and msg.value == intent.inputAmt, so the backend
+// builds the call and the user signs and sends it. Builder checks the
+// contract's entry conditions up front, estimates gas from the user's
+// account, prices an EIP-1559 transaction and returns it both as EIP-1193
+// eth_sendTransaction params, for browser wallets, and as the unsigned
+// EIP-2718 payload, for signers that take raw transactions.
+package ethflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+// CheckError is an intent the contract would reject. Code is the contract
+// error name, or InsufficientFunds when the user cannot pay for the call.
+type CheckError struct {
+	Code   string
+	Reason string
+}
+
+func (e *CheckError) Error() string { return fmt.Sprintf("%s: %s", e.Code, e.Reason) }
+
+func reject(code, format string, args ...interface{}) *CheckError {
+	return &CheckError{Code: code, Reason: fmt.Sprintf(format, args...)}
+}
+
+// Backend is the part of ethclient.Client the builder reads from.
+type Backend interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Config configures a Builder.
+type Config struct {
+	// Settlement is the FastSettlementV3 proxy. Required.
+	Settlement common.Address
+	// Backend is required.
+	Backend Backend
+	// Targets, when set, is used to reject swap targets that are not
+	// allowlisted on the contract.
+	Targets settlement.Caller
+	// ChainID defaults to the backend's.
+	ChainID *big.Int
+	// GasBufferPercent is added to the gas estimate, since the swap's cost
+	// can move between estimation and inclusion. Defaults to 20.
+	GasBufferPercent uint64
+	// MinTimeToDeadline rejects intents that would expire before the wallet
+	// flow completes. Defaults to 2m, allowing for the user to confirm.
+	MinTimeToDeadline time.Duration
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+// Builder prepares executeWithETH transactions.
+type Builder struct {
+	cfg Config
+}
+
+// NewBuilder returns a Builder for cfg.
+func NewBuilder(cfg Config) (*Builder, error) {
+	if cfg.Settlement == (common.Address{}) {
+		return nil, errors.New("ethflow: Config.Settlement is required")
+	}
+	if cfg.Backend == nil {
+		return nil, errors.New("ethflow: Config.Backend is required")
+	}
+	if cfg.GasBufferPercent == 0 {
+		cfg.GasBufferPercent = 20
+	}
+	if cfg.MinTimeToDeadline == 0 {
+		cfg.MinTimeToDeadline = 2 * time.Minute
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	return &Builder{cfg: cfg}, nil
+}
+
+// SendParams is an EIP-1193 eth_sendTransaction parameter object.
+type SendParams struct {
+	From                 common.Address `json:"from"`
+	To                   common.Address `json:"to"`
+	Value                *hexutil.Big   `json:"value"`
+	Data                 hexutil.Bytes  `json:"data"`
+	Gas                  hexutil.Uint64 `json:"gas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	Nonce                hexutil.Uint64 `json:"nonce"`
+	ChainID              *hexutil.Big   `json:"chainId"`
+	Type                 hexutil.Uint64 `json:"type"`
+}
+
+// Tx is a prepared executeWithETH transaction.
+type Tx struct {
+	// Tx is the unsigned transaction.
+	Tx *types.Transaction `json:"-"`
+	// Params is the transaction as eth_sendTransaction params. Wallets may
+	// replace the nonce and fees with their own.
+	Params SendParams `json:"params"`
+	// Unsigned is the EIP-2718 signing payload,
+	// 0x02 || rlp([chainId, nonce, tip, feeCap, gas, to, value, data, accessList]),
+	// and SigningHash its keccak256, the digest the user signs.
+	Unsigned    hexutil.Bytes `json:"unsigned"`
+	SigningHash common.Hash   `json:"signingHash"`
+	// GasEstimate is the unbuffered estimate Params.Gas was derived from.
+	GasEstimate uint64 `json:"gasEstimate"`
+}
+
+// Check validates intent and swapData against executeWithETH's entry
+// conditions. It returns a *CheckError naming the contract error the call
+// would revert with.
+func (b *Builder) Check(ctx context.Context, intent settlement.Intent, swapData settlement.SwapCall) error {
+	minDeadline := b.cfg.Now().Add(b.cfg.MinTimeToDeadline).Unix()
+	switch {
+	case intent.InputToken != (common.Address{}):
+		return reject("ExpectedETHInput", "inputToken is %s, executeWithETH takes ETH input only", intent.InputToken)
+	case intent.User == (common.Address{}):
+		return reject("UnauthorizedCaller", "user is the zero address")
+	case intent.Recipient == (common.Address{}):
+		return reject("BadRecipient", "recipient is the zero address")
+	case intent.InputAmt == nil || intent.InputAmt.Sign() == 0:
+		return reject("BadInputAmt", "inputAmt is zero")
+	case intent.UserAmtOut == nil || intent.UserAmtOut.Sign() == 0:
+		return reject("BadUserAmtOut", "userAmtOut is zero")
+	case intent.Deadline == nil || intent.Deadline.Cmp(big.NewInt(minDeadline)) < 0:
+		return reject("IntentExpired", "deadline %v is less than %s from now", intent.Deadline, b.cfg.MinTimeToDeadline)
+	}
+	if b.cfg.Targets != nil {
+		ok, err := b.cfg.Targets.AllowedSwapTargets(&bind.CallOpts{Context: ctx}, swapData.To)
+		if err != nil {
+			return fmt.Errorf("ethflow: check swap target: %w", err)
+		}
+		if !ok {
+			return reject("UnauthorizedSwapTarget", "swap target %s is not allowlisted", swapData.To)
+		}
+	}
+	return nil
+}
+
+// Build checks the intent, then prepares the transaction intent.User sends:
+// to the settlement, with value intent.InputAmt and executeWithETH calldata.
+// A revert during gas estimation is returned as a *CheckError.
+func (b *Builder) Build(ctx context.Context, intent settlement.Intent, swapData settlement.SwapCall) (*Tx, error) {
+	if err := b.Check(ctx, intent, swapData); err != nil {
+		return nil, err
+	}
+	data, err := pack(intent, swapData)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := b.cfg.Backend.BalanceAt(ctx, intent.User, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ethflow: balance: %w", err)
+	}
+	if balance.Cmp(intent.InputAmt) < 0 {
+		return nil, reject("InsufficientFunds", "user holds %s wei, inputAmt is %s", balance, intent.InputAmt)
+	}
+
+	chainID := b.cfg.ChainID
+	if chainID == nil {
+		if chainID, err = b.cfg.Backend.ChainID(ctx); err != nil {
+			return nil, fmt.Errorf("ethflow: chain id: %w", err)
+		}
+	}
+	head, err := b.cfg.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ethflow: head: %w", err)
+	}
+	if head.BaseFee == nil {
+		return nil, errors.New("ethflow: chain has no base fee, EIP-1559 is not active")
+	}
+	tip, err := b.cfg.Backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ethflow: gas tip: %w", err)
+	}
+	// Twice the base fee survives six full blocks of base fee increases.
+	feeCap := new(big.Int).Add(new(big.Int).Mul(head.BaseFee, big.NewInt(2)), tip)
+	nonce, err := b.cfg.Backend.PendingNonceAt(ctx, intent.User)
+	if err != nil {
+		return nil, fmt.Errorf("ethflow: nonce: %w", err)
+	}
+
+	settlementAddr := b.cfg.Settlement
+	estimate, err := b.cfg.Backend.EstimateGas(ctx, ethereum.CallMsg{
+		From:      intent.User,
+		To:        &settlementAddr,
+		Value:     intent.InputAmt,
+		Data:      data,
+		GasFeeCap: feeCap,
+		GasTipCap: tip,
+	})
+	if err != nil {
+		if rev := reverts.FromError(err); rev != nil {
+			return nil, reject(rev.Name, "gas estimation reverted: %v", rev)
+		}
+		return nil, fmt.Errorf("ethflow: estimate gas: %w", err)
+	}
+	gas := estimate + estimate*b.cfg.GasBufferPercent/100
+
+	cost := new(big.Int).Mul(feeCap, new(big.Int).SetUint64(gas))
+	cost.Add(cost, intent.InputAmt)
+	if balance.Cmp(cost) < 0 {
+		return nil, reject("InsufficientFunds", "user holds %s wei, inputAmt plus max gas cost is %s", balance, cost)
+	}
+
+	inner := &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        &settlementAddr,
+		Value:     intent.InputAmt,
+		Data:      data,
+	}
+	unsigned, err := rlp.EncodeToBytes([]interface{}{
+		inner.ChainID, inner.Nonce, inner.GasTipCap, inner.GasFeeCap, inner.Gas,
+		inner.To, inner.Value, inner.Data, types.AccessList{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	tx := types.NewTx(inner)
+	return &Tx{
+		Tx: tx,
+		Params: SendParams{
+			From:                 intent.User,
+			To:                   settlementAddr,
+			Value:                (*hexutil.Big)(intent.InputAmt),
+			Data:                 data,
+			Gas:                  hexutil.Uint64(gas),
+			MaxFeePerGas:         (*hexutil.Big)(feeCap),
+			MaxPriorityFeePerGas: (*hexutil.Big)(tip),
+			Nonce:                hexutil.Uint64(nonce),
+			ChainID:              (*hexutil.Big)(chainID),
+			Type:                 types.DynamicFeeTxType,
+		},
+		Unsigned:    append([]byte{types.DynamicFeeTxType}, unsigned...),
+		SigningHash: types.LatestSignerForChainID(chainID).Hash(tx),
+		GasEstimate: estimate,
+	}, nil
+}
+
+func pack(intent settlement.Intent, swapData settlement.SwapCall) ([]byte, error) {
+	parsed, err := fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("executeWithETH", intent, swapData)
+}
@@ -0,0 +1,237 @@
+package ethflow_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	"github.com/primev/fastprotocolapp/contracts-abi/ethflow"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"github.com/primev/fastprotocolapp/contracts-abi/testharness"
+)
+
+var (
+	proxy  = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	user   = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	router = common.HexToAddress("0x0000000000000000000000000000000000000f0f")
+	token  = common.HexToAddress("0x000000000000000000000000000000000000000b")
+	now    = time.Unix(1_700_000_000, 0)
+)
+
+// rpcError is a node's revert error carrying the revert data.
+type rpcError struct{ data string }
+
+func (e rpcError) Error() string          { return "execution reverted" }
+func (e rpcError) ErrorCode() int         { return 3 }
+func (e rpcError) ErrorData() interface{} { return e.data }
+
+// backend is a chain at base fee 10 gwei with a 1 gwei tip.
+type backend struct {
+	balance  *big.Int
+	estimate uint64
+	err      error
+	msg      ethereum.CallMsg
+}
+
+func (b *backend) ChainID(context.Context) (*big.Int, error) { return big.NewInt(1), nil }
+
+func (b *backend) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{BaseFee: big.NewInt(10e9)}, nil
+}
+
+func (b *backend) SuggestGasTipCap(context.Context) (*big.Int, error) { return big.NewInt(1e9), nil }
+
+func (b *backend) EstimateGas(_ context.Context, msg ethereum.CallMsg) (uint64, error) {
+	b.msg = msg
+	return b.estimate, b.err
+}
+
+func (b *backend) PendingNonceAt(context.Context, common.Address) (uint64, error) { return 7, nil }
+
+func (b *backend) BalanceAt(context.Context, common.Address, *big.Int) (*big.Int, error) {
+	return b.balance, nil
+}
+
+func intent() settlement.Intent {
+	return settlement.Intent{
+		User:        user,
+		InputToken:  settlement.ETH,
+		OutputToken: token,
+		InputAmt:    big.NewInt(1e18),
+		UserAmtOut:  big.NewInt(3_000e6),
+		Recipient:   user,
+		Deadline:    big.NewInt(now.Add(time.Hour).Unix()),
+		Nonce:       big.NewInt(1),
+	}
+}
+
+func builder(t *testing.T, b ethflow.Backend, targets settlement.Caller) *ethflow.Builder {
+	t.Helper()
+	bl, err := ethflow.NewBuilder(ethflow.Config{
+		Settlement: proxy,
+		Backend:    b,
+		Targets:    targets,
+		Now:        func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bl
+}
+
+func TestCheck(t *testing.T) {
+	fake := settlement.NewFake(settlement.FakeConfig{})
+	fake.AllowSwapTarget(router, true)
+	b := builder(t, &backend{}, fake)
+	swap := settlement.SwapCall{To: router, Value: new(big.Int)}
+
+	for _, tt := range []struct {
+		name   string
+		modify func(*settlement.Intent, *settlement.SwapCall)
+		want   string
+	}{
+		{"valid", func(*settlement.Intent, *settlement.SwapCall) {}, ""},
+		{"token input", func(in *settlement.Intent, _ *settlement.SwapCall) { in.InputToken = token }, "ExpectedETHInput"},
+		{"no user", func(in *settlement.Intent, _ *settlement.SwapCall) { in.User = common.Address{} }, "UnauthorizedCaller"},
+		{"no recipient", func(in *settlement.Intent, _ *settlement.SwapCall) { in.Recipient = common.Address{} }, "BadRecipient"},
+		{"zero input", func(in *settlement.Intent, _ *settlement.SwapCall) { in.InputAmt = new(big.Int) }, "BadInputAmt"},
+		{"zero output", func(in *settlement.Intent, _ *settlement.SwapCall) { in.UserAmtOut = nil }, "BadUserAmtOut"},
+		{"expires during the wallet flow", func(in *settlement.Intent, _ *settlement.SwapCall) {
+			in.Deadline = big.NewInt(now.Add(time.Minute).Unix())
+		}, "IntentExpired"},
+		{"unlisted target", func(_ *settlement.Intent, s *settlement.SwapCall) { s.To = token }, "UnauthorizedSwapTarget"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			in, s := intent(), swap
+			tt.modify(&in, &s)
+			err := b.Check(context.Background(), in, s)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			var ce *ethflow.CheckError
+			if !errors.As(err, &ce) || ce.Code != tt.want {
+				t.Fatalf("err = %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	be := &backend{balance: big.NewInt(2e18), estimate: 100_000}
+	tx, err := builder(t, be, nil).Build(context.Background(), intent(), settlement.SwapCall{To: router, Value: new(big.Int)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := tx.Params
+	if p.From != user || p.To != proxy || p.Value.ToInt().Cmp(big.NewInt(1e18)) != 0 || p.Nonce != 7 {
+		t.Errorf("params %+v", p)
+	}
+	if p.Gas != 120_000 || tx.GasEstimate != 100_000 {
+		t.Errorf("gas %d from estimate %d, want 120000 from 100000", p.Gas, tx.GasEstimate)
+	}
+	// Twice the base fee plus the tip.
+	if got := p.MaxFeePerGas.ToInt(); got.Cmp(big.NewInt(21e9)) != 0 {
+		t.Errorf("maxFeePerGas %s, want 21 gwei", got)
+	}
+	if be.msg.From != user || be.msg.Value.Cmp(big.NewInt(1e18)) != 0 {
+		t.Errorf("estimated as %s with value %s", be.msg.From, be.msg.Value)
+	}
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+	if want := signer.Hash(tx.Tx); tx.SigningHash != want || crypto.Keccak256Hash(tx.Unsigned) != want {
+		t.Errorf("signing hash %s, unsigned hashes to %s, want %s", tx.SigningHash, crypto.Keccak256Hash(tx.Unsigned), want)
+	}
+}
+
+func TestBuildRejects(t *testing.T) {
+	parsed, err := fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := parsed.Errors["InvalidETHAmount"].ID
+	revert := rpcError{data: "0x" + common.Bytes2Hex(id[:4])}
+
+	for _, tt := range []struct {
+		name    string
+		backend *backend
+		want    string
+	}{
+		{"balance below inputAmt", &backend{balance: big.NewInt(1e17), estimate: 100_000}, "InsufficientFunds"},
+		// 1 ether plus 120000 gas at 21 gwei.
+		{"balance below inputAmt plus gas", &backend{balance: big.NewInt(1e18 + 120_000*21e9 - 1), estimate: 100_000}, "InsufficientFunds"},
+		{"estimate reverts", &backend{balance: big.NewInt(2e18), err: revert}, "InvalidETHAmount"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := builder(t, tt.backend, nil).Build(context.Background(), intent(), settlement.SwapCall{To: router, Value: new(big.Int)})
+			var ce *ethflow.CheckError
+			if !errors.As(err, &ce) || ce.Code != tt.want {
+				t.Fatalf("err = %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
+
+// TestBuildSends signs the built transaction as the user and settles it.
+func TestBuildSends(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{})
+	ctx := context.Background()
+	amountIn := testharness.Ether
+	amountOut := big.NewInt(3_000e6)
+	in, err := h.NewIntent(settlement.ETH, h.TokenOut, amountIn, amountOut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	swap, err := h.WETHSwapCall(amountIn, h.TokenOut, amountOut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainNow, err := h.Now()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ethflow.NewBuilder(ethflow.Config{
+		Settlement: h.Proxy,
+		Backend:    h.Client,
+		Targets:    h.Settlement,
+		Now:        func() time.Time { return time.Unix(int64(chainNow), 0) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	built, err := b.Build(ctx, in, swap)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig, err := crypto.Sign(built.SigningHash.Bytes(), h.User.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := built.Tx.WithSignature(types.LatestSignerForChainID(h.ChainID), sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt, err := h.Mine(signed, h.Client.SendTransaction(ctx, signed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.GasUsed > uint64(built.Params.Gas) {
+		t.Errorf("used %d gas, limit %d", receipt.GasUsed, built.Params.Gas)
+	}
+	events, err := h.IntentExecuted(receipt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].User != h.User.Address {
+		t.Fatalf("IntentExecuted %+v, want one for the user", events)
+	}
+}
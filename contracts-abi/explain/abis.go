@@ -0,0 +1,42 @@
+package explain
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	icowsettlementhelper "github.com/primev/fastprotocolapp/contracts-abi/clients/ICowSettlementHelper"
+	ierc20token "github.com/primev/fastprotocolapp/contracts-abi/clients/IERC20Token"
+	imulticall3 "github.com/primev/fastprotocolapp/contracts-abi/clients/IMulticall3"
+	ipermit2signaturetransfer "github.com/primev/fastprotocolapp/contracts-abi/clients/IPermit2SignatureTransfer"
+	iweth9 "github.com/primev/fastprotocolapp/contracts-abi/clients/IWETH9"
+)
+
+// known is a contract ABI with the addresses it is bound to.
+type known struct {
+	name  string
+	abi   *abi.ABI
+	addrs map[common.Address]bool
+}
+
+func mustABI(get func() (*abi.ABI, error)) *abi.ABI {
+	parsed, err := get()
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// defaults are registered by New, most specific first: the selector lookup
+// for an unlabelled address takes the first ABI that has the method. ERC20
+// precedes WETH, whose ABI is a superset, so an unlabelled token's transfer is
+// not attributed to WETH; calls to the configured WETH still use its ABI.
+func defaults() []*known {
+	return []*known{
+		{name: "FastSettlementV3", abi: mustABI(fastsettlementv3.Fastsettlementv3MetaData.GetAbi)},
+		{name: "Permit2", abi: mustABI(ipermit2signaturetransfer.Ipermit2signaturetransferMetaData.GetAbi)},
+		{name: "ERC20", abi: mustABI(ierc20token.Ierc20tokenMetaData.GetAbi)},
+		{name: "WETH", abi: mustABI(iweth9.Iweth9MetaData.GetAbi)},
+		{name: "Multicall3", abi: mustABI(imulticall3.Imulticall3MetaData.GetAbi)},
+		{name: "CowSettlementHelper", abi: mustABI(icowsettlementhelper.IcowsettlementhelperMetaData.GetAbi)},
+	}
+}
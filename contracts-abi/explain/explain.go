@@ -0,0 +1,599 @@
+// Package explain turns a callTracer trace of a settlement into something a
+// person can read when an intent fails with BadCallTarget or
+// InsufficientOut and the cause is buried inside the swap call.
+//
+// A trace is fetched with Transaction for a mined transaction or Call for a
+// simulated one, then passed to an Explainer. Every frame is labelled from
+// the ABIs the explainer knows — the settlement, Permit2, WETH, ERC-20,
+// Multicall3 and the CoW helper by default, plus any router ABI added with
+// Register — and the explanation summarises what the settlement did:
+//
+//   - token movements: ERC-20 Transfers, WETH wraps and unwraps, and ETH
+//     sent with calls;
+//   - the swap target approval set by forceApprove and whether it was reset
+//     to zero afterwards;
+//   - the refund of unused input to the intent's user;
+//   - for a reverted trace, the frame the revert originated in and the chain
+//     of frames it bubbled up through.
+//
+// The tracer discards the logs of frames that reverted, so movements inside
+// a reverted subtree are not reported; the call tree still shows the calls
+// that were attempted. Explanations render with WriteText or as JSON.
+package explain
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+)
+
+// Config names the contracts in a trace.
+type Config struct {
+	// Settlement is the settlement proxy. When zero it is taken from the
+	// first executeWithPermit or executeWithETH frame.
+	Settlement common.Address
+	// Permit2 defaults to permit2.CanonicalAddress.
+	Permit2 common.Address
+	// WETH is the settlement's WETH. When zero it is taken from the deposit
+	// call of an executeWithETH frame.
+	WETH common.Address
+	// Routers are allowed swap targets, labelled "router" unless Labels or
+	// Register name them.
+	Routers []common.Address
+	// Labels overrides the label of individual addresses.
+	Labels map[common.Address]string
+}
+
+// Explainer labels and summarises traces. It is not safe to Register
+// concurrently with Explain.
+type Explainer struct {
+	cfg       Config
+	contracts []*known
+}
+
+// New returns an Explainer with the default ABIs registered.
+func New(cfg Config) *Explainer {
+	if cfg.Permit2 == (common.Address{}) {
+		cfg.Permit2 = permit2.CanonicalAddress
+	}
+	e := &Explainer{cfg: cfg, contracts: defaults()}
+	for _, k := range e.contracts {
+		k.addrs = make(map[common.Address]bool)
+		switch k.name {
+		case "FastSettlementV3":
+			k.addrs[cfg.Settlement] = cfg.Settlement != (common.Address{})
+		case "Permit2":
+			k.addrs[cfg.Permit2] = true
+		case "WETH":
+			k.addrs[cfg.WETH] = cfg.WETH != (common.Address{})
+		}
+	}
+	return e
+}
+
+// Register adds a contract ABI, typically a router's, bound to addrs. It
+// takes precedence over the defaults when decoding calls and events.
+func (e *Explainer) Register(name string, contract abi.ABI, addrs ...common.Address) {
+	k := &known{name: name, abi: &contract, addrs: make(map[common.Address]bool)}
+	for _, a := range addrs {
+		k.addrs[a] = true
+	}
+	e.contracts = append([]*known{k}, e.contracts...)
+}
+
+// Arg is a decoded argument.
+type Arg struct {
+	Name  string `json:"name,omitempty"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// Event is a decoded log.
+type Event struct {
+	Address  common.Address `json:"address"`
+	Contract string         `json:"contract,omitempty"`
+	// Name is empty when no known ABI has the event.
+	Name   string        `json:"name,omitempty"`
+	Args   []Arg         `json:"args,omitempty"`
+	Topics []common.Hash `json:"topics,omitempty"`
+	Data   hexutil.Bytes `json:"data,omitempty"`
+}
+
+// Node is a labelled call frame.
+type Node struct {
+	// Path locates the frame: "0" is the root, "0.2" its third call.
+	Path     string         `json:"path"`
+	Type     string         `json:"type"`
+	From     common.Address `json:"from"`
+	To       common.Address `json:"to"`
+	Contract string         `json:"contract,omitempty"`
+	// Method is the decoded method name, or the selector when unknown.
+	Method  string   `json:"method,omitempty"`
+	Args    []Arg    `json:"args,omitempty"`
+	Value   *big.Int `json:"value,omitempty"`
+	GasUsed uint64   `json:"gasUsed"`
+	// Error is the tracer's error for a failed frame, Revert the decoded
+	// revert payload.
+	Error  string  `json:"error,omitempty"`
+	Revert string  `json:"revert,omitempty"`
+	Events []Event `json:"events,omitempty"`
+	Calls  []*Node `json:"calls,omitempty"`
+
+	frame *Frame
+}
+
+// Movement kinds.
+const (
+	KindTransfer = "transfer"
+	KindWrap     = "wrap"
+	KindUnwrap   = "unwrap"
+	KindETH      = "eth"
+)
+
+// Movement is value moved between two addresses. Token is the zero address
+// for ETH.
+type Movement struct {
+	Kind   string         `json:"kind"`
+	Token  common.Address `json:"token"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Amount *big.Int       `json:"amount"`
+	Path   string         `json:"path"`
+}
+
+// Approval is an allowance the settlement granted. forceApprove may emit a
+// zero approval before the real one; only non-zero grants are listed, with
+// the frame of the zero approval that revoked them, if any.
+type Approval struct {
+	Token   common.Address `json:"token"`
+	Spender common.Address `json:"spender"`
+	Amount  *big.Int       `json:"amount"`
+	SetAt   string         `json:"setAt"`
+	Reset   bool           `json:"reset"`
+	ResetAt string         `json:"resetAt,omitempty"`
+}
+
+// Intent is the settlement call found in the trace.
+type Intent struct {
+	Path       string         `json:"path"`
+	Method     string         `json:"method"`
+	User       common.Address `json:"user"`
+	InputToken common.Address `json:"inputToken"`
+	// SwapInput is the token actually approved and swapped: InputToken, or
+	// WETH for executeWithETH.
+	SwapInput   common.Address `json:"swapInput"`
+	OutputToken common.Address `json:"outputToken"`
+	InputAmt    *big.Int       `json:"inputAmt"`
+	UserAmtOut  *big.Int       `json:"userAmtOut"`
+	Recipient   common.Address `json:"recipient"`
+	SwapTarget  common.Address `json:"swapTarget"`
+	// SwapPath is the swap target call's frame, empty if it was not reached.
+	SwapPath string `json:"swapPath,omitempty"`
+}
+
+// Revert locates a failed trace's revert.
+type Revert struct {
+	// Reason is the revert the transaction returned.
+	Reason string `json:"reason"`
+	// Origin is the deepest failed frame on the revert's path, and
+	// OriginReason its own revert, which differs from Reason when the
+	// settlement translated it (BadCallTarget for a failed swap).
+	Origin       string `json:"origin"`
+	OriginReason string `json:"originReason"`
+	// Chain lists the failed frames from the root down to Origin.
+	Chain []string `json:"chain"`
+}
+
+// Explanation is a labelled trace and its summary.
+type Explanation struct {
+	Settlement common.Address `json:"settlement"`
+	Root       *Node          `json:"root"`
+	Intent     *Intent        `json:"intent,omitempty"`
+	Movements  []Movement     `json:"movements"`
+	Approvals  []Approval     `json:"approvals"`
+	// Refund is the return of unused input to the user; nil when none.
+	Refund *Movement `json:"refund,omitempty"`
+	Revert *Revert   `json:"revert,omitempty"`
+}
+
+// Explain labels root and summarises it.
+func (e *Explainer) Explain(root *Frame) *Explanation {
+	ex := &Explanation{Settlement: e.cfg.Settlement}
+	ex.Root = e.node(root, "0")
+	if ex.Settlement == (common.Address{}) {
+		ex.Settlement = e.findSettlement(ex.Root)
+	}
+	ex.Intent = e.intent(ex.Root)
+	e.walk(ex, ex.Root, false)
+	if ex.Intent != nil {
+		ex.Refund = refund(ex, ex.Intent)
+	}
+	if root.Error != "" {
+		ex.Revert = revertOf(ex.Root)
+	}
+	return ex
+}
+
+func (e *Explainer) node(f *Frame, path string) *Node {
+	n := &Node{
+		Path:    path,
+		Type:    f.Type,
+		From:    f.From,
+		To:      f.To,
+		GasUsed: uint64(f.GasUsed),
+		Error:   f.Error,
+		frame:   f,
+	}
+	if f.Value != nil && f.Value.ToInt().Sign() > 0 {
+		n.Value = f.Value.ToInt()
+	}
+	n.Contract = e.label(f.To)
+	if len(f.Input) >= 4 {
+		if k, m := e.method(f.To, f.Input[:4]); m != nil {
+			n.Method = m.Name
+			if n.Contract == "" {
+				n.Contract = k.name
+			}
+			if vals, err := m.Inputs.Unpack(f.Input[4:]); err == nil {
+				n.Args = args(m.Inputs, vals)
+			}
+		} else {
+			n.Method = hexutil.Encode(f.Input[:4])
+		}
+	}
+	if f.Error != "" && len(f.Output) > 0 {
+		n.Revert = strings.TrimPrefix(reverts.Decode(f.Output).Error(), "execution reverted: ")
+	} else if f.Error != "" && f.RevertReason != "" {
+		n.Revert = f.RevertReason
+	}
+	for _, l := range f.Logs {
+		n.Events = append(n.Events, e.event(l))
+	}
+	for i, c := range f.Calls {
+		n.Calls = append(n.Calls, e.node(c, path+"."+strconv.Itoa(i)))
+	}
+	return n
+}
+
+// label names addr from Labels, then the ABIs bound to it, then Routers.
+func (e *Explainer) label(addr common.Address) string {
+	if l, ok := e.cfg.Labels[addr]; ok {
+		return l
+	}
+	for _, k := range e.contracts {
+		if k.addrs[addr] {
+			return k.name
+		}
+	}
+	for _, r := range e.cfg.Routers {
+		if r == addr {
+			return "router"
+		}
+	}
+	return ""
+}
+
+// method finds the ABI method for sel, preferring the ABI bound to addr.
+func (e *Explainer) method(addr common.Address, sel []byte) (*known, *abi.Method) {
+	for _, k := range e.contracts {
+		if k.addrs[addr] {
+			if m, err := k.abi.MethodById(sel); err == nil {
+				return k, m
+			}
+		}
+	}
+	for _, k := range e.contracts {
+		if m, err := k.abi.MethodById(sel); err == nil {
+			return k, m
+		}
+	}
+	return nil, nil
+}
+
+func (e *Explainer) event(l FrameLog) Event {
+	ev := Event{Address: l.Address, Contract: e.label(l.Address), Topics: l.Topics, Data: l.Data}
+	if len(l.Topics) == 0 {
+		return ev
+	}
+	try := func(k *known) bool {
+		def, err := k.abi.EventByID(l.Topics[0])
+		if err != nil || countIndexed(def.Inputs) != len(l.Topics)-1 {
+			return false
+		}
+		vals := make(map[string]interface{})
+		if err := def.Inputs.NonIndexed().UnpackIntoMap(vals, l.Data); err != nil {
+			return false
+		}
+		var indexed abi.Arguments
+		for _, in := range def.Inputs {
+			if in.Indexed {
+				indexed = append(indexed, in)
+			}
+		}
+		if err := abi.ParseTopicsIntoMap(vals, indexed, l.Topics[1:]); err != nil {
+			return false
+		}
+		ev.Name = def.Name
+		ev.Topics, ev.Data = nil, nil
+		for _, in := range def.Inputs {
+			ev.Args = append(ev.Args, Arg{Name: in.Name, Type: in.Type.String(), Value: format(vals[in.Name])})
+		}
+		if ev.Contract == "" {
+			ev.Contract = k.name
+		}
+		return true
+	}
+	for _, k := range e.contracts {
+		if k.addrs[l.Address] && try(k) {
+			return ev
+		}
+	}
+	for _, k := range e.contracts {
+		if try(k) {
+			return ev
+		}
+	}
+	return ev
+}
+
+func countIndexed(args abi.Arguments) int {
+	n := 0
+	for _, a := range args {
+		if a.Indexed {
+			n++
+		}
+	}
+	return n
+}
+
+func isExecute(n *Node) bool {
+	return n.Method == "executeWithPermit" || n.Method == "executeWithETH"
+}
+
+func (e *Explainer) findSettlement(n *Node) common.Address {
+	if isExecute(n) {
+		if n.Type == "DELEGATECALL" {
+			return n.From
+		}
+		return n.To
+	}
+	for _, c := range n.Calls {
+		if a := e.findSettlement(c); a != (common.Address{}) {
+			return a
+		}
+	}
+	return common.Address{}
+}
+
+// intent decodes the outermost settlement call, preferring the delegated
+// frame of a proxy so its children are the settlement's own calls.
+func (e *Explainer) intent(root *Node) *Intent {
+	n := find(root, isExecute)
+	if n == nil {
+		return nil
+	}
+	if len(n.Calls) == 1 && n.Calls[0].Type == "DELEGATECALL" && isExecute(n.Calls[0]) {
+		n = n.Calls[0]
+	}
+	k, m := e.method(n.To, n.frame.Input[:4])
+	if k == nil {
+		return nil
+	}
+	vals, err := m.Inputs.Unpack(n.frame.Input[4:])
+	if err != nil {
+		return nil
+	}
+	intent := *abi.ConvertType(vals[0], new(fastsettlementv3.IFastSettlementV3Intent)).(*fastsettlementv3.IFastSettlementV3Intent)
+	swap := *abi.ConvertType(vals[len(vals)-1], new(fastsettlementv3.IFastSettlementV3SwapCall)).(*fastsettlementv3.IFastSettlementV3SwapCall)
+	out := &Intent{
+		Path:        n.Path,
+		Method:      n.Method,
+		User:        intent.User,
+		InputToken:  intent.InputToken,
+		SwapInput:   intent.InputToken,
+		OutputToken: intent.OutputToken,
+		InputAmt:    intent.InputAmt,
+		UserAmtOut:  intent.UserAmtOut,
+		Recipient:   intent.Recipient,
+		SwapTarget:  swap.To,
+	}
+	for _, c := range n.Calls {
+		if c.To == swap.To && c.Type == "CALL" && bytes.Equal(c.frame.Input, swap.Data) && out.SwapPath == "" {
+			out.SwapPath = c.Path
+			if c.Contract == "" {
+				c.Contract = "router"
+			}
+		}
+		if n.Method == "executeWithETH" && c.Method == "deposit" && out.SwapInput == (common.Address{}) {
+			out.SwapInput = c.To
+		}
+	}
+	if out.SwapInput == (common.Address{}) {
+		out.SwapInput = e.cfg.WETH
+	}
+	return out
+}
+
+func find(n *Node, match func(*Node) bool) *Node {
+	if match(n) {
+		return n
+	}
+	for _, c := range n.Calls {
+		if m := find(c, match); m != nil {
+			return m
+		}
+	}
+	return nil
+}
+
+// walk collects movements and approvals in execution order, interleaving
+// each frame's logs with its calls by position.
+func (e *Explainer) walk(ex *Explanation, n *Node, reverted bool) {
+	reverted = reverted || n.Error != ""
+	if !reverted && n.Value != nil && n.Type != "DELEGATECALL" && n.Type != "STATICCALL" {
+		ex.Movements = append(ex.Movements, Movement{Kind: KindETH, From: n.From, To: n.To, Amount: n.Value, Path: n.Path})
+	}
+	next := 0
+	for i := range n.Events {
+		for ; next < n.position(i); next++ {
+			e.walk(ex, n.Calls[next], reverted)
+		}
+		if !reverted {
+			e.record(ex, n.Events[i], n.Path)
+		}
+	}
+	for ; next < len(n.Calls); next++ {
+		e.walk(ex, n.Calls[next], reverted)
+	}
+}
+
+// position is the number of calls n made before emitting its i'th event.
+func (n *Node) position(i int) int {
+	if n.frame == nil || i >= len(n.frame.Logs) || int(n.frame.Logs[i].Position) > len(n.Calls) {
+		return len(n.Calls)
+	}
+	return int(n.frame.Logs[i].Position)
+}
+
+func (e *Explainer) record(ex *Explanation, ev Event, path string) {
+	arg := func(i int) string {
+		if i < len(ev.Args) {
+			return ev.Args[i].Value
+		}
+		return ""
+	}
+	amount := func(i int) *big.Int {
+		v, _ := new(big.Int).SetString(arg(i), 10)
+		return v
+	}
+	switch {
+	case ev.Name == "Transfer" && len(ev.Args) == 3 && ev.Args[2].Type == "uint256":
+		ex.Movements = append(ex.Movements, Movement{Kind: KindTransfer, Token: ev.Address,
+			From: common.HexToAddress(arg(0)), To: common.HexToAddress(arg(1)), Amount: amount(2), Path: path})
+	case ev.Name == "Deposit" && len(ev.Args) == 2:
+		ex.Movements = append(ex.Movements, Movement{Kind: KindWrap, Token: ev.Address,
+			To: common.HexToAddress(arg(0)), Amount: amount(1), Path: path})
+	case ev.Name == "Withdrawal" && len(ev.Args) == 2:
+		ex.Movements = append(ex.Movements, Movement{Kind: KindUnwrap, Token: ev.Address,
+			From: common.HexToAddress(arg(0)), Amount: amount(1), Path: path})
+	case ev.Name == "Approval" && len(ev.Args) == 3 && common.HexToAddress(arg(0)) == ex.Settlement:
+		spender, amt := common.HexToAddress(arg(1)), amount(2)
+		if amt == nil {
+			return
+		}
+		if amt.Sign() > 0 {
+			ex.Approvals = append(ex.Approvals, Approval{Token: ev.Address, Spender: spender, Amount: amt, SetAt: path})
+			return
+		}
+		for i := len(ex.Approvals) - 1; i >= 0; i-- {
+			a := &ex.Approvals[i]
+			if a.Token == ev.Address && a.Spender == spender && !a.Reset {
+				a.Reset, a.ResetAt = true, path
+				return
+			}
+		}
+	}
+}
+
+// refund finds the settlement's transfer of the swap input back to the user.
+func refund(ex *Explanation, in *Intent) *Movement {
+	for i := len(ex.Movements) - 1; i >= 0; i-- {
+		m := ex.Movements[i]
+		if m.Kind == KindTransfer && m.Token == in.SwapInput && m.From == ex.Settlement && m.To == in.User {
+			return &m
+		}
+	}
+	return nil
+}
+
+// revertOf follows the last failed call of each failed frame, which is the
+// one whose failure the frame reacted to, down to where the revert began.
+func revertOf(root *Node) *Revert {
+	r := &Revert{Reason: reason(root)}
+	n := root
+	for {
+		r.Chain = append(r.Chain, n.Path)
+		var next *Node
+		for _, c := range n.Calls {
+			if c.Error != "" {
+				next = c
+			}
+		}
+		if next == nil {
+			break
+		}
+		n = next
+	}
+	r.Origin, r.OriginReason = n.Path, reason(n)
+	return r
+}
+
+func reason(n *Node) string {
+	if n.Revert != "" {
+		return n.Revert
+	}
+	return n.Error
+}
+
+func args(defs abi.Arguments, vals []interface{}) []Arg {
+	out := make([]Arg, len(vals))
+	for i, v := range vals {
+		out[i] = Arg{Name: defs[i].Name, Type: defs[i].Type.String(), Value: format(v)}
+	}
+	return out
+}
+
+// format renders a decoded ABI value: addresses and bytes as hex, integers
+// in decimal, tuples as {name: value} and arrays as [a, b].
+func format(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case common.Address:
+		return x.Hex()
+	case *big.Int:
+		return x.String()
+	case []byte:
+		return hexutil.Encode(x)
+	case string:
+		return strconv.Quote(x)
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return hexutil.Encode(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		parts := make([]string, rv.Len())
+		for i := range parts {
+			parts[i] = format(rv.Index(i).Interface())
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	case reflect.Struct:
+		parts := make([]string, rv.NumField())
+		for i := range parts {
+			parts[i] = rv.Type().Field(i).Name + ": " + format(rv.Field(i).Interface())
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return ""
+		}
+		return format(rv.Elem().Interface())
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,238 @@
+package explain_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	ierc20token "github.com/primev/fastprotocolapp/contracts-abi/clients/IERC20Token"
+	"github.com/primev/fastprotocolapp/contracts-abi/explain"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+)
+
+// The simulated chain has no debug namespace, so the traces below are built
+// by hand in callTracer's shape.
+
+var (
+	executor  = common.HexToAddress("0x0000000000000000000000000000000000000e0e")
+	proxy     = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	impl      = common.HexToAddress("0x0000000000000000000000000000000000001111")
+	router    = common.HexToAddress("0x0000000000000000000000000000000000000f0f")
+	user      = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	recipient = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	tokenIn   = common.HexToAddress("0x000000000000000000000000000000000000000a")
+	tokenOut  = common.HexToAddress("0x000000000000000000000000000000000000000b")
+
+	settlementABI, _ = fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	erc20ABI, _      = ierc20token.Ierc20tokenMetaData.GetAbi()
+)
+
+func call(t *testing.T, contract *abi.ABI, method string, args ...interface{}) []byte {
+	t.Helper()
+	data, err := contract.Pack(method, args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// tokenLog is an ERC-20 Transfer or Approval emitted before the frame's
+// position'th call.
+func tokenLog(token common.Address, event string, a, b common.Address, amount int64, position uint) explain.FrameLog {
+	return explain.FrameLog{
+		Address:  token,
+		Topics:   []common.Hash{erc20ABI.Events[event].ID, common.BytesToHash(a.Bytes()), common.BytesToHash(b.Bytes())},
+		Data:     common.LeftPadBytes(big.NewInt(amount).Bytes(), 32),
+		Position: hexutil.Uint(position),
+	}
+}
+
+func frame(typ string, from, to common.Address, input []byte, calls ...*explain.Frame) *explain.Frame {
+	return &explain.Frame{Type: typ, From: from, To: to, Input: input, Calls: calls}
+}
+
+// settle is executeWithPermit selling 100 tokenIn for at least 90 tokenOut.
+// The router pulls 95, so 5 is refunded.
+func settle(t *testing.T, swapReverts bool) *explain.Frame {
+	t.Helper()
+	intent := fastsettlementv3.IFastSettlementV3Intent{
+		User: user, InputToken: tokenIn, OutputToken: tokenOut,
+		InputAmt: big.NewInt(100), UserAmtOut: big.NewInt(90),
+		Recipient: recipient, Deadline: big.NewInt(1 << 40), Nonce: big.NewInt(1),
+	}
+	swapData := []byte{0x12, 0x34, 0x56, 0x78}
+	swap := fastsettlementv3.IFastSettlementV3SwapCall{To: router, Value: new(big.Int), Data: swapData}
+	input := call(t, settlementABI, "executeWithPermit", intent, []byte{1}, swap)
+
+	pull := frame("CALL", proxy, permit2.CanonicalAddress, []byte{0xde, 0xad, 0xbe, 0xef},
+		frame("CALL", permit2.CanonicalAddress, tokenIn, call(t, erc20ABI, "transferFrom", user, proxy, big.NewInt(100))))
+	pull.Calls[0].Logs = []explain.FrameLog{tokenLog(tokenIn, "Transfer", user, proxy, 100, 0)}
+
+	approve := frame("CALL", proxy, tokenIn, call(t, erc20ABI, "approve", router, big.NewInt(100)))
+	approve.Logs = []explain.FrameLog{tokenLog(tokenIn, "Approval", proxy, router, 100, 0)}
+
+	swapFrame := frame("CALL", proxy, router, swapData,
+		frame("CALL", router, tokenIn, call(t, erc20ABI, "transferFrom", proxy, router, big.NewInt(95))),
+		frame("CALL", router, tokenOut, call(t, erc20ABI, "transfer", proxy, big.NewInt(92))),
+	)
+	swapFrame.Calls[0].Logs = []explain.FrameLog{tokenLog(tokenIn, "Transfer", proxy, router, 95, 0)}
+	swapFrame.Calls[1].Logs = []explain.FrameLog{tokenLog(tokenOut, "Transfer", router, proxy, 92, 0)}
+
+	delegate := frame("DELEGATECALL", proxy, impl, input, pull, approve, swapFrame)
+	root := frame("CALL", executor, proxy, input, delegate)
+	if swapReverts {
+		reason := append(common.FromHex("0x08c379a0"), abiString(t, "router: too little")...)
+		swapFrame.Calls, swapFrame.Error, swapFrame.Output = nil, "execution reverted", reason
+		badCall := reverts.New("BadCallTarget").Data
+		delegate.Error, delegate.Output = "execution reverted", badCall
+		root.Error, root.Output = "execution reverted", badCall
+		// The reverted transaction keeps no logs.
+		pull.Calls[0].Logs, approve.Logs = nil, nil
+		return root
+	}
+
+	reset := frame("CALL", proxy, tokenIn, call(t, erc20ABI, "approve", router, big.NewInt(0)))
+	reset.Logs = []explain.FrameLog{tokenLog(tokenIn, "Approval", proxy, router, 0, 0)}
+	refund := frame("CALL", proxy, tokenIn, call(t, erc20ABI, "transfer", user, big.NewInt(5)))
+	refund.Logs = []explain.FrameLog{tokenLog(tokenIn, "Transfer", proxy, user, 5, 0)}
+	pay := frame("CALL", proxy, tokenOut, call(t, erc20ABI, "transfer", recipient, big.NewInt(92)))
+	pay.Logs = []explain.FrameLog{tokenLog(tokenOut, "Transfer", proxy, recipient, 92, 0)}
+	delegate.Calls = append(delegate.Calls, reset, refund, pay)
+	return root
+}
+
+func abiString(t *testing.T, s string) []byte {
+	t.Helper()
+	str, _ := abi.NewType("string", "", nil)
+	data, err := abi.Arguments{{Type: str}}.Pack(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestExplain(t *testing.T) {
+	ex := explain.New(explain.Config{Routers: []common.Address{router}}).Explain(settle(t, false))
+	if ex.Settlement != proxy {
+		t.Errorf("settlement %s, want the proxy", ex.Settlement)
+	}
+	in := ex.Intent
+	if in == nil || in.Path != "0.0" || in.User != user || in.SwapTarget != router || in.SwapPath != "0.0.2" {
+		t.Fatalf("intent %+v", in)
+	}
+	if ex.Root.Method != "executeWithPermit" || ex.Root.Calls[0].Calls[0].Contract != "Permit2" {
+		t.Errorf("labels: root %s, permit2 frame %q", ex.Root.Method, ex.Root.Calls[0].Calls[0].Contract)
+	}
+
+	want := []struct {
+		path     string
+		token    common.Address
+		from, to common.Address
+		amount   int64
+	}{
+		{"0.0.0.0", tokenIn, user, proxy, 100},
+		{"0.0.2.0", tokenIn, proxy, router, 95},
+		{"0.0.2.1", tokenOut, router, proxy, 92},
+		{"0.0.4", tokenIn, proxy, user, 5},
+		{"0.0.5", tokenOut, proxy, recipient, 92},
+	}
+	if len(ex.Movements) != len(want) {
+		t.Fatalf("%d movements, want %d: %+v", len(ex.Movements), len(want), ex.Movements)
+	}
+	for i, w := range want {
+		m := ex.Movements[i]
+		if m.Path != w.path || m.Token != w.token || m.From != w.from || m.To != w.to || m.Amount.Int64() != w.amount {
+			t.Errorf("movement %d: %+v, want %+v", i, m, w)
+		}
+	}
+	if len(ex.Approvals) != 1 || !ex.Approvals[0].Reset || ex.Approvals[0].ResetAt != "0.0.3" {
+		t.Errorf("approvals %+v, want one reset at 0.0.3", ex.Approvals)
+	}
+	if ex.Refund == nil || ex.Refund.Amount.Int64() != 5 {
+		t.Errorf("refund %+v, want 5", ex.Refund)
+	}
+	if ex.Revert != nil {
+		t.Errorf("revert %+v on a successful trace", ex.Revert)
+	}
+	if _, err := json.Marshal(ex); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExplainRevert(t *testing.T) {
+	e := explain.New(explain.Config{Settlement: proxy, Labels: map[common.Address]string{router: "uniswap"}})
+	ex := e.Explain(settle(t, true))
+	r := ex.Revert
+	if r == nil {
+		t.Fatal("no revert")
+	}
+	if !strings.Contains(r.Reason, "BadCallTarget") || r.Origin != "0.0.2" || !strings.Contains(r.OriginReason, "router: too little") {
+		t.Errorf("revert %+v, want BadCallTarget originating in the router", r)
+	}
+	if got := strings.Join(r.Chain, " "); got != "0 0.0 0.0.2" {
+		t.Errorf("chain %s", got)
+	}
+	if len(ex.Movements) != 0 || ex.Refund != nil {
+		t.Errorf("movements %+v in a reverted trace", ex.Movements)
+	}
+
+	var out strings.Builder
+	if err := ex.WriteText(&out); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"REVERTED: ", "originated at 0.0.2", "uniswap"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("text lacks %q:\n%s", want, out.String())
+		}
+	}
+}
+
+// tracer answers every request with root and records it.
+type tracer struct {
+	root   *explain.Frame
+	method string
+	args   []interface{}
+}
+
+func (tr *tracer) CallContext(_ context.Context, result interface{}, method string, args ...interface{}) error {
+	tr.method, tr.args = method, args
+	raw, err := json.Marshal(tr.root)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, result)
+}
+
+func TestTrace(t *testing.T) {
+	ctx := context.Background()
+	tr := &tracer{root: settle(t, false)}
+	root, err := explain.Transaction(ctx, tr, common.HexToHash("0x01"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.method != "debug_traceTransaction" || len(root.Calls) != 1 || len(root.Calls[0].Calls) != 6 {
+		t.Fatalf("%s returned %d calls", tr.method, len(root.Calls))
+	}
+
+	to := proxy
+	if _, err := explain.Call(ctx, tr, ethereum.CallMsg{From: executor, To: &to, Data: []byte{1}}, big.NewInt(16)); err != nil {
+		t.Fatal(err)
+	}
+	if tr.method != "debug_traceCall" || tr.args[1] != "0x10" {
+		t.Errorf("%s at %v", tr.method, tr.args[1])
+	}
+
+	tr.root = nil
+	if _, err := explain.Transaction(ctx, tr, common.HexToHash("0x01")); !errors.Is(err, explain.ErrNoTrace) {
+		t.Fatalf("err = %v, want ErrNoTrace", err)
+	}
+}
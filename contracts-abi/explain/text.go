@@ -0,0 +1,160 @@
+package explain
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// WriteText writes the summary followed by the labelled call tree.
+func (ex *Explanation) WriteText(w io.Writer) error {
+	p := &printer{w: w, ex: ex}
+	if in := ex.Intent; in != nil {
+		p.printf("%s at %s by %s\n", in.Method, in.Path, p.name(in.User))
+		p.printf("  sell %s %s for at least %s %s to %s\n", in.InputAmt, p.token(in.InputToken), in.UserAmtOut, p.token(in.OutputToken), p.name(in.Recipient))
+		swap := "not reached"
+		if in.SwapPath != "" {
+			swap = "at " + in.SwapPath
+		}
+		p.printf("  swap target %s, %s\n", p.name(in.SwapTarget), swap)
+	}
+	if r := ex.Revert; r != nil {
+		p.printf("\nREVERTED: %s\n", r.Reason)
+		if r.Origin != r.Chain[0] {
+			p.printf("  originated at %s: %s\n", r.Origin, r.OriginReason)
+			p.printf("  via %s\n", strings.Join(r.Chain, " > "))
+		}
+	}
+
+	p.printf("\nMovements:\n")
+	if len(ex.Movements) == 0 {
+		p.printf("  none\n")
+	}
+	for _, m := range ex.Movements {
+		switch m.Kind {
+		case KindWrap:
+			p.printf("  %-8s wrap %s %s for %s\n", m.Path, m.Amount, p.token(m.Token), p.name(m.To))
+		case KindUnwrap:
+			p.printf("  %-8s unwrap %s %s for %s\n", m.Path, m.Amount, p.token(m.Token), p.name(m.From))
+		default:
+			p.printf("  %-8s %s %s  %s -> %s\n", m.Path, m.Amount, p.token(m.Token), p.name(m.From), p.name(m.To))
+		}
+	}
+
+	p.printf("\nApprovals:\n")
+	if len(ex.Approvals) == 0 {
+		p.printf("  none\n")
+	}
+	for _, a := range ex.Approvals {
+		state := "NOT RESET"
+		if a.Reset {
+			state = "reset at " + a.ResetAt
+		}
+		p.printf("  %-8s %s %s to %s, %s\n", a.SetAt, a.Amount, p.token(a.Token), p.name(a.Spender), state)
+	}
+
+	if ex.Intent != nil {
+		p.printf("\nRefund: ")
+		if ex.Refund != nil {
+			p.printf("%s %s to %s at %s\n", ex.Refund.Amount, p.token(ex.Refund.Token), p.name(ex.Refund.To), ex.Refund.Path)
+		} else {
+			p.printf("none\n")
+		}
+	}
+
+	p.printf("\nCalls:\n")
+	p.tree(ex.Root, 1)
+	return p.err
+}
+
+type printer struct {
+	w   io.Writer
+	ex  *Explanation
+	err error
+}
+
+func (p *printer) printf(format string, args ...interface{}) {
+	if p.err == nil {
+		_, p.err = fmt.Fprintf(p.w, format, args...)
+	}
+}
+
+// name renders addr with the label of the frame that called it, if any.
+func (p *printer) name(addr common.Address) string {
+	if addr == p.ex.Settlement && addr != (common.Address{}) {
+		return "settlement"
+	}
+	if n := find(p.ex.Root, func(n *Node) bool { return n.To == addr && n.Contract != "" }); n != nil {
+		return n.Contract + "(" + short(addr) + ")"
+	}
+	return short(addr)
+}
+
+func (p *printer) token(addr common.Address) string {
+	if addr == (common.Address{}) {
+		return "ETH"
+	}
+	return p.name(addr)
+}
+
+func short(addr common.Address) string {
+	h := addr.Hex()
+	return h[:6] + ".." + h[len(h)-4:]
+}
+
+func (p *printer) tree(n *Node, depth int) {
+	indent := strings.Repeat("  ", depth)
+	contract := n.Contract
+	if contract == "" {
+		contract = short(n.To)
+	}
+	p.printf("%s[%s] %s %s.%s(", indent, n.Path, n.Type, contract, n.Method)
+	for i, a := range n.Args {
+		if i > 0 {
+			p.printf(", ")
+		}
+		if a.Name != "" {
+			p.printf("%s=", a.Name)
+		}
+		p.printf("%s", a.Value)
+	}
+	p.printf(")")
+	if n.Value != nil {
+		p.printf(" value=%s", n.Value)
+	}
+	p.printf(" gas=%d", n.GasUsed)
+	if n.Error != "" {
+		p.printf(" FAILED: %s", reason(n))
+	}
+	p.printf("\n")
+
+	next := 0
+	for i, ev := range n.Events {
+		for ; next < n.position(i); next++ {
+			p.tree(n.Calls[next], depth+1)
+		}
+		p.event(ev, depth+1)
+	}
+	for ; next < len(n.Calls); next++ {
+		p.tree(n.Calls[next], depth+1)
+	}
+}
+
+func (p *printer) event(ev Event, depth int) {
+	indent := strings.Repeat("  ", depth)
+	if ev.Name == "" {
+		p.printf("%semit %s unknown topics=%v data=%s\n", indent, short(ev.Address), ev.Topics, ev.Data)
+		return
+	}
+	parts := make([]string, len(ev.Args))
+	for i, a := range ev.Args {
+		parts[i] = a.Name + "=" + a.Value
+	}
+	contract := ev.Contract
+	if contract == "" {
+		contract = short(ev.Address)
+	}
+	p.printf("%semit %s.%s(%s)\n", indent, contract, ev.Name, strings.Join(parts, ", "))
+}
@@ -0,0 +1,112 @@
+package explain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ErrNoTrace is returned when the node answers a trace request with no frame.
+var ErrNoTrace = errors.New("explain: empty trace")
+
+// Tracer issues raw JSON-RPC calls. *rpc.Client satisfies it; the node must
+// expose the debug namespace.
+type Tracer interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// Frame is one call frame of geth's callTracer output.
+type Frame struct {
+	Type         string         `json:"type"`
+	From         common.Address `json:"from"`
+	To           common.Address `json:"to"`
+	Value        *hexutil.Big   `json:"value,omitempty"`
+	Gas          hexutil.Uint64 `json:"gas"`
+	GasUsed      hexutil.Uint64 `json:"gasUsed"`
+	Input        hexutil.Bytes  `json:"input"`
+	Output       hexutil.Bytes  `json:"output,omitempty"`
+	Error        string         `json:"error,omitempty"`
+	RevertReason string         `json:"revertReason,omitempty"`
+	Calls        []*Frame       `json:"calls,omitempty"`
+	// Logs are only populated with withLog; the tracer drops the logs of
+	// frames that reverted.
+	Logs []FrameLog `json:"logs,omitempty"`
+}
+
+// FrameLog is a log emitted directly by a frame.
+type FrameLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+	// Position is the index of the first child call made after the log was
+	// emitted, which orders logs against the frame's calls.
+	Position hexutil.Uint `json:"position"`
+}
+
+var tracerConfig = map[string]interface{}{
+	"tracer":       "callTracer",
+	"tracerConfig": map[string]interface{}{"withLog": true},
+}
+
+// Transaction traces a mined transaction with debug_traceTransaction.
+func Transaction(ctx context.Context, t Tracer, hash common.Hash) (*Frame, error) {
+	var root *Frame
+	if err := t.CallContext(ctx, &root, "debug_traceTransaction", hash, tracerConfig); err != nil {
+		return nil, fmt.Errorf("explain: trace %s: %w", hash, err)
+	}
+	if root == nil {
+		return nil, ErrNoTrace
+	}
+	return root, nil
+}
+
+// Call traces msg executed on top of block with debug_traceCall, so a
+// settlement can be explained before it is sent. A nil block means latest.
+func Call(ctx context.Context, t Tracer, msg ethereum.CallMsg, block *big.Int) (*Frame, error) {
+	var root *Frame
+	if err := t.CallContext(ctx, &root, "debug_traceCall", callArg(msg), blockArg(block), tracerConfig); err != nil {
+		return nil, fmt.Errorf("explain: trace call: %w", err)
+	}
+	if root == nil {
+		return nil, ErrNoTrace
+	}
+	return root, nil
+}
+
+func callArg(msg ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["input"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	if msg.GasFeeCap != nil {
+		arg["maxFeePerGas"] = (*hexutil.Big)(msg.GasFeeCap)
+	}
+	if msg.GasTipCap != nil {
+		arg["maxPriorityFeePerGas"] = (*hexutil.Big)(msg.GasTipCap)
+	}
+	return arg
+}
+
+func blockArg(n *big.Int) string {
+	if n == nil {
+		return "latest"
+	}
+	return hexutil.EncodeBig(n)
+}
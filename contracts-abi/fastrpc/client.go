@@ -0,0 +1,194 @@
+// Package fastrpc is a client for the Fast RPC transaction status API,
+//
+//	GET https://fastrpc.mev-commit.xyz/status/{hash}   Authorization: Bearer <token>
+//
+// the Go counterpart of the web app's api/transaction-status/[hash] route,
+// plus a Watcher that merges the preconfirmation status with the on-chain
+// receipt and IntentExecuted log into one settlement-status stream. StandIn
+// serves the status API locally.
+package fastrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultBaseURL is the public Fast RPC endpoint.
+const DefaultBaseURL = "https://fastrpc.mev-commit.xyz"
+
+var (
+	// ErrNotFound is returned when Fast RPC has no record of the hash.
+	ErrNotFound = errors.New("fastrpc: transaction not found")
+	// ErrUnauthorized is returned when the token is missing or rejected.
+	ErrUnauthorized = errors.New("fastrpc: unauthorized")
+)
+
+// APIError is a non-2xx response.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("fastrpc: HTTP %d: %s", e.StatusCode, e.Body)
+}
+
+// Unwrap maps 401/403 to ErrUnauthorized and 404 to ErrNotFound.
+func (e *APIError) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (e *APIError) retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// State is a transaction's preconfirmation state.
+type State string
+
+const (
+	StatePending      State = "pending"
+	StatePreconfirmed State = "preconfirmed"
+	StateConfirmed    State = "confirmed"
+	StateFailed       State = "failed"
+	StateDropped      State = "dropped"
+)
+
+// Final reports whether Fast RPC will not update the state further.
+func (s State) Final() bool {
+	return s == StateConfirmed || s == StateFailed || s == StateDropped
+}
+
+// Commitment is a provider's preconfirmation commitment.
+type Commitment struct {
+	Provider    common.Address `json:"provider"`
+	BlockNumber uint64         `json:"blockNumber"`
+	Digest      string         `json:"commitmentDigest,omitempty"`
+}
+
+// Status is the status API response. Fields the client does not model are
+// kept in Raw.
+type Status struct {
+	Hash        common.Hash  `json:"hash"`
+	State       State        `json:"status"`
+	BlockNumber uint64       `json:"blockNumber,omitempty"`
+	Commitments []Commitment `json:"commitments,omitempty"`
+	Error       string       `json:"error,omitempty"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+// Client queries the status API.
+type Client struct {
+	// BaseURL defaults to DefaultBaseURL.
+	BaseURL string
+	// Token is sent as a bearer token.
+	Token      string
+	HTTPClient *http.Client
+	// Timeout bounds each attempt. Defaults to 10s.
+	Timeout time.Duration
+	// MaxRetries is the number of retries after the first attempt for
+	// network errors, 429 and 5xx. Defaults to 3; negative disables retries.
+	MaxRetries int
+	// Backoff is the first retry delay, doubled per retry with jitter.
+	// Defaults to 250ms. A Retry-After header takes precedence.
+	Backoff time.Duration
+}
+
+// Status returns the status of the transaction with the given hash.
+func (c *Client) Status(ctx context.Context, hash common.Hash) (*Status, error) {
+	retries := c.MaxRetries
+	switch {
+	case retries == 0:
+		retries = 3
+	case retries < 0:
+		retries = 0
+	}
+	backoff := c.Backoff
+	if backoff == 0 {
+		backoff = 250 * time.Millisecond
+	}
+	for attempt := 0; ; attempt++ {
+		st, wait, err := c.try(ctx, hash)
+		if err == nil {
+			return st, nil
+		}
+		var apiErr *APIError
+		if attempt == retries || ctx.Err() != nil || (errors.As(err, &apiErr) && !apiErr.retryable()) {
+			return nil, err
+		}
+		if wait == 0 {
+			wait = backoff<<attempt + time.Duration(rand.Int63n(int64(backoff)))
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// try makes one request, returning the server's Retry-After on failure.
+func (c *Client) try(ctx context.Context, hash common.Hash) (*Status, time.Duration, error) {
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	base := c.BaseURL
+	if base == "" {
+		base = DefaultBaseURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(base, "/")+"/status/"+hash.Hex(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("fastrpc: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, 0, fmt.Errorf("fastrpc: read body: %w", err)
+	}
+	if resp.StatusCode/100 != 2 {
+		var wait time.Duration
+		if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && s >= 0 {
+			wait = time.Duration(s) * time.Second
+		}
+		return nil, wait, &APIError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(body))}
+	}
+	var st Status
+	if err := json.Unmarshal(body, &st); err != nil {
+		return nil, 0, fmt.Errorf("fastrpc: decode status: %w", err)
+	}
+	if st.Hash == (common.Hash{}) {
+		st.Hash = hash
+	}
+	st.Raw = body
+	return &st, 0, nil
+}
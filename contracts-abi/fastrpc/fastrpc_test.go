@@ -0,0 +1,200 @@
+package fastrpc_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	"github.com/primev/fastprotocolapp/contracts-abi/fastrpc"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+var (
+	proxy    = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	user     = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	provider = common.HexToAddress("0x0000000000000000000000000000000000000e11")
+	txHash   = common.HexToHash("0x01")
+)
+
+func TestStatus(t *testing.T) {
+	s := fastrpc.NewStandIn("token")
+	defer s.Close()
+	s.Set(txHash, fastrpc.Status{
+		State:       fastrpc.StatePreconfirmed,
+		Commitments: []fastrpc.Commitment{{Provider: provider, BlockNumber: 100}},
+	})
+	st, err := s.Client().Status(context.Background(), txHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Hash != txHash || st.State != fastrpc.StatePreconfirmed || len(st.Commitments) != 1 || st.Commitments[0].Provider != provider {
+		t.Fatalf("status %+v", st)
+	}
+	if len(st.Raw) == 0 {
+		t.Error("Raw is empty")
+	}
+}
+
+func TestStatusErrors(t *testing.T) {
+	s := fastrpc.NewStandIn("token")
+	defer s.Close()
+	s.Set(txHash, fastrpc.Status{State: fastrpc.StatePending})
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name string
+		// fail is the number of 503s served before answering.
+		fail     int
+		client   func(*fastrpc.Client)
+		hash     common.Hash
+		want     error
+		requests int
+	}{
+		{"not found", 0, nil, common.HexToHash("0x02"), fastrpc.ErrNotFound, 1},
+		{"bad token", 0, func(c *fastrpc.Client) { c.Token = "wrong" }, txHash, fastrpc.ErrUnauthorized, 1},
+		{"retried 503s", 2, nil, txHash, nil, 3},
+		{"retries exhausted", 5, func(c *fastrpc.Client) { c.MaxRetries = 1 }, txHash, &fastrpc.APIError{}, 2},
+		{"retries disabled", 5, func(c *fastrpc.Client) { c.MaxRetries = -1 }, txHash, &fastrpc.APIError{}, 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s.Fail(tt.fail)
+			before := s.Requests()
+			c := s.Client()
+			if tt.client != nil {
+				tt.client(c)
+			}
+			_, err := c.Status(ctx, tt.hash)
+			switch want := tt.want.(type) {
+			case nil:
+				if err != nil {
+					t.Fatal(err)
+				}
+			case *fastrpc.APIError:
+				var apiErr *fastrpc.APIError
+				if !errors.As(err, &apiErr) || apiErr.StatusCode != 503 {
+					t.Fatalf("err = %v, want HTTP 503", err)
+				}
+			default:
+				if !errors.Is(err, want) {
+					t.Fatalf("err = %v, want %v", err, want)
+				}
+			}
+			if got := s.Requests() - before; got != tt.requests {
+				t.Errorf("%d requests, want %d", got, tt.requests)
+			}
+			s.Fail(0)
+		})
+	}
+}
+
+// receipts serves a receipt once one is set.
+type receipts struct {
+	mu sync.Mutex
+	r  *types.Receipt
+}
+
+func (c *receipts) set(r *types.Receipt) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.r = r
+}
+
+func (c *receipts) TransactionReceipt(context.Context, common.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.r == nil {
+		return nil, ethereum.NotFound
+	}
+	return c.r, nil
+}
+
+func executedLog(t *testing.T) *types.Log {
+	t.Helper()
+	parsed, err := fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := parsed.Events["IntentExecuted"].Inputs.NonIndexed().Pack(big.NewInt(1e18), big.NewInt(3_000e6), big.NewInt(3_001e6), big.NewInt(1e6))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &types.Log{
+		Address: proxy,
+		Topics:  []common.Hash{settlement.IntentExecutedTopic, common.BytesToHash(user.Bytes()), {}, {}},
+		Data:    data,
+		TxHash:  txHash,
+	}
+}
+
+func TestWatch(t *testing.T) {
+	s := fastrpc.NewStandIn("token")
+	defer s.Close()
+	chain := &receipts{}
+	w := &fastrpc.Watcher{Status: s.Client(), Chain: chain, Settlement: proxy, Interval: time.Millisecond}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s.Set(txHash, fastrpc.Status{State: fastrpc.StatePending})
+	updates := w.Watch(ctx, txHash)
+	next := func(want fastrpc.Stage) fastrpc.Update {
+		t.Helper()
+		u, ok := <-updates
+		if !ok {
+			t.Fatalf("stream closed, want %s", want)
+		}
+		if u.Stage != want {
+			t.Fatalf("stage %s, want %s", u.Stage, want)
+		}
+		return u
+	}
+	next(fastrpc.StagePending)
+	s.Set(txHash, fastrpc.Status{State: fastrpc.StatePreconfirmed, Commitments: []fastrpc.Commitment{{Provider: provider}}})
+	if u := next(fastrpc.StagePreconfirmed); u.Preconf == nil || len(u.Preconf.Commitments) != 1 {
+		t.Fatalf("preconf %+v", u.Preconf)
+	}
+	chain.set(&types.Receipt{TxHash: txHash, Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{executedLog(t)}})
+	u := next(fastrpc.StageSettled)
+	if u.Executed == nil || u.Executed.User != user || u.Executed.Received.Cmp(big.NewInt(3_001e6)) != 0 {
+		t.Fatalf("executed %+v", u.Executed)
+	}
+	if _, ok := <-updates; ok {
+		t.Fatal("stream not closed after a final stage")
+	}
+}
+
+func TestWaitFinalStages(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		status  fastrpc.State
+		receipt *types.Receipt
+		want    fastrpc.Stage
+	}{
+		{"dropped by Fast RPC", fastrpc.StateDropped, nil, fastrpc.StageDropped},
+		{"reverted", fastrpc.StateConfirmed, &types.Receipt{Status: types.ReceiptStatusFailed}, fastrpc.StageFailed},
+		{"mined without IntentExecuted", fastrpc.StateConfirmed, &types.Receipt{Status: types.ReceiptStatusSuccessful}, fastrpc.StageFailed},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := fastrpc.NewStandIn("token")
+			defer s.Close()
+			s.Set(txHash, fastrpc.Status{State: tt.status})
+			chain := &receipts{r: tt.receipt}
+			w := &fastrpc.Watcher{Status: s.Client(), Chain: chain, Settlement: proxy, Interval: time.Millisecond}
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			u, err := w.Wait(ctx, txHash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if u.Stage != tt.want {
+				t.Fatalf("stage %s, want %s", u.Stage, tt.want)
+			}
+		})
+	}
+}
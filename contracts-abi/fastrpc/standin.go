@@ -0,0 +1,89 @@
+package fastrpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StandIn is a local status API. It answers GET /status/{hash} with the
+// status set for the hash, 404 for unknown hashes and 401 when the bearer
+// token does not match.
+type StandIn struct {
+	*httptest.Server
+
+	token string
+
+	mu       sync.Mutex
+	statuses map[common.Hash]Status
+	failures int
+	requests int
+}
+
+// NewStandIn starts a StandIn expecting token. Close it when done.
+func NewStandIn(token string) *StandIn {
+	s := &StandIn{token: token, statuses: make(map[common.Hash]Status)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status/{hash}", s.serve)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Client returns a Client for the stand-in with retries sped up.
+func (s *StandIn) Client() *Client {
+	return &Client{BaseURL: s.URL, Token: s.token, HTTPClient: s.Server.Client(), Backoff: 10 * time.Millisecond}
+}
+
+// Set makes the stand-in report st for hash.
+func (s *StandIn) Set(hash common.Hash, st Status) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st.Hash = hash
+	s.statuses[hash] = st
+}
+
+// Fail makes the next n requests fail with 503.
+func (s *StandIn) Fail(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = n
+}
+
+// Requests returns the number of requests received so far.
+func (s *StandIn) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *StandIn) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	if r.Header.Get("Authorization") != "Bearer "+s.token {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if s.failures > 0 {
+		s.failures--
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	hash, err := hexutil.Decode(r.PathValue("hash"))
+	if err != nil || len(hash) != common.HashLength {
+		http.Error(w, "invalid hash", http.StatusBadRequest)
+		return
+	}
+	st, ok := s.statuses[common.BytesToHash(hash)]
+	if !ok {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(st)
+}
@@ -0,0 +1,184 @@
+package fastrpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+// Stage is a settlement transaction's combined progress.
+type Stage string
+
+const (
+	// StagePending: known to neither Fast RPC's providers nor the chain.
+	StagePending Stage = "pending"
+	// StagePreconfirmed: a provider committed to include it.
+	StagePreconfirmed Stage = "preconfirmed"
+	// StageSettled: mined, and the receipt carries IntentExecuted.
+	StageSettled Stage = "settled"
+	// StageFailed: mined and reverted, or mined without IntentExecuted.
+	StageFailed Stage = "failed"
+	// StageDropped: Fast RPC gave up on it and it is not on chain.
+	StageDropped Stage = "dropped"
+)
+
+// Final reports whether the stage is terminal.
+func (s Stage) Final() bool {
+	return s == StageSettled || s == StageFailed || s == StageDropped
+}
+
+// Update is one observation of a settlement transaction.
+type Update struct {
+	Hash  common.Hash
+	Stage Stage
+	// Preconf is the latest Fast RPC status, nil until one is available.
+	Preconf *Status
+	Receipt *types.Receipt
+	// Executed is the IntentExecuted log of a settled transaction.
+	Executed *settlement.IntentExecuted
+	// Err is the last transient error; the watcher keeps polling after it.
+	Err error
+}
+
+// ReceiptReader is the part of ethclient.Client the Watcher needs.
+type ReceiptReader interface {
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+}
+
+// Watcher polls Fast RPC and the chain for settlement transactions.
+type Watcher struct {
+	Status *Client
+	Chain  ReceiptReader
+	// Settlement is the FastSettlementV3 proxy whose IntentExecuted logs are
+	// parsed from receipts.
+	Settlement common.Address
+	// Interval between polls. Defaults to 1s.
+	Interval time.Duration
+}
+
+// Watch polls until the transaction reaches a final stage or ctx is done,
+// sending an Update whenever the stage, preconfirmation state or error
+// changes. The channel is closed when watching stops.
+func (w *Watcher) Watch(ctx context.Context, hash common.Hash) <-chan Update {
+	out := make(chan Update, 1)
+	go func() {
+		defer close(out)
+		interval := w.Interval
+		if interval == 0 {
+			interval = time.Second
+		}
+		var last Update
+		first := true
+		for {
+			u := w.poll(ctx, hash, last)
+			if ctx.Err() != nil {
+				return
+			}
+			if first || changed(last, u) {
+				select {
+				case out <- u:
+				case <-ctx.Done():
+					return
+				}
+				first = false
+			}
+			last = u
+			if u.Stage.Final() {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	}()
+	return out
+}
+
+// Wait watches until the transaction reaches a final stage and returns the
+// final update.
+func (w *Watcher) Wait(ctx context.Context, hash common.Hash) (Update, error) {
+	var last Update
+	for u := range w.Watch(ctx, hash) {
+		last = u
+	}
+	if !last.Stage.Final() {
+		return last, ctx.Err()
+	}
+	return last, nil
+}
+
+func (w *Watcher) poll(ctx context.Context, hash common.Hash, prev Update) Update {
+	u := Update{Hash: hash, Stage: StagePending, Preconf: prev.Preconf}
+	receipt, err := w.Chain.TransactionReceipt(ctx, hash)
+	switch {
+	case err == nil:
+		u.Receipt = receipt
+		u.Stage, u.Executed, u.Err = w.settled(receipt)
+		return u
+	case !errors.Is(err, ethereum.NotFound):
+		u.Err = err
+	}
+	if w.Status != nil {
+		st, err := w.Status.Status(ctx, hash)
+		switch {
+		case err == nil:
+			u.Preconf = st
+		case !errors.Is(err, ErrNotFound):
+			u.Err = err
+		}
+	}
+	if u.Preconf != nil {
+		switch u.Preconf.State {
+		case StatePreconfirmed, StateConfirmed:
+			// Confirmed by Fast RPC but not yet visible to our node.
+			u.Stage = StagePreconfirmed
+		case StateFailed, StateDropped:
+			u.Stage = StageDropped
+		}
+	}
+	return u
+}
+
+// settled classifies a mined receipt.
+func (w *Watcher) settled(r *types.Receipt) (Stage, *settlement.IntentExecuted, error) {
+	if r.Status != types.ReceiptStatusSuccessful {
+		return StageFailed, nil, nil
+	}
+	parser, err := settlement.NewV3(w.Settlement, nil)
+	if err != nil {
+		return StageFailed, nil, err
+	}
+	for _, l := range r.Logs {
+		if l.Address != w.Settlement {
+			continue
+		}
+		// Other settlement events fail the signature check.
+		if ev, err := parser.ParseIntentExecuted(*l); err == nil {
+			return StageSettled, ev, nil
+		}
+	}
+	return StageFailed, nil, nil
+}
+
+func changed(a, b Update) bool {
+	if a.Stage != b.Stage || (a.Err == nil) != (b.Err == nil) {
+		return true
+	}
+	if a.Err != nil && a.Err.Error() != b.Err.Error() {
+		return true
+	}
+	switch {
+	case a.Preconf == nil && b.Preconf == nil:
+		return false
+	case a.Preconf == nil || b.Preconf == nil:
+		return true
+	}
+	return a.Preconf.State != b.Preconf.State || len(a.Preconf.Commitments) != len(b.Preconf.Commitments)
+}
@@ -0,0 +1,5 @@
+// Package contractsabi hosts the Go bindings for the Fast Protocol contracts.
+// The bindings under clients/ are generated; see cmd/bindgen.
+package contractsabi
+
+//go:generate go run ./cmd/bindgen -extract
@@ -0,0 +1,415 @@
+// Package intake is the executor's HTTP/JSON entry point for signed intents.
+//
+//	POST /intents          {intent, signature, swapHint} → 202 {hash, status}
+//	GET  /intents/{hash}   → {hash, status, intent, txHash, received, surplus, error}
+//
+// Intents are keyed by their EIP-712 witness hash (permit2.WitnessHash), the
+// same value FastSettlementV3 passes to Permit2. A submission is decoded,
+// checked against the contract's _validateIntent rules and the user's Permit2
+// signature, stored, and enqueued; the executor then reports progress through
+// the Mark* methods.
+package intake
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/primev/fastprotocolapp/contracts-abi/codec"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"github.com/primev/fastprotocolapp/contracts-abi/telemetry"
+)
+
+var (
+	// ErrFinal is returned when updating an intent that already settled or
+	// failed.
+	ErrFinal = errors.New("intake: intent already final")
+	// ErrInvalidTransition is returned when an update would move an intent
+	// back to an earlier status.
+	ErrInvalidTransition = errors.New("intake: invalid status transition")
+)
+
+// RejectError is a pre-flight rejection. Code reuses the contract's custom
+// error name where one applies, so clients see the same vocabulary whether an
+// intent is rejected here or reverts on chain.
+type RejectError struct {
+	Code   string
+	Reason string
+}
+
+func (e *RejectError) Error() string { return fmt.Sprintf("%s: %s", e.Code, e.Reason) }
+
+func reject(code, format string, args ...interface{}) *RejectError {
+	return &RejectError{Code: code, Reason: fmt.Sprintf(format, args...)}
+}
+
+// Config configures a Server.
+type Config struct {
+	// Domain is the Permit2 domain intents are signed against.
+	Domain permit2.Domain
+	// Settlement is the FastSettlementV3 proxy, the Permit2 spender.
+	Settlement common.Address
+	// Queue receives accepted intents. Required.
+	Queue Queue
+	// Store defaults to a MemoryStore.
+	Store Store
+	// Targets, when set, is used to reject swap hints whose target is not
+	// allowlisted on the contract.
+	Targets settlement.Caller
+	// Accounts, when set, lets smart accounts submit intents: a user with
+	// code is verified through ERC-1271 against it, as Permit2 does.
+	// Without it only EOA signatures are accepted.
+	Accounts bind.ContractCaller
+	// Preflight runs after the built-in checks, e.g. an eth_call simulation.
+	// Returning a *RejectError sets the response code.
+	Preflight func(ctx context.Context, r *Record) error
+	// MinTimeToDeadline rejects intents that would expire before the executor
+	// can land them. Defaults to 30s.
+	MinTimeToDeadline time.Duration
+	// MaxBodyBytes caps request bodies. Defaults to 64 KiB.
+	MaxBodyBytes int64
+	// Telemetry, when set, starts an intent's trace when it is accepted.
+	// Later milestones are reported by the executor (see batch.Config).
+	Telemetry *telemetry.Telemetry
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+// Server accepts intents and reports their status.
+type Server struct {
+	cfg Config
+	mux *http.ServeMux
+}
+
+// NewServer returns a Server for cfg.
+func NewServer(cfg Config) (*Server, error) {
+	if cfg.Queue == nil {
+		return nil, errors.New("intake: Config.Queue is required")
+	}
+	if cfg.Domain.ChainID == nil {
+		return nil, errors.New("intake: Config.Domain.ChainID is required")
+	}
+	if cfg.Store == nil {
+		cfg.Store = NewMemoryStore()
+	}
+	if cfg.MinTimeToDeadline == 0 {
+		cfg.MinTimeToDeadline = 30 * time.Second
+	}
+	if cfg.MaxBodyBytes == 0 {
+		cfg.MaxBodyBytes = 64 << 10
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	s := &Server{cfg: cfg, mux: http.NewServeMux()}
+	s.mux.HandleFunc("POST /intents", s.handleSubmit)
+	s.mux.HandleFunc("GET /intents/{hash}", s.handleGet)
+	return s, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) { s.mux.ServeHTTP(w, r) }
+
+// Submit validates, stores and enqueues an intent. Resubmitting a known
+// intent returns its current record without enqueueing it again. When the
+// queue refuses an intent nothing is kept, so the client can retry.
+func (s *Server) Submit(ctx context.Context, req SubmitRequest) (*Record, error) {
+	if err := req.Intent.Validate(); err != nil {
+		return nil, reject("BadRequest", "%v", err)
+	}
+	intent := settlement.Intent(req.Intent)
+	sig, err := hexutil.Decode(req.Signature)
+	if err != nil {
+		return nil, reject("InvalidSignature", "signature: %v", err)
+	}
+	var hint *settlement.SwapCall
+	if req.SwapHint != nil {
+		call, err := req.SwapHint.Decode()
+		if err != nil {
+			return nil, reject("BadRequest", "%v", err)
+		}
+		hint = &call
+	}
+
+	hash := permit2.WitnessHash(intent)
+	if existing, err := s.cfg.Store.Get(ctx, hash); err == nil {
+		return existing, nil
+	}
+
+	if err := s.validate(ctx, intent, sig, hint); err != nil {
+		return nil, err
+	}
+	now := s.cfg.Now()
+	rec := &Record{
+		Hash:      hash,
+		Intent:    intent,
+		Signature: sig,
+		SwapHint:  hint,
+		Status:    Pending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if s.cfg.Preflight != nil {
+		if err := s.cfg.Preflight(ctx, rec); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.cfg.Store.Create(ctx, rec); err != nil {
+		if errors.Is(err, ErrDuplicate) {
+			return s.cfg.Store.Get(ctx, hash)
+		}
+		return nil, err
+	}
+	if err := s.cfg.Queue.Enqueue(ctx, rec); err != nil {
+		// The intent itself is fine; forget it so a resubmission is
+		// validated and enqueued again instead of finding a dead record.
+		_ = s.cfg.Store.Delete(context.WithoutCancel(ctx), hash)
+		return nil, err
+	}
+	if s.cfg.Telemetry != nil {
+		s.cfg.Telemetry.Received(ctx, hash, intent, now)
+	}
+	return rec, nil
+}
+
+// validate mirrors FastSettlementV3.executeWithPermit's checks that can be
+// made off-chain.
+func (s *Server) validate(ctx context.Context, intent settlement.Intent, sig []byte, hint *settlement.SwapCall) error {
+	minDeadline := s.cfg.Now().Add(s.cfg.MinTimeToDeadline).Unix()
+	switch {
+	case intent.InputToken == (common.Address{}):
+		return reject("BadInputToken", "ETH input intents are sent by the user via executeWithETH")
+	case intent.Recipient == (common.Address{}):
+		return reject("BadRecipient", "recipient is the zero address")
+	case intent.InputAmt.Sign() == 0:
+		return reject("BadInputAmt", "inputAmt is zero")
+	case intent.UserAmtOut.Sign() == 0:
+		return reject("BadUserAmtOut", "userAmtOut is zero")
+	case intent.Deadline.Cmp(big.NewInt(minDeadline)) < 0:
+		return reject("IntentExpired", "deadline %s is less than %s from now", intent.Deadline, s.cfg.MinTimeToDeadline)
+	}
+	if s.cfg.Accounts != nil {
+		err := s.cfg.Domain.VerifyAny(ctx, s.cfg.Accounts, s.cfg.Settlement, intent, sig)
+		if errors.Is(err, permit2.ErrInvalidSignature) {
+			return reject("InvalidSignature", "%v", err)
+		}
+		if err != nil {
+			return fmt.Errorf("verify signature: %w", err)
+		}
+	} else if err := s.cfg.Domain.Verify(s.cfg.Settlement, intent, sig); err != nil {
+		return reject("InvalidSignature", "%v", err)
+	}
+	if hint != nil && s.cfg.Targets != nil {
+		ok, err := s.cfg.Targets.AllowedSwapTargets(&bind.CallOpts{Context: ctx}, hint.To)
+		if err != nil {
+			return fmt.Errorf("check swap target: %w", err)
+		}
+		if !ok {
+			return reject("UnauthorizedSwapTarget", "swap target %s is not allowlisted", hint.To)
+		}
+	}
+	return nil
+}
+
+// Get returns the record for hash.
+func (s *Server) Get(ctx context.Context, hash common.Hash) (*Record, error) {
+	return s.cfg.Store.Get(ctx, hash)
+}
+
+// MarkSimulated records a successful pre-submission simulation.
+func (s *Server) MarkSimulated(ctx context.Context, hash common.Hash) error {
+	return s.advance(ctx, hash, Simulated, nil)
+}
+
+// MarkSubmitted records the settlement transaction carrying the intent.
+func (s *Server) MarkSubmitted(ctx context.Context, hash, txHash common.Hash) error {
+	return s.advance(ctx, hash, Submitted, func(r *Record) { r.TxHash = txHash })
+}
+
+// MarkSettled records the IntentExecuted event of the settlement.
+func (s *Server) MarkSettled(ctx context.Context, hash common.Hash, ev *settlement.IntentExecuted) error {
+	return s.advance(ctx, hash, Settled, func(r *Record) {
+		r.TxHash = ev.Raw.TxHash
+		r.Received = ev.Received
+		r.Surplus = ev.Surplus
+	})
+}
+
+// MarkFailed records a failure. Reverts carried by err are decoded into
+// contract error names.
+func (s *Server) MarkFailed(ctx context.Context, hash common.Hash, err error) error {
+	return s.advance(ctx, hash, Failed, func(r *Record) {
+		r.Revert = reverts.FromError(err)
+		if r.Revert == nil && err != nil {
+			r.Reason = err.Error()
+		}
+	})
+}
+
+// transitions lists the statuses each status may move to. Updates only move
+// forward; a status absent from the map is final.
+var transitions = map[Status][]Status{
+	Pending: {Simulated, Submitted, Settled, Failed},
+	// Re-simulating refreshes the swap route before submission.
+	Simulated: {Simulated, Submitted, Settled, Failed},
+	// A replacement transaction stays submitted with a new TxHash.
+	Submitted: {Submitted, Settled, Failed},
+}
+
+func canAdvance(from, to Status) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) advance(ctx context.Context, hash common.Hash, to Status, fn func(*Record)) error {
+	return s.cfg.Store.Update(ctx, hash, func(r *Record) error {
+		if _, ok := transitions[r.Status]; !ok {
+			return fmt.Errorf("%w: %s is %s", ErrFinal, hash, r.Status)
+		}
+		if !canAdvance(r.Status, to) {
+			return fmt.Errorf("%w: %s is %s, cannot move to %s", ErrInvalidTransition, hash, r.Status, to)
+		}
+		r.Status = to
+		r.UpdatedAt = s.cfg.Now()
+		if fn != nil {
+			fn(r)
+		}
+		return nil
+	})
+}
+
+// StatusResponse is the GET /intents/{hash} body.
+type StatusResponse struct {
+	Hash     string       `json:"hash"`
+	Status   Status       `json:"status"`
+	Intent   codec.Intent `json:"intent"`
+	TxHash   string       `json:"txHash,omitempty"`
+	Received string       `json:"received,omitempty"`
+	Surplus  string       `json:"surplus,omitempty"`
+	Error    *ErrorJSON   `json:"error,omitempty"`
+}
+
+// ErrorJSON describes a failure.
+type ErrorJSON struct {
+	// Name is the decoded custom error, e.g. "InsufficientOut", or a
+	// pre-flight code.
+	Name     string   `json:"name,omitempty"`
+	Selector string   `json:"selector,omitempty"`
+	Args     []string `json:"args,omitempty"`
+	Message  string   `json:"message"`
+}
+
+// NewStatusResponse renders r.
+func NewStatusResponse(r *Record) StatusResponse {
+	resp := StatusResponse{
+		Hash:   r.Hash.Hex(),
+		Status: r.Status,
+		Intent: codec.Intent(r.Intent),
+	}
+	if r.TxHash != (common.Hash{}) {
+		resp.TxHash = r.TxHash.Hex()
+	}
+	if r.Status == Settled {
+		resp.Received = decimal(r.Received)
+		resp.Surplus = decimal(r.Surplus)
+	}
+	switch {
+	case r.Revert != nil:
+		resp.Error = &ErrorJSON{Name: r.Revert.Name, Message: r.Revert.Error()}
+		if r.Revert.Selector != [4]byte{} {
+			resp.Error.Selector = hexutil.Encode(r.Revert.Selector[:])
+		}
+		for _, a := range r.Revert.Args {
+			resp.Error.Args = append(resp.Error.Args, formatArg(a))
+		}
+	case r.Reason != "":
+		resp.Error = &ErrorJSON{Message: r.Reason}
+	}
+	return resp
+}
+
+func formatArg(a interface{}) string {
+	switch v := a.(type) {
+	case *big.Int:
+		return decimal(v)
+	case common.Address:
+		return v.Hex()
+	case [32]byte:
+		return common.Hash(v).Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (s *Server) handleSubmit(w http.ResponseWriter, r *http.Request) {
+	var req SubmitRequest
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.cfg.MaxBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, &ErrorJSON{Name: "BadRequest", Message: err.Error()})
+		return
+	}
+	rec, err := s.Submit(r.Context(), req)
+	if err != nil {
+		var rej *RejectError
+		switch {
+		case errors.As(err, &rej):
+			status := http.StatusUnprocessableEntity
+			if rej.Code == "BadRequest" {
+				status = http.StatusBadRequest
+			}
+			writeError(w, status, &ErrorJSON{Name: rej.Code, Message: rej.Reason})
+		case errors.Is(err, ErrQueueFull):
+			writeError(w, http.StatusServiceUnavailable, &ErrorJSON{Message: err.Error()})
+		default:
+			writeError(w, http.StatusInternalServerError, &ErrorJSON{Message: err.Error()})
+		}
+		return
+	}
+	writeJSON(w, http.StatusAccepted, NewStatusResponse(rec))
+}
+
+func (s *Server) handleGet(w http.ResponseWriter, r *http.Request) {
+	raw := r.PathValue("hash")
+	b, err := hexutil.Decode(raw)
+	if err != nil || len(b) != common.HashLength {
+		writeError(w, http.StatusBadRequest, &ErrorJSON{Name: "BadRequest", Message: fmt.Sprintf("invalid intent hash %q", raw)})
+		return
+	}
+	rec, err := s.Get(r.Context(), common.BytesToHash(b))
+	switch {
+	case errors.Is(err, ErrNotFound):
+		writeError(w, http.StatusNotFound, &ErrorJSON{Message: err.Error()})
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, &ErrorJSON{Message: err.Error()})
+	default:
+		writeJSON(w, http.StatusOK, NewStatusResponse(rec))
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, e *ErrorJSON) {
+	writeJSON(w, status, struct {
+		Error *ErrorJSON `json:"error"`
+	}{e})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,302 @@
+package intake_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primev/fastprotocolapp/contracts-abi/intake"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"github.com/primev/fastprotocolapp/contracts-abi/telemetry"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	now        = time.Unix(1_800_000_000, 0)
+	userKey, _ = crypto.ToECDSA(crypto.Keccak256([]byte("user")))
+	user       = crypto.PubkeyToAddress(userKey.PublicKey)
+	proxy      = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	domain     = permit2.Domain{ChainID: big.NewInt(1), Permit2: permit2.CanonicalAddress}
+)
+
+// queue records enqueued intents, or fails with err.
+type queue struct {
+	got []*intake.Record
+	err error
+}
+
+func (q *queue) Enqueue(_ context.Context, r *intake.Record) error {
+	if q.err != nil {
+		return q.err
+	}
+	q.got = append(q.got, r)
+	return nil
+}
+
+func newServer(t *testing.T, q intake.Queue, store intake.Store) *httptest.Server {
+	t.Helper()
+	s, err := intake.NewServer(intake.Config{
+		Domain:     domain,
+		Settlement: proxy,
+		Queue:      q,
+		Store:      store,
+		Now:        func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func signedIntent(t *testing.T, nonce int64) (settlement.Intent, string) {
+	t.Helper()
+	intent := settlement.Intent{
+		User:        user,
+		InputToken:  common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+		OutputToken: common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"),
+		InputAmt:    big.NewInt(1_000_000),
+		UserAmtOut:  big.NewInt(300_000_000_000_000),
+		Recipient:   user,
+		Deadline:    big.NewInt(now.Unix() + 3600),
+		Nonce:       big.NewInt(nonce),
+	}
+	sig, err := domain.Sign(userKey, proxy, intent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return intent, hexutil.Encode(sig)
+}
+
+func body(intent settlement.Intent, sig string) map[string]interface{} {
+	return map[string]interface{}{
+		"intent": map[string]string{
+			"user":        intent.User.Hex(),
+			"inputToken":  intent.InputToken.Hex(),
+			"outputToken": intent.OutputToken.Hex(),
+			"inputAmt":    intent.InputAmt.String(),
+			"userAmtOut":  intent.UserAmtOut.String(),
+			"recipient":   intent.Recipient.Hex(),
+			"deadline":    intent.Deadline.String(),
+			"nonce":       intent.Nonce.String(),
+		},
+		"signature": sig,
+	}
+}
+
+// flipCase breaks an EIP-55 checksum by flipping the case of its first
+// letter.
+func flipCase(addr string) string {
+	b := []byte(addr)
+	for i := 2; i < len(b); i++ {
+		if c := b[i]; c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F' {
+			b[i] ^= 0x20
+			break
+		}
+	}
+	return string(b)
+}
+
+type response struct {
+	Hash   string `json:"hash"`
+	Status string `json:"status"`
+	Error  *struct {
+		Name    string `json:"name"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func post(t *testing.T, srv *httptest.Server, v interface{}) (int, response) {
+	t.Helper()
+	raw, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Post(srv.URL+"/intents", "application/json", bytes.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var out response
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, out
+}
+
+func get(t *testing.T, srv *httptest.Server, hash string) (int, response) {
+	t.Helper()
+	resp, err := http.Get(srv.URL + "/intents/" + hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var out response
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, out
+}
+
+func TestSubmit(t *testing.T) {
+	q := &queue{}
+	srv := newServer(t, q, nil)
+	intent, sig := signedIntent(t, 1)
+
+	code, resp := post(t, srv, body(intent, sig))
+	if code != http.StatusAccepted {
+		t.Fatalf("POST = %d %+v", code, resp.Error)
+	}
+	if want := permit2.WitnessHash(intent).Hex(); resp.Hash != want {
+		t.Fatalf("hash = %s, want witness hash %s", resp.Hash, want)
+	}
+	if resp.Status != string(intake.Pending) || len(q.got) != 1 {
+		t.Fatalf("status %s, %d enqueued", resp.Status, len(q.got))
+	}
+
+	// A resubmission returns the stored record without enqueueing again.
+	if code, _ := post(t, srv, body(intent, sig)); code != http.StatusAccepted || len(q.got) != 1 {
+		t.Fatalf("resubmit = %d, %d enqueued", code, len(q.got))
+	}
+	if code, got := get(t, srv, resp.Hash); code != http.StatusOK || got.Status != string(intake.Pending) {
+		t.Fatalf("GET = %d %+v", code, got)
+	}
+}
+
+func TestSubmitStartsTrace(t *testing.T) {
+	tel := telemetry.New(telemetry.Config{Now: func() time.Time { return now }})
+	s, err := intake.NewServer(intake.Config{Domain: domain, Settlement: proxy, Queue: &queue{}, Telemetry: tel, Now: func() time.Time { return now }})
+	if err != nil {
+		t.Fatal(err)
+	}
+	intent, sig := signedIntent(t, 1)
+	var req intake.SubmitRequest
+	raw, _ := json.Marshal(body(intent, sig))
+	if err := json.Unmarshal(raw, &req); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := s.Submit(context.Background(), req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if tel.Tracked() != 1 || testutil.ToFloat64(tel.Metrics.Received) != 1 {
+		t.Fatalf("tracked %d, received %v; a resubmission must not count", tel.Tracked(), testutil.ToFloat64(tel.Metrics.Received))
+	}
+}
+
+func TestSubmitRejects(t *testing.T) {
+	srv := newServer(t, &queue{}, nil)
+	intent, sig := signedIntent(t, 1)
+	other, _ := signedIntent(t, 2)
+
+	expired := body(intent, sig)
+	expired["intent"].(map[string]string)["deadline"] = "1"
+	badChecksum := body(intent, sig)
+	badChecksum["intent"].(map[string]string)["user"] = flipCase(user.Hex())
+	leadingZero := body(intent, sig)
+	leadingZero["intent"].(map[string]string)["nonce"] = "01"
+
+	tests := []struct {
+		name string
+		body interface{}
+		code int
+		want string
+	}{
+		{"foreign signature", body(other, sig), http.StatusUnprocessableEntity, "InvalidSignature"},
+		{"expired", expired, http.StatusUnprocessableEntity, "IntentExpired"},
+		{"bad checksum", badChecksum, http.StatusBadRequest, "BadRequest"},
+		{"leading zeros", leadingZero, http.StatusBadRequest, "BadRequest"},
+		{"unknown field", map[string]interface{}{"intent": body(intent, sig)["intent"], "signature": sig, "extra": 1}, http.StatusBadRequest, "BadRequest"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, resp := post(t, srv, tt.body)
+			if code != tt.code || resp.Error == nil || resp.Error.Name != tt.want {
+				t.Fatalf("POST = %d %+v, want %d %s", code, resp.Error, tt.code, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubmitQueueFailureIsRetryable(t *testing.T) {
+	store := intake.NewMemoryStore()
+	q := &queue{err: intake.ErrQueueFull}
+	srv := newServer(t, q, store)
+	intent, sig := signedIntent(t, 1)
+	hash := permit2.WitnessHash(intent)
+
+	if code, _ := post(t, srv, body(intent, sig)); code != http.StatusServiceUnavailable {
+		t.Fatalf("POST with a full queue = %d, want 503", code)
+	}
+	if _, err := store.Get(context.Background(), hash); !errors.Is(err, intake.ErrNotFound) {
+		t.Fatalf("record after a failed enqueue: err = %v, want ErrNotFound", err)
+	}
+
+	q.err = nil
+	code, resp := post(t, srv, body(intent, sig))
+	if code != http.StatusAccepted || resp.Status != string(intake.Pending) || len(q.got) != 1 {
+		t.Fatalf("retry = %d %s, %d enqueued", code, resp.Status, len(q.got))
+	}
+}
+
+func TestLifecycle(t *testing.T) {
+	q := &queue{}
+	s, err := intake.NewServer(intake.Config{Domain: domain, Settlement: proxy, Queue: q, Now: func() time.Time { return now }})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	intent, sig := signedIntent(t, 1)
+	sigBytes := hexutil.MustDecode(sig)
+	var req intake.SubmitRequest
+	raw, _ := json.Marshal(body(intent, sig))
+	if err := json.Unmarshal(raw, &req); err != nil {
+		t.Fatal(err)
+	}
+	rec, err := s.Submit(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(rec.Signature, sigBytes) {
+		t.Fatalf("signature not stored")
+	}
+	if err := s.MarkSimulated(ctx, rec.Hash); err != nil {
+		t.Fatal(err)
+	}
+	tx := common.HexToHash("0x01")
+	if err := s.MarkSubmitted(ctx, rec.Hash, tx); err != nil {
+		t.Fatal(err)
+	}
+	// A late simulation result does not move a submitted intent back.
+	if err := s.MarkSimulated(ctx, rec.Hash); !errors.Is(err, intake.ErrInvalidTransition) {
+		t.Fatalf("simulating a submitted intent: err = %v, want ErrInvalidTransition", err)
+	}
+	if got, _ := s.Get(ctx, rec.Hash); got.Status != intake.Submitted {
+		t.Fatalf("status %s after a rejected update, want submitted", got.Status)
+	}
+	if err := s.MarkFailed(ctx, rec.Hash, errors.New("dropped")); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.MarkSimulated(ctx, rec.Hash); !errors.Is(err, intake.ErrFinal) {
+		t.Fatalf("advancing a failed intent: err = %v, want ErrFinal", err)
+	}
+	got, err := s.Get(ctx, rec.Hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != intake.Failed || got.TxHash != tx || got.Reason != "dropped" {
+		t.Fatalf("record = %+v", got)
+	}
+}
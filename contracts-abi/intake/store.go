@@ -0,0 +1,149 @@
+package intake
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+// Status is an intent's position in the executor pipeline.
+type Status string
+
+const (
+	Pending   Status = "pending"
+	Simulated Status = "simulated"
+	Submitted Status = "submitted"
+	Settled   Status = "settled"
+	Failed    Status = "failed"
+)
+
+var (
+	// ErrNotFound is returned for unknown intent hashes.
+	ErrNotFound = errors.New("intake: intent not found")
+	// ErrDuplicate is returned when an intent hash is already stored.
+	ErrDuplicate = errors.New("intake: intent already submitted")
+)
+
+// Record is an accepted intent and its progress.
+type Record struct {
+	// Hash is the intent's EIP-712 witness hash.
+	Hash      common.Hash
+	Intent    settlement.Intent
+	Signature []byte
+	SwapHint  *settlement.SwapCall
+
+	Status Status
+	TxHash common.Hash
+	// Received and Surplus are copied from IntentExecuted once settled.
+	Received *big.Int
+	Surplus  *big.Int
+	// Revert is the decoded revert of a failed simulation or transaction.
+	Revert *reverts.Error
+	// Reason describes a failure that is not a revert.
+	Reason string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Store persists Records.
+type Store interface {
+	// Create stores r, or returns ErrDuplicate if r.Hash exists.
+	Create(ctx context.Context, r *Record) error
+	// Get returns a copy of the record, or ErrNotFound.
+	Get(ctx context.Context, hash common.Hash) (*Record, error)
+	// Update applies fn to the stored record atomically.
+	Update(ctx context.Context, hash common.Hash, fn func(*Record) error) error
+	// Delete removes the record, if any.
+	Delete(ctx context.Context, hash common.Hash) error
+}
+
+// MemoryStore is a process-local Store.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	records map[common.Hash]*Record
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: map[common.Hash]*Record{}}
+}
+
+// Create implements Store.
+func (m *MemoryStore) Create(_ context.Context, r *Record) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.records[r.Hash]; ok {
+		return ErrDuplicate
+	}
+	cp := *r
+	m.records[r.Hash] = &cp
+	return nil
+}
+
+// Get implements Store.
+func (m *MemoryStore) Get(_ context.Context, hash common.Hash) (*Record, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	r, ok := m.records[hash]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	cp := *r
+	return &cp, nil
+}
+
+// Update implements Store.
+func (m *MemoryStore) Update(_ context.Context, hash common.Hash, fn func(*Record) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.records[hash]
+	if !ok {
+		return ErrNotFound
+	}
+	cp := *r
+	if err := fn(&cp); err != nil {
+		return err
+	}
+	m.records[hash] = &cp
+	return nil
+}
+
+// Delete implements Store.
+func (m *MemoryStore) Delete(_ context.Context, hash common.Hash) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.records, hash)
+	return nil
+}
+
+// Queue hands accepted intents to the executor.
+type Queue interface {
+	Enqueue(ctx context.Context, r *Record) error
+}
+
+// ErrQueueFull is returned by ChanQueue when the executor is not keeping up.
+var ErrQueueFull = errors.New("intake: queue full")
+
+// ChanQueue is a Queue backed by a buffered channel the executor drains.
+type ChanQueue chan *Record
+
+// Enqueue implements Queue without blocking.
+func (q ChanQueue) Enqueue(ctx context.Context, r *Record) error {
+	select {
+	case q <- r:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		return ErrQueueFull
+	}
+}
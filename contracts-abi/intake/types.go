@@ -0,0 +1,53 @@
+package intake
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/primev/fastprotocolapp/contracts-abi/codec"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+// SwapHintJSON is the route the frontend quoted, mirroring
+// IFastSettlementV3SwapCall. The executor may replace it with a fresher one.
+type SwapHintJSON struct {
+	To    string `json:"to"`
+	Value string `json:"value,omitempty"`
+	Data  string `json:"data"`
+}
+
+// SubmitRequest is the POST /intents body. The intent uses codec's strict
+// JSON form: checksummed or single-case addresses and canonical decimal
+// integers.
+type SubmitRequest struct {
+	Intent    codec.Intent  `json:"intent"`
+	Signature string        `json:"signature"`
+	SwapHint  *SwapHintJSON `json:"swapHint,omitempty"`
+}
+
+// Decode parses the wire swap call.
+func (j SwapHintJSON) Decode() (settlement.SwapCall, error) {
+	to, err := codec.ParseAddress("swapHint.to", j.To)
+	if err != nil {
+		return settlement.SwapCall{}, err
+	}
+	value := new(big.Int)
+	if j.Value != "" {
+		if value, err = codec.ParseUint256("swapHint.value", j.Value); err != nil {
+			return settlement.SwapCall{}, err
+		}
+	}
+	data, err := hexutil.Decode(j.Data)
+	if err != nil {
+		return settlement.SwapCall{}, fmt.Errorf("swapHint.data: %w", err)
+	}
+	return settlement.SwapCall{To: to, Value: value, Data: data}, nil
+}
+
+func decimal(n *big.Int) string {
+	if n == nil {
+		return "0"
+	}
+	return n.String()
+}
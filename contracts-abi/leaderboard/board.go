@@ -0,0 +1,190 @@
+package leaderboard
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/amount"
+	"github.com/primev/fastprotocolapp/contracts-abi/tokens"
+)
+
+// Tier is a volume tier, as shown by the web app.
+type Tier string
+
+const (
+	Gold     Tier = "gold"
+	Silver   Tier = "silver"
+	Bronze   Tier = "bronze"
+	Standard Tier = "standard"
+)
+
+// Tier thresholds in USD of total volume, the web app's TIER_THRESHOLDS.
+const (
+	GoldUSD   = 1_000_000
+	SilverUSD = 100_000
+	BronzeUSD = 10_000
+)
+
+// TierOf returns the tier of a total volume in USD.
+func TierOf(usd float64) Tier {
+	switch {
+	case usd >= GoldUSD:
+		return Gold
+	case usd >= SilverUSD:
+		return Silver
+	case usd >= BronzeUSD:
+		return Bronze
+	}
+	return Standard
+}
+
+// nextTierUSD returns the threshold of the tier above usd, or nil at Gold.
+func nextTierUSD(usd float64) *float64 {
+	for _, t := range []float64{BronzeUSD, SilverUSD, GoldUSD} {
+		if usd < t {
+			return &t
+		}
+	}
+	return nil
+}
+
+// Entry is one wallet's standing.
+type Entry struct {
+	// Rank is 1 plus the number of wallets with strictly more total
+	// volume, so tied wallets share a rank.
+	Rank      int            `json:"rank"`
+	Wallet    common.Address `json:"wallet"`
+	SwapCount int            `json:"swapCount"`
+	// Volume is the all-time volume in ETH.
+	Volume    amount.Amount `json:"volume"`
+	VolumeUSD float64       `json:"volumeUSD"`
+	// Volume24h covers the 24 hours up to the snapshot's block.
+	Volume24h    amount.Amount `json:"volume24h"`
+	Volume24hUSD float64       `json:"volume24hUSD"`
+	// Change24hPct compares Volume24h with the 24 hours before it: 100
+	// for new activity, 0 for none.
+	Change24hPct float64 `json:"change24hPct"`
+	Tier         Tier    `json:"tier"`
+	// NextRank is the total volume of the nearest wallet ranked above,
+	// nil at rank 1.
+	NextRank    *amount.Amount `json:"nextRank,omitempty"`
+	NextRankUSD *float64       `json:"nextRankUSD,omitempty"`
+	// NextTierUSD is the threshold of the next tier, nil at Gold.
+	NextTierUSD *float64 `json:"nextTierUSD,omitempty"`
+}
+
+// Snapshot ranks every wallet that has settled an intent. It is a pure
+// function of the indexed logs, the snapshot block and the ETH price:
+// windows are anchored at the block's timestamp, not the wall clock, so
+// replaying the same chain reproduces the same rankings.
+type Snapshot struct {
+	Block       uint64    `json:"block"`
+	Time        time.Time `json:"time"`
+	ETHPriceUSD float64   `json:"ethPriceUSD"`
+	// Entries are ordered by rank, ties by wallet address.
+	Entries []*Entry `json:"entries"`
+
+	byWallet map[common.Address]*Entry
+}
+
+// Lookup returns wallet's entry.
+func (s *Snapshot) Lookup(wallet common.Address) (*Entry, bool) {
+	e, ok := s.byWallet[wallet]
+	return e, ok
+}
+
+// Top returns the first n entries.
+func (s *Snapshot) Top(n int) []*Entry {
+	if n > len(s.Entries) {
+		n = len(s.Entries)
+	}
+	return s.Entries[:n]
+}
+
+type totals struct {
+	count              int
+	all, cur, previous *big.Int
+}
+
+// build ranks swaps as of block at time now.
+func build(swaps []*Swap, block uint64, now uint64, ethUSD float64) *Snapshot {
+	const day = 24 * 60 * 60
+	by := make(map[common.Address]*totals)
+	for _, s := range swaps {
+		t := by[s.User]
+		if t == nil {
+			t = &totals{all: new(big.Int), cur: new(big.Int), previous: new(big.Int)}
+			by[s.User] = t
+		}
+		t.count++
+		t.all.Add(t.all, s.Volume)
+		switch age := now - s.Time; {
+		case s.Time > now:
+			// Guards the unsigned age; indexed swaps are never after the head.
+		case age < day:
+			t.cur.Add(t.cur, s.Volume)
+		case age < 2*day:
+			t.previous.Add(t.previous, s.Volume)
+		}
+	}
+
+	snap := &Snapshot{
+		Block:       block,
+		Time:        time.Unix(int64(now), 0).UTC(),
+		ETHPriceUSD: ethUSD,
+		Entries:     make([]*Entry, 0, len(by)),
+		byWallet:    make(map[common.Address]*Entry, len(by)),
+	}
+	usd := func(a amount.Amount) float64 { return a.Float64() * ethUSD }
+	for wallet, t := range by {
+		e := &Entry{
+			Wallet:       wallet,
+			SwapCount:    t.count,
+			Volume:       amount.Of(t.all, tokens.ETH),
+			Volume24h:    amount.Of(t.cur, tokens.ETH),
+			Change24hPct: change(t.cur, t.previous),
+		}
+		e.VolumeUSD, e.Volume24hUSD = usd(e.Volume), usd(e.Volume24h)
+		e.Tier, e.NextTierUSD = TierOf(e.VolumeUSD), nextTierUSD(e.VolumeUSD)
+		snap.Entries = append(snap.Entries, e)
+		snap.byWallet[wallet] = e
+	}
+	sort.Slice(snap.Entries, func(i, j int) bool {
+		a, b := snap.Entries[i], snap.Entries[j]
+		if c := a.Volume.Raw().Cmp(b.Volume.Raw()); c != 0 {
+			return c > 0
+		}
+		return bytes.Compare(a.Wallet[:], b.Wallet[:]) < 0
+	})
+	// start is the index of the first entry tied with the current one,
+	// which is also the number of entries with strictly more volume.
+	start := 0
+	for i, e := range snap.Entries {
+		if i > 0 && e.Volume.Raw().Cmp(snap.Entries[i-1].Volume.Raw()) < 0 {
+			start = i
+		}
+		e.Rank = start + 1
+		if start > 0 {
+			above := snap.Entries[start-1]
+			v, u := above.Volume, above.VolumeUSD
+			e.NextRank, e.NextRankUSD = &v, &u
+		}
+	}
+	return snap
+}
+
+// change mirrors the analytics query's change_24h_pct.
+func change(cur, previous *big.Int) float64 {
+	switch {
+	case previous.Sign() > 0:
+		diff := new(big.Rat).SetFrac(new(big.Int).Sub(cur, previous), previous)
+		pct, _ := diff.Mul(diff, big.NewRat(100, 1)).Float64()
+		return pct
+	case cur.Sign() > 0:
+		return 100
+	}
+	return 0
+}
@@ -0,0 +1,175 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/primev/fastprotocolapp/contracts-abi/profit"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+// Backend is the chain access the index needs; ethclient.Client satisfies
+// it.
+type Backend interface {
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Swap is one IntentExecuted log valued in wei.
+type Swap struct {
+	User     common.Address `json:"user"`
+	TxHash   common.Hash    `json:"txHash"`
+	LogIndex uint           `json:"logIndex"`
+	Block    uint64         `json:"block"`
+	// Time is the block timestamp in seconds.
+	Time uint64 `json:"time"`
+	// Volume is the swap's value in wei; zero when neither side could be
+	// priced, matching the analytics query's COALESCE(swap_vol_eth, 0).
+	Volume *big.Int `json:"volume"`
+}
+
+type logID struct {
+	tx    common.Hash
+	index uint
+}
+
+// index holds the swaps in [FromBlock, synced].
+type index struct {
+	cfg Config
+
+	mu     sync.Mutex
+	swaps  map[logID]*Swap
+	synced uint64
+	// head is the header of the last synced block.
+	head *types.Header
+}
+
+// sync indexes IntentExecuted logs up to the chain head. The last
+// ReorgDepth blocks already indexed are dropped and read again, so a reorg
+// within that depth replaces orphaned swaps rather than double-counting
+// them.
+func (ix *index) sync(ctx context.Context) error {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	head, err := ix.cfg.Backend.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("leaderboard: head: %w", err)
+	}
+	from := ix.cfg.FromBlock
+	if ix.head != nil && ix.synced+1 > from+ix.cfg.ReorgDepth {
+		from = ix.synced + 1 - ix.cfg.ReorgDepth
+	}
+	if head < from {
+		return nil
+	}
+	times := make(map[uint64]uint64)
+	var fresh []*Swap
+	err = settlement.ReadIntentExecuted(ctx, ix.cfg.Backend, ix.cfg.Settlement, from, head, ix.cfg.ChunkSize, func(ev *settlement.IntentExecuted) error {
+		block := ev.Raw.BlockNumber
+		t, ok := times[block]
+		if !ok {
+			h, err := ix.cfg.Backend.HeaderByNumber(ctx, new(big.Int).SetUint64(block))
+			if err != nil {
+				return fmt.Errorf("leaderboard: header %d: %w", block, err)
+			}
+			t = h.Time
+			times[block] = t
+		}
+		s, err := ix.swap(ctx, ev, t)
+		if err != nil {
+			return err
+		}
+		fresh = append(fresh, s)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	last, err := ix.cfg.Backend.HeaderByNumber(ctx, new(big.Int).SetUint64(head))
+	if err != nil {
+		return fmt.Errorf("leaderboard: header %d: %w", head, err)
+	}
+
+	// Only commit once the whole range was read, so a failed sync leaves
+	// the previous state intact.
+	for id, s := range ix.swaps {
+		if s.Block >= from {
+			delete(ix.swaps, id)
+		}
+	}
+	for _, s := range fresh {
+		ix.swaps[logID{s.TxHash, s.LogIndex}] = s
+	}
+	ix.synced, ix.head = head, last
+	return nil
+}
+
+func (ix *index) swap(ctx context.Context, ev *settlement.IntentExecuted, time uint64) (*Swap, error) {
+	v, err := Volume(ctx, ix.cfg.Oracle, ix.cfg.WETH, ev)
+	if err != nil {
+		return nil, err
+	}
+	return &Swap{
+		User:     ev.User,
+		TxHash:   ev.Raw.TxHash,
+		LogIndex: ev.Raw.Index,
+		Block:    ev.Raw.BlockNumber,
+		Time:     time,
+		Volume:   v,
+	}, nil
+}
+
+// Volume values a settled intent in wei. ETH and WETH legs are exact and
+// preferred; otherwise oracle prices the output actually received, then
+// the input. It returns zero when neither leg can be priced.
+func Volume(ctx context.Context, oracle profit.Oracle, weth common.Address, ev *settlement.IntentExecuted) (*big.Int, error) {
+	isETH := func(token common.Address) bool {
+		return token == (common.Address{}) || (weth != (common.Address{}) && token == weth)
+	}
+	type leg struct {
+		token  common.Address
+		amount *big.Int
+	}
+	legs := []leg{{ev.OutputToken, ev.Received}, {ev.InputToken, ev.InputAmt}}
+	var ordered []leg
+	for _, l := range legs {
+		if isETH(l.token) {
+			ordered = append(ordered, leg{common.Address{}, l.amount})
+		}
+	}
+	for _, l := range legs {
+		if !isETH(l.token) {
+			ordered = append(ordered, l)
+		}
+	}
+	for _, l := range ordered {
+		v, err := oracle.ETHValue(ctx, l.token, l.amount)
+		if errors.Is(err, profit.ErrNoPrice) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("leaderboard: value %s of %s: %w", l.amount, l.token, err)
+		}
+		return v, nil
+	}
+	return new(big.Int), nil
+}
+
+// snapshot copies the indexed swaps and the last synced header.
+func (ix *index) snapshot() ([]*Swap, *types.Header) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	out := make([]*Swap, 0, len(ix.swaps))
+	for _, s := range ix.swaps {
+		out = append(out, s)
+	}
+	return out, ix.head
+}
@@ -0,0 +1,297 @@
+// Package leaderboard ranks wallets by the volume of intents they settled,
+// computed from FastSettlementV3's IntentExecuted logs alone.
+//
+//	GET /leaderboard?limit=15&currentUser=0x…  → {entries, user, block, time, ethPriceUSD}
+//	GET /leaderboard/{wallet}                  → entry
+//
+// It is the on-chain counterpart of the web app's leaderboard.service.ts,
+// which reads the same figures from the analytics database: total and 24h
+// volume in ETH and USD, swap count, 24h change, rank, the volume needed to
+// reach the next rank, and the Gold/Silver/Bronze tier.
+//
+// Each swap is valued in wei once, when indexed: an ETH or WETH leg is
+// taken as is, otherwise the Oracle prices the output received, then the
+// input. USD figures multiply by a single ETH price per snapshot. The 24h
+// windows end at the snapshot block's timestamp rather than the wall clock,
+// so given the same oracle and ETH price the rankings are reproducible from
+// chain data.
+//
+// Run keeps the index synced in the background; requests are answered from
+// the last snapshot and never wait on the chain.
+package leaderboard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/profit"
+)
+
+// DefaultETHPriceUSD is used when no price source is configured or it
+// fails, as the web app's DEFAULT_ETH_PRICE_USD.
+const DefaultETHPriceUSD = 3000
+
+// ErrNotSynced is returned, and served as 503, until the first sync
+// completes.
+var ErrNotSynced = errors.New("leaderboard: not synced yet")
+
+// Config configures a Service.
+type Config struct {
+	// Backend is required.
+	Backend Backend
+	// Settlement is the FastSettlementV3 proxy. Required.
+	Settlement common.Address
+	// WETH legs are valued 1:1 with ETH.
+	WETH common.Address
+	// FromBlock should be the settlement's deployment block.
+	FromBlock uint64
+	// Oracle values non-ETH legs. Defaults to an empty StaticOracle, which
+	// values only ETH and WETH legs.
+	Oracle profit.Oracle
+	// ETHPriceUSD returns the current ETH price. Defaults to
+	// DefaultETHPriceUSD, which is also the fallback when it fails.
+	ETHPriceUSD func(ctx context.Context) (float64, error)
+	// CacheTTL is how often Run syncs the index and rebuilds the snapshot,
+	// and the max-age served with it. Defaults to 1m, the web app's
+	// LEADERBOARD_CACHE_STALE_TIME.
+	CacheTTL time.Duration
+	// Limit is the default number of entries served. Defaults to 15;
+	// requests may ask for up to MaxLimit, which defaults to 100.
+	Limit    int
+	MaxLimit int
+	// ChunkSize is the block span of each log query. Defaults to
+	// settlement.DefaultChunkSize.
+	ChunkSize uint64
+	// ReorgDepth is how many indexed blocks are re-read on each sync.
+	// Defaults to 64.
+	ReorgDepth uint64
+	// Logger defaults to slog.Default().
+	Logger *slog.Logger
+	// Now defaults to time.Now; it only governs Cache-Control.
+	Now func() time.Time
+}
+
+// Service indexes settlements and serves snapshots of the leaderboard.
+type Service struct {
+	cfg Config
+	ix  *index
+	mux *http.ServeMux
+
+	// refresh serialises Refresh so snapshots are stored in sync order.
+	refresh sync.Mutex
+	// mu guards only the served snapshot; syncs never hold it.
+	mu      sync.Mutex
+	cached  *Snapshot
+	expires time.Time
+}
+
+// New returns a Service for cfg. Nothing is read until Run or Refresh; the
+// handlers answer 503 until then.
+func New(cfg Config) (*Service, error) {
+	if cfg.Backend == nil {
+		return nil, errors.New("leaderboard: Config.Backend is required")
+	}
+	if cfg.Settlement == (common.Address{}) {
+		return nil, errors.New("leaderboard: Config.Settlement is required")
+	}
+	if cfg.Oracle == nil {
+		cfg.Oracle = profit.NewStaticOracle(nil)
+	}
+	if cfg.CacheTTL == 0 {
+		cfg.CacheTTL = time.Minute
+	}
+	if cfg.Limit == 0 {
+		cfg.Limit = 15
+	}
+	if cfg.MaxLimit == 0 {
+		cfg.MaxLimit = 100
+	}
+	if cfg.ReorgDepth == 0 {
+		cfg.ReorgDepth = 64
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	s := &Service{
+		cfg: cfg,
+		ix:  &index{cfg: cfg, swaps: make(map[logID]*Swap)},
+		mux: http.NewServeMux(),
+	}
+	s.mux.HandleFunc("GET /leaderboard", s.handleBoard)
+	s.mux.HandleFunc("GET /leaderboard/{wallet}", s.handleWallet)
+	return s, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) { s.mux.ServeHTTP(w, r) }
+
+// Run syncs the index and rebuilds the snapshot immediately, then every
+// CacheTTL, until ctx is done. A failed sync is logged and the previous
+// snapshot kept; the next tick starts from the last indexed block, so an
+// interrupted backfill resumes rather than restarts.
+func (s *Service) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.cfg.CacheTTL)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Refresh(ctx); err != nil && ctx.Err() == nil {
+			s.cfg.Logger.Warn("leaderboard sync failed, serving last snapshot", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh syncs the index and replaces the served snapshot. Run calls it
+// on every tick; it may also be called directly to sync on demand.
+func (s *Service) Refresh(ctx context.Context) (*Snapshot, error) {
+	s.refresh.Lock()
+	defer s.refresh.Unlock()
+	snap, err := s.build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.cached, s.expires = snap, s.cfg.Now().Add(s.cfg.CacheTTL)
+	s.mu.Unlock()
+	return snap, nil
+}
+
+// Snapshot returns the last snapshot built by Refresh, or ErrNotSynced
+// before the first one. It never reads the chain.
+func (s *Service) Snapshot() (*Snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cached == nil {
+		return nil, ErrNotSynced
+	}
+	return s.cached, nil
+}
+
+func (s *Service) build(ctx context.Context) (*Snapshot, error) {
+	if err := s.ix.sync(ctx); err != nil {
+		return nil, err
+	}
+	swaps, head := s.ix.snapshot()
+	if head == nil {
+		return nil, fmt.Errorf("leaderboard: chain head is before block %d", s.cfg.FromBlock)
+	}
+	return build(swaps, head.Number.Uint64(), head.Time, s.ethPrice(ctx)), nil
+}
+
+func (s *Service) ethPrice(ctx context.Context) float64 {
+	if s.cfg.ETHPriceUSD == nil {
+		return DefaultETHPriceUSD
+	}
+	p, err := s.cfg.ETHPriceUSD(ctx)
+	if err != nil || p <= 0 {
+		s.cfg.Logger.Warn("ETH price unavailable, using default", "default", DefaultETHPriceUSD, "price", p, "err", err)
+		return DefaultETHPriceUSD
+	}
+	return p
+}
+
+// Response is the GET /leaderboard body.
+type Response struct {
+	Entries []*Entry `json:"entries"`
+	// User is currentUser's entry, whether or not it is among Entries;
+	// nil when the wallet has not settled an intent.
+	User        *Entry    `json:"user"`
+	Block       uint64    `json:"block"`
+	Time        time.Time `json:"time"`
+	ETHPriceUSD float64   `json:"ethPriceUSD"`
+}
+
+// ErrorJSON is the body of an error response.
+type ErrorJSON struct {
+	Message string `json:"message"`
+}
+
+func (s *Service) handleBoard(w http.ResponseWriter, r *http.Request) {
+	limit := s.cfg.Limit
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid limit %q", raw))
+			return
+		}
+		limit = min(n, s.cfg.MaxLimit)
+	}
+	var user *common.Address
+	if raw := r.URL.Query().Get("currentUser"); raw != "" {
+		if !common.IsHexAddress(raw) {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid address %q", raw))
+			return
+		}
+		a := common.HexToAddress(raw)
+		user = &a
+	}
+	snap, ok := s.snapshot(w, r)
+	if !ok {
+		return
+	}
+	resp := Response{Entries: snap.Top(limit), Block: snap.Block, Time: snap.Time, ETHPriceUSD: snap.ETHPriceUSD}
+	if user != nil {
+		resp.User, _ = snap.Lookup(*user)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Service) handleWallet(w http.ResponseWriter, r *http.Request) {
+	raw := r.PathValue("wallet")
+	if !common.IsHexAddress(raw) {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid address %q", raw))
+		return
+	}
+	snap, ok := s.snapshot(w, r)
+	if !ok {
+		return
+	}
+	e, found := snap.Lookup(common.HexToAddress(raw))
+	if !found {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s has not settled an intent", raw))
+		return
+	}
+	writeJSON(w, http.StatusOK, e)
+}
+
+// snapshot fetches the last snapshot for a request and sets Cache-Control
+// to the time left until the next scheduled refresh.
+func (s *Service) snapshot(w http.ResponseWriter, _ *http.Request) (*Snapshot, bool) {
+	s.mu.Lock()
+	snap, ttl := s.cached, s.expires.Sub(s.cfg.Now())
+	s.mu.Unlock()
+	if snap == nil {
+		writeError(w, http.StatusServiceUnavailable, ErrNotSynced.Error())
+		return nil, false
+	}
+	if ttl > 0 {
+		w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(ttl/time.Second)))
+	}
+	return snap, true
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, struct {
+		Error *ErrorJSON `json:"error"`
+	}{&ErrorJSON{Message: msg}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
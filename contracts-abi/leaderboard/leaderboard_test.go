@@ -0,0 +1,190 @@
+package leaderboard_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	"github.com/primev/fastprotocolapp/contracts-abi/leaderboard"
+)
+
+var (
+	proxy = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	alice = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	bob   = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	usdc  = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+)
+
+type swap struct {
+	block uint64
+	user  common.Address
+}
+
+// chain serves IntentExecuted logs for 1 ETH → USDC swaps. While gate is
+// set, FilterLogs waits on it, so a test can hold a sync in flight.
+type chain struct {
+	mu    sync.Mutex
+	head  uint64
+	swaps []swap
+	fail  error
+	gate  chan struct{}
+}
+
+func (c *chain) add(head uint64, s ...swap) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head = head
+	c.swaps = append(c.swaps, s...)
+}
+
+func (c *chain) BlockNumber(context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.head, c.fail
+}
+
+func (c *chain) HeaderByNumber(_ context.Context, n *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).Set(n), Time: 1_700_000_000 + n.Uint64()*12}, nil
+}
+
+func (c *chain) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	c.mu.Lock()
+	gate := c.gate
+	c.mu.Unlock()
+	if gate != nil {
+		select {
+		case <-gate:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	parsed, err := fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	ev := parsed.Events["IntentExecuted"]
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var logs []types.Log
+	for i, s := range c.swaps {
+		if s.block < q.FromBlock.Uint64() || s.block > q.ToBlock.Uint64() {
+			continue
+		}
+		data, err := ev.Inputs.NonIndexed().Pack(big.NewInt(1e18), big.NewInt(3_000e6), big.NewInt(3_001e6), big.NewInt(1e6))
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, types.Log{
+			Address:     proxy,
+			Topics:      []common.Hash{ev.ID, common.BytesToHash(s.user.Bytes()), {}, common.BytesToHash(usdc.Bytes())},
+			Data:        data,
+			BlockNumber: s.block,
+			TxHash:      common.BigToHash(big.NewInt(int64(i + 1))),
+		})
+	}
+	return logs, nil
+}
+
+func get(t *testing.T, svc http.Handler) (int, *leaderboard.Response) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	svc.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/leaderboard", nil))
+	if rec.Code != http.StatusOK {
+		return rec.Code, nil
+	}
+	var resp leaderboard.Response
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	return rec.Code, &resp
+}
+
+// TestServeLastSnapshot checks that requests never wait on a sync: they get
+// 503 before the first one and the previous snapshot while one is running.
+func TestServeLastSnapshot(t *testing.T) {
+	c := &chain{head: 10, swaps: []swap{{3, alice}}}
+	svc, err := leaderboard.New(leaderboard.Config{Backend: c, Settlement: proxy, FromBlock: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code, _ := get(t, svc); code != http.StatusServiceUnavailable {
+		t.Fatalf("before the first sync: status %d, want 503", code)
+	}
+	if _, err := svc.Snapshot(); !errors.Is(err, leaderboard.ErrNotSynced) {
+		t.Fatalf("Snapshot before the first sync: err = %v, want ErrNotSynced", err)
+	}
+	if _, err := svc.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	c.add(20, swap{15, bob})
+	c.gate = make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		_, err := svc.Refresh(context.Background())
+		done <- err
+	}()
+	code, resp := get(t, svc)
+	if code != http.StatusOK || resp.Block != 10 || len(resp.Entries) != 1 {
+		t.Fatalf("during a sync: status %d, response %+v, want the block 10 snapshot", code, resp)
+	}
+
+	close(c.gate)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if _, resp := get(t, svc); resp == nil || resp.Block != 20 || len(resp.Entries) != 2 {
+		t.Fatalf("after the sync: response %+v, want both wallets at block 20", resp)
+	}
+}
+
+// TestRun checks that Run keeps serving through failed syncs and picks up
+// once the backend recovers.
+func TestRun(t *testing.T) {
+	c := &chain{head: 10, swaps: []swap{{3, alice}}, fail: errors.New("rpc down")}
+	svc, err := leaderboard.New(leaderboard.Config{Backend: c, Settlement: proxy, FromBlock: 1, CacheTTL: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := make(chan error, 1)
+	go func() { stopped <- svc.Run(ctx) }()
+
+	time.Sleep(10 * time.Millisecond)
+	if _, err := svc.Snapshot(); !errors.Is(err, leaderboard.ErrNotSynced) {
+		t.Fatalf("while the backend fails: err = %v, want ErrNotSynced", err)
+	}
+	c.mu.Lock()
+	c.fail = nil
+	c.mu.Unlock()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		snap, err := svc.Snapshot()
+		if err == nil {
+			if snap.Block != 10 {
+				t.Fatalf("snapshot block %d, want 10", snap.Block)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Run never built a snapshot after the backend recovered")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	if err := <-stopped; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run returned %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,96 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+// DraftOptions controls V2 → V3 intent conversion.
+type DraftOptions struct {
+	// Permit2 is the domain the user re-signs against.
+	Permit2 permit2.Domain
+	// Settlement is the FastSettlementV3 proxy, the Permit2 spender.
+	Settlement common.Address
+	// Deadline replaces the V2 deadline when set, e.g. to give users time to
+	// re-sign intents that were about to expire.
+	Deadline *big.Int
+}
+
+// Draft is an unsigned V3 intent derived from a V2 one.
+type Draft struct {
+	// V2ID is the V2 intent id the draft replaces.
+	V2ID common.Hash
+	// RefID is the V2 refId, which V3 has no field for.
+	RefID [32]byte
+	// Intent keeps the V2 Permit2 nonce. Permit2 nonces are per owner, not
+	// per spender, so whichever of the V2 or V3 signature is used first
+	// consumes the nonce and the other can no longer pull funds.
+	Intent settlement.Intent
+	// Digest is the EIP-712 digest the user must sign.
+	Digest common.Hash
+}
+
+// ErrNotConvertible is returned for V2 intents V3 would reject outright.
+var ErrNotConvertible = errors.New("migration: intent cannot be expressed on V3")
+
+// ToV3 converts a V2 intent field by field: maker → user, tokenIn →
+// inputToken, tokenOut → outputToken (address(0) is ETH in both), amountIn →
+// inputAmt, amountOut → userAmtOut.
+func ToV3(o Outstanding, opts DraftOptions) (Draft, error) {
+	in := o.Intent
+	switch {
+	case in.TokenIn == (common.Address{}):
+		return Draft{}, fmt.Errorf("%w: %s has ETH input, which V2 never settled", ErrNotConvertible, o.ID)
+	case in.Recipient == (common.Address{}):
+		return Draft{}, fmt.Errorf("%w: %s has no recipient", ErrNotConvertible, o.ID)
+	}
+	deadline := in.Deadline
+	if opts.Deadline != nil {
+		deadline = opts.Deadline
+	}
+	intent := settlement.Intent{
+		User:        in.Maker,
+		InputToken:  in.TokenIn,
+		OutputToken: in.TokenOut,
+		InputAmt:    new(big.Int).Set(in.AmountIn),
+		UserAmtOut:  new(big.Int).Set(in.AmountOut),
+		Recipient:   in.Recipient,
+		Deadline:    new(big.Int).Set(deadline),
+		Nonce:       new(big.Int).Set(in.Nonce),
+	}
+	return Draft{
+		V2ID:   o.ID,
+		RefID:  in.RefId,
+		Intent: intent,
+		Digest: opts.Permit2.Digest(opts.Settlement, intent),
+	}, nil
+}
+
+// Drafts converts every Valid intent. Intents that cannot be converted are
+// returned alongside the error-free drafts rather than aborting the batch.
+func Drafts(valid []Outstanding, opts DraftOptions) ([]Draft, map[common.Hash]error) {
+	var (
+		out    []Draft
+		failed map[common.Hash]error
+	)
+	for _, o := range valid {
+		if o.Status != Valid {
+			continue
+		}
+		d, err := ToV3(o, opts)
+		if err != nil {
+			if failed == nil {
+				failed = map[common.Hash]error{}
+			}
+			failed[o.ID] = err
+			continue
+		}
+		out = append(out, d)
+	}
+	return out, failed
+}
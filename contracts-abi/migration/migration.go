@@ -0,0 +1,87 @@
+// Package migration moves outstanding FastSettlementV2 intents to
+// FastSettlementV3 without replaying or stranding them.
+//
+// V2 tracks nonces itself (a per-maker minNonce plus a used-nonce map) on top
+// of the Permit2 nonce it passes through; V3 relies on Permit2's unordered
+// nonces alone. The migration therefore runs in four steps:
+//
+//  1. Scan V2 IntentSettled and NonceInvalidated history.
+//  2. Classify the off-chain V2 intents the relayer still holds, keeping the
+//     ones V2 would still settle.
+//  3. Convert those into V3 drafts for users to re-sign. Drafts keep the
+//     original Permit2 nonce, so at most one of the V2 and V3 versions can
+//     ever pull funds.
+//  4. Build the V2 admin transactions that close the old path: pause, and
+//     per-maker invalidateNoncesUpTo calls for users to send.
+package migration
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	fastsettlementv2 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV2"
+)
+
+// IntentV2 is the V2 signed intent.
+type IntentV2 = fastsettlementv2.IFastSettlementV2Intent
+
+// SignedIntent is a V2 intent as the relayer received it.
+type SignedIntent struct {
+	Intent    IntentV2
+	Signature []byte
+}
+
+const (
+	// intentTypeString mirrors FastSettlementV2.INTENT_TYPEHASH's preimage.
+	intentTypeString = "Intent(address maker,address recipient,address tokenIn,address tokenOut,uint256 amountIn,uint256 amountOut,uint256 deadline,uint256 nonce,bytes32 refId)"
+	domainTypeString = "EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"
+)
+
+var (
+	intentTypeHash = crypto.Keccak256Hash([]byte(intentTypeString))
+	domainTypeHash = crypto.Keccak256Hash([]byte(domainTypeString))
+	nameHash       = crypto.Keccak256Hash([]byte("FastSettlement"))
+	versionHash    = crypto.Keccak256Hash([]byte("2"))
+
+	// MaxNonce mirrors FastSettlementV2.MAX_NONCE: invalidateNoncesUpTo
+	// reverts with NonceTooHigh above it.
+	MaxNonce = new(big.Int).Sub(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)), big.NewInt(1000))
+)
+
+// DomainSeparator returns FastSettlementV2.DOMAIN_SEPARATOR() for a
+// deployment.
+func DomainSeparator(chainID *big.Int, contract common.Address) common.Hash {
+	return crypto.Keccak256Hash(
+		domainTypeHash.Bytes(),
+		nameHash.Bytes(),
+		versionHash.Bytes(),
+		word(chainID),
+		common.LeftPadBytes(contract.Bytes(), 32),
+	)
+}
+
+// IntentID returns FastSettlementV2.getIntentId(intent), the id IntentSettled
+// logs as its first topic.
+func IntentID(chainID *big.Int, contract common.Address, intent IntentV2) common.Hash {
+	structHash := crypto.Keccak256Hash(
+		intentTypeHash.Bytes(),
+		common.LeftPadBytes(intent.Maker.Bytes(), 32),
+		common.LeftPadBytes(intent.Recipient.Bytes(), 32),
+		common.LeftPadBytes(intent.TokenIn.Bytes(), 32),
+		common.LeftPadBytes(intent.TokenOut.Bytes(), 32),
+		word(intent.AmountIn),
+		word(intent.AmountOut),
+		word(intent.Deadline),
+		word(intent.Nonce),
+		intent.RefId[:],
+	)
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, DomainSeparator(chainID, contract).Bytes(), structHash.Bytes())
+}
+
+func word(x *big.Int) []byte {
+	if x == nil {
+		return make([]byte, 32)
+	}
+	return common.LeftPadBytes(x.Bytes(), 32)
+}
@@ -0,0 +1,286 @@
+package migration_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	fastsettlementv2 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV2"
+	"github.com/primev/fastprotocolapp/contracts-abi/migration"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/testharness"
+)
+
+var (
+	v2      = common.HexToAddress("0x00000000000000000000000000000000000000f2")
+	v3      = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	owner   = common.HexToAddress("0x0000000000000000000000000000000000000a0a")
+	alice   = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	bob     = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	tokenA  = common.HexToAddress("0x000000000000000000000000000000000000000a")
+	tokenB  = common.HexToAddress("0x000000000000000000000000000000000000000b")
+	chainID = big.NewInt(1)
+	now     = time.Unix(1_700_000_000, 0)
+)
+
+func signed(maker common.Address, nonce int64, deadline time.Time) migration.SignedIntent {
+	return migration.SignedIntent{
+		Intent: migration.IntentV2{
+			Maker:     maker,
+			Recipient: maker,
+			TokenIn:   tokenA,
+			TokenOut:  tokenB,
+			AmountIn:  big.NewInt(1e18),
+			AmountOut: big.NewInt(3_000e6),
+			Deadline:  big.NewInt(deadline.Unix()),
+			Nonce:     big.NewInt(nonce),
+			RefId:     [32]byte{byte(nonce)},
+		},
+		Signature: []byte{1},
+	}
+}
+
+// TestIntentID checks IntentID against go-ethereum's EIP-712 encoder.
+func TestIntentID(t *testing.T) {
+	in := signed(alice, 7, now).Intent
+	typed := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Intent": {
+				{Name: "maker", Type: "address"},
+				{Name: "recipient", Type: "address"},
+				{Name: "tokenIn", Type: "address"},
+				{Name: "tokenOut", Type: "address"},
+				{Name: "amountIn", Type: "uint256"},
+				{Name: "amountOut", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "refId", Type: "bytes32"},
+			},
+		},
+		PrimaryType: "Intent",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "FastSettlement",
+			Version:           "2",
+			ChainId:           (*math.HexOrDecimal256)(chainID),
+			VerifyingContract: v2.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"maker":     in.Maker.Hex(),
+			"recipient": in.Recipient.Hex(),
+			"tokenIn":   in.TokenIn.Hex(),
+			"tokenOut":  in.TokenOut.Hex(),
+			"amountIn":  in.AmountIn.String(),
+			"amountOut": in.AmountOut.String(),
+			"deadline":  in.Deadline.String(),
+			"nonce":     in.Nonce.String(),
+			"refId":     in.RefId[:],
+		},
+	}
+	want, _, err := apitypes.TypedDataAndHash(typed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := migration.IntentID(chainID, v2, in); got != common.BytesToHash(want) {
+		t.Fatalf("IntentID = %s, want %x", got, want)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	settled := signed(alice, 1, now.Add(-time.Hour))
+	h := &migration.History{
+		ChainID:  chainID,
+		Contract: v2,
+		MinNonce: map[common.Address]*big.Int{bob: big.NewInt(5)},
+		Settled: map[common.Hash]*fastsettlementv2.Fastsettlementv2IntentSettled{
+			migration.IntentID(chainID, v2, settled.Intent): {},
+		},
+	}
+	intents := []migration.SignedIntent{
+		settled,
+		signed(bob, 4, now.Add(time.Hour)),
+		signed(alice, 2, now.Add(-time.Second)),
+		signed(bob, 9, now.Add(time.Hour)),
+		signed(bob, 5, now.Add(time.Hour)),
+		signed(alice, 3, now.Add(time.Hour)),
+	}
+	want := []migration.Status{migration.Settled, migration.Invalidated, migration.Expired, migration.Valid, migration.Valid, migration.Valid}
+	for i, o := range h.Classify(intents, now) {
+		if o.Status != want[i] {
+			t.Errorf("intent %d: %s, want %s", i, o.Status, want[i])
+		}
+	}
+
+	valid := h.StillValid(intents, now)
+	if len(valid) != 3 {
+		t.Fatalf("%d still valid, want 3", len(valid))
+	}
+	// Ordered by maker, then nonce.
+	for i, w := range []struct {
+		maker common.Address
+		nonce int64
+	}{{bob, 5}, {bob, 9}, {alice, 3}} {
+		if in := valid[i].Intent; in.Maker != w.maker || in.Nonce.Int64() != w.nonce {
+			t.Errorf("valid %d: %s nonce %s, want %s nonce %d", i, in.Maker, in.Nonce, w.maker, w.nonce)
+		}
+	}
+}
+
+func TestDrafts(t *testing.T) {
+	h := &migration.History{ChainID: chainID, Contract: v2}
+	ethIn := signed(bob, 2, now.Add(time.Hour))
+	ethIn.Intent.TokenIn = common.Address{}
+	valid := h.Classify([]migration.SignedIntent{signed(alice, 1, now.Add(time.Hour)), ethIn}, now)
+
+	domain := permit2.Domain{ChainID: chainID, Permit2: permit2.CanonicalAddress}
+	deadline := big.NewInt(now.Add(24 * time.Hour).Unix())
+	drafts, failed := migration.Drafts(valid, migration.DraftOptions{Permit2: domain, Settlement: v3, Deadline: deadline})
+	if len(drafts) != 1 || len(failed) != 1 || !errors.Is(failed[valid[1].ID], migration.ErrNotConvertible) {
+		t.Fatalf("drafts %+v, failed %v; want the ETH-input intent rejected", drafts, failed)
+	}
+	d := drafts[0]
+	in := d.Intent
+	if in.User != alice || in.InputToken != tokenA || in.OutputToken != tokenB || in.Nonce.Int64() != 1 || in.Deadline.Cmp(deadline) != 0 {
+		t.Errorf("draft intent %+v", in)
+	}
+	if d.V2ID != valid[0].ID || d.RefID != valid[0].Intent.RefId {
+		t.Errorf("draft ids %s %x", d.V2ID, d.RefID)
+	}
+	if d.Digest != domain.Digest(v3, in) {
+		t.Errorf("digest %s is not the V3 Permit2 digest", d.Digest)
+	}
+}
+
+func TestBuildPlan(t *testing.T) {
+	h := &migration.History{ChainID: chainID, Contract: v2, MinNonce: map[common.Address]*big.Int{bob: big.NewInt(10)}}
+	random := signed(owner, 0, now.Add(time.Hour))
+	random.Intent.Nonce = new(big.Int).Set(migration.MaxNonce)
+	valid := h.Classify([]migration.SignedIntent{
+		signed(alice, 3, now.Add(time.Hour)),
+		signed(alice, 7, now.Add(time.Hour)),
+		signed(bob, 9, now.Add(time.Hour)),
+		random,
+	}, now)
+	// A settlement after the scan could leave bob's nonce 9 looking valid.
+	valid[2].Status = migration.Valid
+
+	p, err := migration.BuildPlan(h, owner, valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Pause.From != owner || p.Pause.To != v2 {
+		t.Errorf("pause %+v", p.Pause)
+	}
+	// alice moves past 7; bob is already past 9.
+	if len(p.Invalidations) != 1 || p.Invalidations[0].From != alice {
+		t.Fatalf("invalidations %+v", p.Invalidations)
+	}
+	want, err := migration.InvalidateNoncesTx(v2, alice, big.NewInt(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(p.Invalidations[0].Data) != string(want.Data) {
+		t.Errorf("alice invalidation %x, want %x", p.Invalidations[0].Data, want.Data)
+	}
+	if len(p.Uninvalidatable) != 1 || p.Uninvalidatable[0] != owner {
+		t.Errorf("uninvalidatable %v, want the maker at MAX_NONCE", p.Uninvalidatable)
+	}
+	if _, err := migration.InvalidateNoncesTx(v2, alice, new(big.Int).Add(migration.MaxNonce, common.Big1)); err == nil {
+		t.Error("nonce above MAX_NONCE accepted")
+	}
+}
+
+// TestMigrate scans a deployed FastSettlementV2, closes it with the plan's
+// transactions and rescans.
+func TestMigrate(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{})
+	ctx := context.Background()
+	addr, err := h.DeployV2()
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract, err := fastsettlementv2.NewFastsettlementv2(addr, h.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainNow, err := h.Now()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := time.Unix(int64(chainNow), 0)
+	intents := []migration.SignedIntent{
+		signed(h.User.Address, 3, ts.Add(time.Hour)),
+		signed(h.User.Address, 7, ts.Add(time.Hour)),
+		signed(h.Recipient.Address, 1, ts.Add(time.Hour)),
+	}
+	for _, si := range intents {
+		id, err := contract.GetIntentId(&bind.CallOpts{Context: ctx}, si.Intent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := migration.IntentID(h.ChainID, addr, si.Intent); got != id {
+			t.Fatalf("IntentID = %s, getIntentId = %x", got, id)
+		}
+	}
+
+	scan := func() *migration.History {
+		t.Helper()
+		hist, err := migration.Scan(ctx, h.Client, migration.ScanConfig{ChainID: h.ChainID, Contract: addr, ChunkSize: 2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hist
+	}
+	hist := scan()
+	valid, err := migration.Confirm(&bind.CallOpts{Context: ctx}, &contract.Fastsettlementv2Caller, hist.StillValid(intents, ts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(valid) != 3 {
+		t.Fatalf("%d valid intents, want 3", len(valid))
+	}
+
+	p, err := migration.BuildPlan(hist, h.Owner.Address, valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accounts := map[common.Address]testharness.Account{}
+	for _, a := range h.Accounts() {
+		accounts[a.Address] = a
+	}
+	parsed, err := fastsettlementv2.Fastsettlementv2MetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bound := bind.NewBoundContract(addr, *parsed, h.Client, h.Client, h.Client)
+	for _, tx := range append([]migration.TxRequest{p.Pause}, p.Invalidations...) {
+		if _, err := h.Mine(bound.RawTransact(h.Opts(accounts[tx.From]), tx.Data)); err != nil {
+			t.Fatalf("%s: %v", tx.Description, err)
+		}
+	}
+	if paused, err := contract.Paused(&bind.CallOpts{Context: ctx}); err != nil || !paused {
+		t.Fatalf("paused = %v, %v", paused, err)
+	}
+
+	hist = scan()
+	if got := hist.MinNonce[h.User.Address]; got == nil || got.Int64() != 8 {
+		t.Errorf("user minNonce %v, want 8", got)
+	}
+	if left := hist.StillValid(intents, ts); len(left) != 0 {
+		t.Errorf("%d intents still valid after the plan", len(left))
+	}
+	if left, err := migration.Confirm(&bind.CallOpts{Context: ctx}, &contract.Fastsettlementv2Caller, valid); err != nil || len(left) != 0 {
+		t.Errorf("Confirm = %d intents, %v; want none", len(left), err)
+	}
+}
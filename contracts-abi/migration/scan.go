@@ -0,0 +1,215 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	fastsettlementv2 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV2"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+// History is the V2 nonce and settlement state reconstructed from logs.
+type History struct {
+	ChainID  *big.Int
+	Contract common.Address
+	// ToBlock is the last block scanned.
+	ToBlock uint64
+
+	// MinNonce holds each maker's latest invalidateNoncesUpTo value.
+	MinNonce map[common.Address]*big.Int
+	// Settled holds every IntentSettled event keyed by intent id.
+	Settled map[common.Hash]*fastsettlementv2.Fastsettlementv2IntentSettled
+}
+
+// Backend is the chain access Scan needs; ethclient.Client satisfies it.
+type Backend interface {
+	settlement.LogFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// ScanConfig selects the V2 contract and block range Scan reads.
+type ScanConfig struct {
+	ChainID  *big.Int
+	Contract common.Address
+	// FromBlock should be the V2 deployment block.
+	FromBlock uint64
+	// ToBlock defaults to the head.
+	ToBlock *uint64
+	// ChunkSize is the block span of each log query. Defaults to
+	// settlement.DefaultChunkSize.
+	ChunkSize uint64
+}
+
+var (
+	intentSettledTopic    common.Hash
+	nonceInvalidatedTopic common.Hash
+)
+
+func init() {
+	parsed, err := fastsettlementv2.Fastsettlementv2MetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	intentSettledTopic = parsed.Events["IntentSettled"].ID
+	nonceInvalidatedTopic = parsed.Events["NonceInvalidated"].ID
+}
+
+// Scan reads the V2 IntentSettled and NonceInvalidated history of
+// cfg.Contract over cfg's block range.
+func Scan(ctx context.Context, backend Backend, cfg ScanConfig) (*History, error) {
+	var to uint64
+	if cfg.ToBlock != nil {
+		to = *cfg.ToBlock
+	} else {
+		head, err := backend.BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		to = head
+	}
+	filterer, err := fastsettlementv2.NewFastsettlementv2Filterer(cfg.Contract, nil)
+	if err != nil {
+		return nil, err
+	}
+	h := &History{
+		ChainID:  cfg.ChainID,
+		Contract: cfg.Contract,
+		ToBlock:  to,
+		MinNonce: map[common.Address]*big.Int{},
+		Settled:  map[common.Hash]*fastsettlementv2.Fastsettlementv2IntentSettled{},
+	}
+
+	q := ethereum.FilterQuery{
+		Addresses: []common.Address{cfg.Contract},
+		Topics:    [][]common.Hash{{intentSettledTopic, nonceInvalidatedTopic}},
+	}
+	err = settlement.ReadLogs(ctx, backend, cfg.FromBlock, to, cfg.ChunkSize, []ethereum.FilterQuery{q}, func(l types.Log) error {
+		switch l.Topics[0] {
+		case nonceInvalidatedTopic:
+			ev, err := filterer.ParseNonceInvalidated(l)
+			if err != nil {
+				return fmt.Errorf("parse NonceInvalidated: %w", err)
+			}
+			// Each increment must exceed the previous minimum, so the latest
+			// event is also the largest; max() guards against reordered logs.
+			if cur, ok := h.MinNonce[ev.Maker]; !ok || ev.NewMinNonce.Cmp(cur) > 0 {
+				h.MinNonce[ev.Maker] = ev.NewMinNonce
+			}
+		case intentSettledTopic:
+			ev, err := filterer.ParseIntentSettled(l)
+			if err != nil {
+				return fmt.Errorf("parse IntentSettled: %w", err)
+			}
+			h.Settled[ev.IntentId] = ev
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Status is the V2 fate of an off-chain intent.
+type Status uint8
+
+const (
+	// Valid intents would still settle on V2 and need migrating.
+	Valid Status = iota
+	// Settled intents appear in IntentSettled.
+	Settled
+	// Invalidated intents have a nonce below the maker's minNonce.
+	Invalidated
+	// Expired intents are past their deadline.
+	Expired
+)
+
+func (s Status) String() string {
+	switch s {
+	case Valid:
+		return "valid"
+	case Settled:
+		return "settled"
+	case Invalidated:
+		return "invalidated"
+	case Expired:
+		return "expired"
+	default:
+		return fmt.Sprintf("Status(%d)", uint8(s))
+	}
+}
+
+// Outstanding is a classified V2 intent.
+type Outstanding struct {
+	SignedIntent
+	ID     common.Hash
+	Status Status
+}
+
+// Classify resolves each intent against h at time now. An intent is checked
+// for settlement first, then invalidation, then expiry, so a settled intent is
+// reported as settled even after its deadline passes.
+//
+// Classify only sees settlements and invalidations up to h.ToBlock; callers
+// that act on Valid results should confirm them with IsNonceUsed on chain.
+func (h *History) Classify(intents []SignedIntent, now time.Time) []Outstanding {
+	out := make([]Outstanding, 0, len(intents))
+	ts := big.NewInt(now.Unix())
+	for _, si := range intents {
+		o := Outstanding{SignedIntent: si, ID: IntentID(h.ChainID, h.Contract, si.Intent)}
+		switch {
+		case h.Settled[o.ID] != nil:
+			o.Status = Settled
+		case h.MinNonce[si.Intent.Maker] != nil && si.Intent.Nonce.Cmp(h.MinNonce[si.Intent.Maker]) < 0:
+			o.Status = Invalidated
+		case si.Intent.Deadline.Cmp(ts) < 0:
+			o.Status = Expired
+		default:
+			o.Status = Valid
+		}
+		out = append(out, o)
+	}
+	return out
+}
+
+// StillValid returns the Valid subset of Classify, ordered by maker then
+// nonce.
+func (h *History) StillValid(intents []SignedIntent, now time.Time) []Outstanding {
+	var out []Outstanding
+	for _, o := range h.Classify(intents, now) {
+		if o.Status == Valid {
+			out = append(out, o)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i].Intent, out[j].Intent
+		if a.Maker != b.Maker {
+			return a.Maker.Hex() < b.Maker.Hex()
+		}
+		return a.Nonce.Cmp(b.Nonce) < 0
+	})
+	return out
+}
+
+// Confirm drops intents whose nonce V2 reports as used, closing the gap
+// between h.ToBlock and the head.
+func Confirm(opts *bind.CallOpts, caller *fastsettlementv2.Fastsettlementv2Caller, valid []Outstanding) ([]Outstanding, error) {
+	var out []Outstanding
+	for _, o := range valid {
+		used, err := caller.IsNonceUsed(opts, o.Intent.Maker, o.Intent.Nonce)
+		if err != nil {
+			return nil, fmt.Errorf("isNonceUsed(%s, %s): %w", o.Intent.Maker, o.Intent.Nonce, err)
+		}
+		if !used {
+			out = append(out, o)
+		}
+	}
+	return out, nil
+}
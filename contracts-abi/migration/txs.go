@@ -0,0 +1,109 @@
+package migration
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	fastsettlementv2 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV2"
+)
+
+// TxRequest is an unsigned V2 call for From to send.
+type TxRequest struct {
+	From        common.Address
+	To          common.Address
+	Data        []byte
+	Description string
+}
+
+// PauseTx builds the owner's FastSettlementV2.pause() call, which blocks
+// settle for every maker at once.
+func PauseTx(contract, owner common.Address) (TxRequest, error) {
+	data, err := pack("pause")
+	if err != nil {
+		return TxRequest{}, err
+	}
+	return TxRequest{From: owner, To: contract, Data: data, Description: "pause FastSettlementV2"}, nil
+}
+
+// InvalidateNoncesTx builds maker's invalidateNoncesUpTo(newMinNonce) call.
+// V2 keys minNonce by msg.sender, so only the maker can send it.
+func InvalidateNoncesTx(contract, maker common.Address, newMinNonce *big.Int) (TxRequest, error) {
+	if newMinNonce.Cmp(MaxNonce) > 0 {
+		return TxRequest{}, fmt.Errorf("migration: nonce %s above MAX_NONCE would revert NonceTooHigh", newMinNonce)
+	}
+	data, err := pack("invalidateNoncesUpTo", newMinNonce)
+	if err != nil {
+		return TxRequest{}, err
+	}
+	return TxRequest{
+		From:        maker,
+		To:          contract,
+		Data:        data,
+		Description: fmt.Sprintf("invalidate V2 nonces of %s below %s", maker, newMinNonce),
+	}, nil
+}
+
+// Plan is the set of V2 transactions that close the old settlement path.
+type Plan struct {
+	Pause         TxRequest
+	Invalidations []TxRequest
+	// Uninvalidatable lists makers with an outstanding nonce at or above
+	// MaxNonce. invalidateNoncesUpTo cannot cover them, which is common with
+	// random Permit2-style nonces; they rely on Pause, and on the V3 draft
+	// consuming the same Permit2 nonce.
+	Uninvalidatable []common.Address
+}
+
+// BuildPlan builds the pause transaction and, for every maker with a Valid
+// intent, the invalidateNoncesUpTo call that raises minNonce past that
+// maker's highest outstanding nonce. Makers already at or above that minimum
+// in h are skipped, since V2 rejects non-increasing values.
+func BuildPlan(h *History, owner common.Address, valid []Outstanding) (*Plan, error) {
+	pause, err := PauseTx(h.Contract, owner)
+	if err != nil {
+		return nil, err
+	}
+	p := &Plan{Pause: pause}
+
+	highest := map[common.Address]*big.Int{}
+	for _, o := range valid {
+		if o.Status != Valid {
+			continue
+		}
+		if cur := highest[o.Intent.Maker]; cur == nil || o.Intent.Nonce.Cmp(cur) > 0 {
+			highest[o.Intent.Maker] = o.Intent.Nonce
+		}
+	}
+	makers := make([]common.Address, 0, len(highest))
+	for m := range highest {
+		makers = append(makers, m)
+	}
+	sort.Slice(makers, func(i, j int) bool { return makers[i].Hex() < makers[j].Hex() })
+
+	for _, maker := range makers {
+		next := new(big.Int).Add(highest[maker], common.Big1)
+		if next.Cmp(MaxNonce) > 0 {
+			p.Uninvalidatable = append(p.Uninvalidatable, maker)
+			continue
+		}
+		if cur := h.MinNonce[maker]; cur != nil && next.Cmp(cur) <= 0 {
+			continue
+		}
+		tx, err := InvalidateNoncesTx(h.Contract, maker, next)
+		if err != nil {
+			return nil, err
+		}
+		p.Invalidations = append(p.Invalidations, tx)
+	}
+	return p, nil
+}
+
+func pack(method string, args ...interface{}) ([]byte, error) {
+	parsed, err := fastsettlementv2.Fastsettlementv2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack(method, args...)
+}
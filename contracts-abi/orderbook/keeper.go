@@ -0,0 +1,139 @@
+package orderbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NonceChecker reports whether a Permit2 nonce has been consumed on chain.
+// permit2.Nonces implements it.
+type NonceChecker interface {
+	NonceUsed(ctx context.Context, owner common.Address, nonce *big.Int) (bool, error)
+}
+
+// NonceCheckerFunc adapts a function to NonceChecker.
+type NonceCheckerFunc func(ctx context.Context, owner common.Address, nonce *big.Int) (bool, error)
+
+// NonceUsed implements NonceChecker.
+func (f NonceCheckerFunc) NonceUsed(ctx context.Context, owner common.Address, nonce *big.Int) (bool, error) {
+	return f(ctx, owner, nonce)
+}
+
+// Keeper moves open orders to expired once their deadline passes and to
+// cancelled once their nonce is consumed elsewhere. Orders with a
+// transaction in flight are left to whoever tracks that transaction.
+type Keeper struct {
+	Store Store
+	// Nonces, when set, enables cancellation.
+	Nonces NonceChecker
+	// Interval between sweeps in Run. Defaults to 12s.
+	Interval time.Duration
+	// Now defaults to time.Now. Deadlines are compared with its Unix time,
+	// as the contract compares them with block.timestamp.
+	Now func() time.Time
+	// OnError, when set, receives sweep errors from Run.
+	OnError func(error)
+}
+
+func (k *Keeper) now() time.Time {
+	if k.Now != nil {
+		return k.Now()
+	}
+	return time.Now()
+}
+
+// Expire moves every open order whose deadline is before now to expired and
+// returns how many moved.
+func (k *Keeper) Expire(ctx context.Context) (int, error) {
+	now := k.now().Unix()
+	due, err := k.Store.Find(ctx, Filter{States: OpenStates, DeadlineBefore: big.NewInt(now)})
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, o := range due {
+		err := k.Store.Transition(ctx, o.Hash, Expired, func(o *Order) error {
+			o.Reason = fmt.Sprintf("deadline %s passed at %d", o.Intent.Deadline, now)
+			return nil
+		})
+		switch {
+		case errors.Is(err, ErrInvalidTransition):
+			// The order left its open state since Find.
+		case err != nil:
+			return n, err
+		default:
+			n++
+		}
+	}
+	return n, nil
+}
+
+// Cancel checks the Permit2 nonce of every open order and moves those whose
+// nonce is already used to cancelled, returning how many moved.
+func (k *Keeper) Cancel(ctx context.Context) (int, error) {
+	if k.Nonces == nil {
+		return 0, nil
+	}
+	open, err := k.Store.Find(ctx, Filter{States: OpenStates})
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, o := range open {
+		used, err := k.Nonces.NonceUsed(ctx, o.Intent.User, o.Intent.Nonce)
+		if err != nil {
+			return n, fmt.Errorf("orderbook: nonce of %s: %w", o.Hash, err)
+		}
+		if !used {
+			continue
+		}
+		err = k.Store.Transition(ctx, o.Hash, Cancelled, func(o *Order) error {
+			o.Reason = fmt.Sprintf("Permit2 nonce %s consumed", o.Intent.Nonce)
+			return nil
+		})
+		switch {
+		case errors.Is(err, ErrInvalidTransition):
+			// The order left its open state since Find.
+		case err != nil:
+			return n, err
+		default:
+			n++
+		}
+	}
+	return n, nil
+}
+
+// Sweep runs Expire then Cancel, so an order that is both expired and
+// cancelled is reported as expired.
+func (k *Keeper) Sweep(ctx context.Context) error {
+	if _, err := k.Expire(ctx); err != nil {
+		return err
+	}
+	_, err := k.Cancel(ctx)
+	return err
+}
+
+// Run sweeps every Interval until ctx is done.
+func (k *Keeper) Run(ctx context.Context) error {
+	interval := k.Interval
+	if interval == 0 {
+		interval = 12 * time.Second
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		if err := k.Sweep(ctx); err != nil && k.OnError != nil && ctx.Err() == nil {
+			k.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package orderbook
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/codec"
+)
+
+type nonceKey struct {
+	user  common.Address
+	nonce common.Hash
+}
+
+type hashSet map[common.Hash]struct{}
+
+// MemoryStore is a process-local Store. Indexes are maintained on insert;
+// none of the indexed fields change afterwards.
+type MemoryStore struct {
+	mu     sync.RWMutex
+	orders map[common.Hash]*Order
+
+	byUser     map[common.Address]hashSet
+	byInput    map[common.Address]hashSet
+	byOutput   map[common.Address]hashSet
+	byNonce    map[nonceKey]hashSet
+	byDeadline []*Order // sorted by (deadline, hash)
+
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		orders:   map[common.Hash]*Order{},
+		byUser:   map[common.Address]hashSet{},
+		byInput:  map[common.Address]hashSet{},
+		byOutput: map[common.Address]hashSet{},
+		byNonce:  map[nonceKey]hashSet{},
+	}
+}
+
+func (m *MemoryStore) now() time.Time {
+	if m.Now != nil {
+		return m.Now()
+	}
+	return time.Now()
+}
+
+func add[K comparable](idx map[K]hashSet, k K, h common.Hash) {
+	set, ok := idx[k]
+	if !ok {
+		set = hashSet{}
+		idx[k] = set
+	}
+	set[h] = struct{}{}
+}
+
+func less(a, b *Order) bool {
+	if c := a.Intent.Deadline.Cmp(b.Intent.Deadline); c != 0 {
+		return c < 0
+	}
+	return a.Hash.Cmp(b.Hash) < 0
+}
+
+// Insert implements Store.
+func (m *MemoryStore) Insert(_ context.Context, o *Order) error {
+	// The deadline and nonce indexes need every integer field set.
+	if err := codec.Intent(o.Intent).Validate(); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.orders[o.Hash]; ok {
+		return ErrDuplicate
+	}
+	cp := newOrder(o, m.now())
+	m.orders[cp.Hash] = cp
+	add(m.byUser, cp.Intent.User, cp.Hash)
+	add(m.byInput, cp.Intent.InputToken, cp.Hash)
+	add(m.byOutput, cp.Intent.OutputToken, cp.Hash)
+	add(m.byNonce, nonceKey{cp.Intent.User, common.BigToHash(cp.Intent.Nonce)}, cp.Hash)
+	i := sort.Search(len(m.byDeadline), func(i int) bool { return less(cp, m.byDeadline[i]) })
+	m.byDeadline = append(m.byDeadline, nil)
+	copy(m.byDeadline[i+1:], m.byDeadline[i:])
+	m.byDeadline[i] = cp
+	return nil
+}
+
+// Get implements Store.
+func (m *MemoryStore) Get(_ context.Context, hash common.Hash) (*Order, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	o, ok := m.orders[hash]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return o.Clone(), nil
+}
+
+// Transition implements Store.
+func (m *MemoryStore) Transition(_ context.Context, hash common.Hash, to State, fn func(*Order) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cur, ok := m.orders[hash]
+	if !ok {
+		return ErrNotFound
+	}
+	next, err := apply(cur, to, fn, m.now())
+	if err != nil {
+		return err
+	}
+	// Update in place so byDeadline keeps pointing at the live order.
+	*cur = *next
+	return nil
+}
+
+// Find implements Store.
+func (m *MemoryStore) Find(_ context.Context, f Filter) ([]*Order, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var out []*Order
+	collect := func(o *Order) bool {
+		if f.Match(o) {
+			out = append(out, o.Clone())
+		}
+		return f.Limit <= 0 || len(out) < f.Limit
+	}
+	if set, ok := m.index(f); ok {
+		candidates := make([]*Order, 0, len(set))
+		for h := range set {
+			candidates = append(candidates, m.orders[h])
+		}
+		sort.Slice(candidates, func(i, j int) bool { return less(candidates[i], candidates[j]) })
+		for _, o := range candidates {
+			if !collect(o) {
+				break
+			}
+		}
+		return out, nil
+	}
+	// No equality index applies: walk the deadline index, which also lets a
+	// DeadlineBefore bound stop the scan early.
+	for _, o := range m.byDeadline {
+		if f.DeadlineBefore != nil && o.Intent.Deadline.Cmp(f.DeadlineBefore) >= 0 {
+			break
+		}
+		if !collect(o) {
+			break
+		}
+	}
+	return out, nil
+}
+
+// index returns the narrowest equality index f can use.
+func (m *MemoryStore) index(f Filter) (hashSet, bool) {
+	switch {
+	case f.User != nil && f.Nonce != nil:
+		return m.byNonce[nonceKey{*f.User, common.BigToHash(f.Nonce)}], true
+	case f.User != nil:
+		return m.byUser[*f.User], true
+	case f.InputToken != nil:
+		return m.byInput[*f.InputToken], true
+	case f.OutputToken != nil:
+		return m.byOutput[*f.OutputToken], true
+	}
+	return nil, false
+}
+
+// Close implements Store.
+func (m *MemoryStore) Close() error { return nil }
@@ -0,0 +1,236 @@
+// Package orderbook keeps signed intents between receipt and settlement and
+// tracks each one through the executor's lifecycle:
+//
+//	received → validated → simulated → submitted → mined → settled
+//	                                                     ↘ failed
+//
+// Any open intent (received, validated or simulated) can also become expired
+// once its deadline passes, or cancelled when its Permit2 nonce is consumed
+// by something other than this executor. Every state change goes through
+// Store.Transition, which checks the move against the table below and applies
+// it atomically; Keeper performs the time- and chain-driven moves.
+//
+// Orders are keyed by permit2.WitnessHash and indexed by user, deadline,
+// input and output token, and (user, Permit2 nonce). MemoryStore and
+// SQLiteStore implement the same Store.
+package orderbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+// State is an order's position in the lifecycle.
+type State string
+
+const (
+	Received  State = "received"
+	Validated State = "validated"
+	Simulated State = "simulated"
+	Submitted State = "submitted"
+	Mined     State = "mined"
+	Settled   State = "settled"
+	Failed    State = "failed"
+	Expired   State = "expired"
+	Cancelled State = "cancelled"
+)
+
+// transitions lists the states each state may move to. A state absent from
+// the map is final.
+var transitions = map[State][]State{
+	Received:  {Validated, Failed, Expired, Cancelled},
+	Validated: {Simulated, Failed, Expired, Cancelled},
+	// Re-simulating refreshes the swap route before submission.
+	Simulated: {Simulated, Submitted, Failed, Expired, Cancelled},
+	// A replacement transaction stays submitted with a new TxHash; a dropped
+	// one goes back to simulated for resubmission. A submitted order never
+	// expires directly: past its deadline the transaction reverts and fails,
+	// or is dropped and expires from simulated.
+	Submitted: {Submitted, Simulated, Mined, Failed},
+	// A reorg can take a mined transaction back to the mempool.
+	Mined: {Submitted, Settled, Failed},
+}
+
+// OpenStates are the states in which an order has no transaction in flight
+// and can still expire or be cancelled.
+var OpenStates = []State{Received, Validated, Simulated}
+
+// CanTransition reports whether an order in state from may move to to.
+func CanTransition(from, to State) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Final reports whether s is a terminal state.
+func (s State) Final() bool {
+	_, ok := transitions[s]
+	return !ok
+}
+
+// Open reports whether s is one of OpenStates.
+func (s State) Open() bool {
+	return s == Received || s == Validated || s == Simulated
+}
+
+var (
+	// ErrNotFound is returned for unknown order hashes.
+	ErrNotFound = errors.New("orderbook: order not found")
+	// ErrDuplicate is returned when an order hash is already stored.
+	ErrDuplicate = errors.New("orderbook: order already stored")
+	// ErrInvalidTransition is returned when a transition is not allowed from
+	// the order's current state.
+	ErrInvalidTransition = errors.New("orderbook: invalid state transition")
+)
+
+func invalidTransition(hash common.Hash, from, to State) error {
+	return fmt.Errorf("%w: %s: %s → %s", ErrInvalidTransition, hash, from, to)
+}
+
+// Order is a signed intent and its progress.
+type Order struct {
+	// Hash is the intent's EIP-712 witness hash.
+	Hash      common.Hash
+	Intent    settlement.Intent
+	Signature []byte
+	SwapHint  *settlement.SwapCall
+
+	State State
+	// TxHash and BlockNumber identify the settling transaction once
+	// submitted and mined.
+	TxHash      common.Hash
+	BlockNumber uint64
+	// Received and Surplus are copied from IntentExecuted once settled.
+	Received *big.Int
+	Surplus  *big.Int
+	// Reason describes why the order failed, expired or was cancelled.
+	Reason string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Clone returns a copy of o that shares no mutable memory with it.
+func (o *Order) Clone() *Order {
+	cp := *o
+	cp.Intent = settlement.Intent{
+		User:        o.Intent.User,
+		InputToken:  o.Intent.InputToken,
+		OutputToken: o.Intent.OutputToken,
+		InputAmt:    cloneBig(o.Intent.InputAmt),
+		UserAmtOut:  cloneBig(o.Intent.UserAmtOut),
+		Recipient:   o.Intent.Recipient,
+		Deadline:    cloneBig(o.Intent.Deadline),
+		Nonce:       cloneBig(o.Intent.Nonce),
+	}
+	cp.Signature = common.CopyBytes(o.Signature)
+	if o.SwapHint != nil {
+		cp.SwapHint = &settlement.SwapCall{
+			To:    o.SwapHint.To,
+			Value: cloneBig(o.SwapHint.Value),
+			Data:  common.CopyBytes(o.SwapHint.Data),
+		}
+	}
+	cp.Received = cloneBig(o.Received)
+	cp.Surplus = cloneBig(o.Surplus)
+	return &cp
+}
+
+func cloneBig(x *big.Int) *big.Int {
+	if x == nil {
+		return nil
+	}
+	return new(big.Int).Set(x)
+}
+
+// Filter selects orders. Zero fields match everything; set fields are ANDed.
+type Filter struct {
+	User        *common.Address
+	InputToken  *common.Address
+	OutputToken *common.Address
+	// Nonce matches the Permit2 nonce, usually together with User.
+	Nonce *big.Int
+	// States matches any of the listed states.
+	States []State
+	// DeadlineBefore matches orders whose deadline is strictly earlier.
+	DeadlineBefore *big.Int
+	// Limit caps the number of results when positive.
+	Limit int
+}
+
+// Match reports whether o satisfies f, ignoring Limit.
+func (f Filter) Match(o *Order) bool {
+	switch {
+	case f.User != nil && o.Intent.User != *f.User,
+		f.InputToken != nil && o.Intent.InputToken != *f.InputToken,
+		f.OutputToken != nil && o.Intent.OutputToken != *f.OutputToken,
+		f.Nonce != nil && o.Intent.Nonce.Cmp(f.Nonce) != 0,
+		f.DeadlineBefore != nil && o.Intent.Deadline.Cmp(f.DeadlineBefore) >= 0:
+		return false
+	}
+	if len(f.States) == 0 {
+		return true
+	}
+	for _, s := range f.States {
+		if o.State == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Store persists orders. Find returns orders by ascending deadline, then
+// hash.
+type Store interface {
+	// Insert stores o in state Received, or returns ErrDuplicate if o.Hash
+	// exists. An intent with a missing or out-of-range integer field is
+	// rejected with codec.ErrInvalid.
+	Insert(ctx context.Context, o *Order) error
+	// Get returns a copy of the order, or ErrNotFound.
+	Get(ctx context.Context, hash common.Hash) (*Order, error)
+	// Transition moves the order to state to, first applying fn (which may
+	// be nil) to record tx hashes, amounts or a reason. The check, fn and
+	// write happen atomically; an error from fn aborts the transition.
+	// Hash, Intent and Signature are immutable.
+	Transition(ctx context.Context, hash common.Hash, to State, fn func(*Order) error) error
+	// Find returns copies of the orders matching f.
+	Find(ctx context.Context, f Filter) ([]*Order, error)
+	// Close releases the store's resources.
+	Close() error
+}
+
+// newOrder prepares o for insertion.
+func newOrder(o *Order, now time.Time) *Order {
+	cp := o.Clone()
+	cp.State = Received
+	cp.CreatedAt = now
+	cp.UpdatedAt = now
+	return cp
+}
+
+// apply runs a transition on a copy of cur and returns the updated order.
+func apply(cur *Order, to State, fn func(*Order) error, now time.Time) (*Order, error) {
+	if !CanTransition(cur.State, to) {
+		return nil, invalidTransition(cur.Hash, cur.State, to)
+	}
+	next := cur.Clone()
+	if fn != nil {
+		if err := fn(next); err != nil {
+			return nil, err
+		}
+	}
+	next.Hash, next.Intent, next.Signature = cur.Hash, cur.Intent, cur.Signature
+	next.State = to
+	next.CreatedAt = cur.CreatedAt
+	next.UpdatedAt = now
+	return next, nil
+}
@@ -0,0 +1,240 @@
+package orderbook_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/codec"
+	"github.com/primev/fastprotocolapp/contracts-abi/orderbook"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+var (
+	alice = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	bob   = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	usdc  = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	weth  = common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	now   = time.Unix(1_800_000_000, 0)
+)
+
+func order(user common.Address, nonce, deadline int64) *orderbook.Order {
+	intent := settlement.Intent{
+		User:        user,
+		InputToken:  usdc,
+		OutputToken: weth,
+		InputAmt:    big.NewInt(1_000_000),
+		UserAmtOut:  big.NewInt(1),
+		Recipient:   user,
+		Deadline:    big.NewInt(deadline),
+		Nonce:       big.NewInt(nonce),
+	}
+	return &orderbook.Order{Hash: permit2.WitnessHash(intent), Intent: intent, Signature: []byte{1, 2, 3}}
+}
+
+// stores runs fn against every Store implementation.
+func stores(t *testing.T, fn func(t *testing.T, s orderbook.Store)) {
+	t.Run("memory", func(t *testing.T) {
+		s := orderbook.NewMemoryStore()
+		s.Now = func() time.Time { return now }
+		fn(t, s)
+	})
+	t.Run("sqlite", func(t *testing.T) {
+		s, err := orderbook.OpenSQLite(":memory:")
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.Now = func() time.Time { return now }
+		t.Cleanup(func() { s.Close() })
+		fn(t, s)
+	})
+}
+
+func TestInsertValidates(t *testing.T) {
+	stores(t, func(t *testing.T, s orderbook.Store) {
+		ctx := context.Background()
+		o := order(alice, 1, 100)
+		o.Intent.Deadline = nil
+		if err := s.Insert(ctx, o); !errors.Is(err, codec.ErrInvalid) {
+			t.Fatalf("nil deadline: err = %v, want codec.ErrInvalid", err)
+		}
+		o = order(alice, 1, 100)
+		o.Intent.Nonce = nil
+		if err := s.Insert(ctx, o); !errors.Is(err, codec.ErrInvalid) {
+			t.Fatalf("nil nonce: err = %v, want codec.ErrInvalid", err)
+		}
+
+		o = order(alice, 1, 100)
+		if err := s.Insert(ctx, o); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Insert(ctx, o); !errors.Is(err, orderbook.ErrDuplicate) {
+			t.Fatalf("second insert: err = %v, want ErrDuplicate", err)
+		}
+		got, err := s.Get(ctx, o.Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.State != orderbook.Received || !got.CreatedAt.Equal(now) || got.Intent.Deadline.Int64() != 100 {
+			t.Fatalf("stored %+v", got)
+		}
+	})
+}
+
+func TestTransitions(t *testing.T) {
+	stores(t, func(t *testing.T, s orderbook.Store) {
+		ctx := context.Background()
+		o := order(alice, 1, 100)
+		if err := s.Insert(ctx, o); err != nil {
+			t.Fatal(err)
+		}
+		tx := common.HexToHash("0xabc")
+		steps := []struct {
+			to orderbook.State
+			ok bool
+		}{
+			{orderbook.Submitted, false},
+			{orderbook.Validated, true},
+			{orderbook.Simulated, true},
+			{orderbook.Submitted, true},
+			{orderbook.Expired, false},
+			{orderbook.Cancelled, false},
+			{orderbook.Mined, true},
+			{orderbook.Settled, true},
+			{orderbook.Failed, false},
+		}
+		for _, st := range steps {
+			err := s.Transition(ctx, o.Hash, st.to, func(o *orderbook.Order) error {
+				o.TxHash = tx
+				return nil
+			})
+			if st.ok && err != nil {
+				t.Fatalf("→ %s: %v", st.to, err)
+			}
+			if !st.ok && !errors.Is(err, orderbook.ErrInvalidTransition) {
+				t.Fatalf("→ %s: err = %v, want ErrInvalidTransition", st.to, err)
+			}
+		}
+		got, err := s.Get(ctx, o.Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.State != orderbook.Settled || got.TxHash != tx || !got.State.Final() {
+			t.Fatalf("final order %+v", got)
+		}
+
+		abort := errors.New("abort")
+		o2 := order(alice, 2, 100)
+		if err := s.Insert(ctx, o2); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Transition(ctx, o2.Hash, orderbook.Validated, func(*orderbook.Order) error { return abort }); !errors.Is(err, abort) {
+			t.Fatalf("aborted transition: err = %v", err)
+		}
+		if got, _ := s.Get(ctx, o2.Hash); got.State != orderbook.Received {
+			t.Fatalf("aborted transition moved the order to %s", got.State)
+		}
+	})
+}
+
+func TestFind(t *testing.T) {
+	stores(t, func(t *testing.T, s orderbook.Store) {
+		ctx := context.Background()
+		orders := []*orderbook.Order{order(alice, 1, 300), order(alice, 2, 100), order(bob, 1, 200)}
+		for _, o := range orders {
+			if err := s.Insert(ctx, o); err != nil {
+				t.Fatal(err)
+			}
+		}
+		deadlines := func(f orderbook.Filter) []int64 {
+			found, err := s.Find(ctx, f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var out []int64
+			for _, o := range found {
+				out = append(out, o.Intent.Deadline.Int64())
+			}
+			return out
+		}
+		equal := func(a, b []int64) bool {
+			if len(a) != len(b) {
+				return false
+			}
+			for i := range a {
+				if a[i] != b[i] {
+					return false
+				}
+			}
+			return true
+		}
+		for _, tt := range []struct {
+			name string
+			f    orderbook.Filter
+			want []int64
+		}{
+			{"all", orderbook.Filter{}, []int64{100, 200, 300}},
+			{"user", orderbook.Filter{User: &alice}, []int64{100, 300}},
+			{"user nonce", orderbook.Filter{User: &alice, Nonce: big.NewInt(1)}, []int64{300}},
+			{"deadline", orderbook.Filter{DeadlineBefore: big.NewInt(300)}, []int64{100, 200}},
+			{"limit", orderbook.Filter{Limit: 1}, []int64{100}},
+			{"token", orderbook.Filter{InputToken: &usdc, OutputToken: &weth, Limit: 2}, []int64{100, 200}},
+			{"state", orderbook.Filter{States: []orderbook.State{orderbook.Validated}}, nil},
+		} {
+			if got := deadlines(tt.f); !equal(got, tt.want) {
+				t.Errorf("%s: deadlines %v, want %v", tt.name, got, tt.want)
+			}
+		}
+	})
+}
+
+func TestKeeper(t *testing.T) {
+	stores(t, func(t *testing.T, s orderbook.Store) {
+		ctx := context.Background()
+		expired := order(alice, 1, now.Unix()-1)
+		inFlight := order(alice, 2, now.Unix()-1)
+		consumed := order(bob, 7, now.Unix()+60)
+		live := order(bob, 8, now.Unix()+60)
+		for _, o := range []*orderbook.Order{expired, inFlight, consumed, live} {
+			if err := s.Insert(ctx, o); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, to := range []orderbook.State{orderbook.Validated, orderbook.Simulated, orderbook.Submitted} {
+			if err := s.Transition(ctx, inFlight.Hash, to, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+		k := &orderbook.Keeper{
+			Store: s,
+			Now:   func() time.Time { return now },
+			Nonces: orderbook.NonceCheckerFunc(func(_ context.Context, owner common.Address, nonce *big.Int) (bool, error) {
+				return owner == bob && nonce.Int64() == 7, nil
+			}),
+		}
+		if err := k.Sweep(ctx); err != nil {
+			t.Fatal(err)
+		}
+		for _, tt := range []struct {
+			o    *orderbook.Order
+			want orderbook.State
+		}{
+			{expired, orderbook.Expired},
+			{inFlight, orderbook.Submitted},
+			{consumed, orderbook.Cancelled},
+			{live, orderbook.Received},
+		} {
+			got, err := s.Get(ctx, tt.o.Hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.State != tt.want {
+				t.Errorf("deadline %s nonce %s: state %s, want %s", got.Intent.Deadline, got.Intent.Nonce, got.State, tt.want)
+			}
+		}
+	})
+}
@@ -0,0 +1,305 @@
+package orderbook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	sqlite3 "github.com/mattn/go-sqlite3"
+	"github.com/primev/fastprotocolapp/contracts-abi/codec"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+// schema stores addresses and hashes as raw bytes and uint256 values as
+// 32-byte big-endian blobs, so SQLite's memcmp ordering of the deadline
+// column is numeric order.
+const schema = `
+CREATE TABLE IF NOT EXISTS orders (
+	hash         BLOB PRIMARY KEY,
+	user         BLOB NOT NULL,
+	input_token  BLOB NOT NULL,
+	output_token BLOB NOT NULL,
+	input_amt    BLOB NOT NULL,
+	user_amt_out BLOB NOT NULL,
+	recipient    BLOB NOT NULL,
+	deadline     BLOB NOT NULL,
+	nonce        BLOB NOT NULL,
+	signature    BLOB NOT NULL,
+	swap_hint    BLOB,
+	state        TEXT NOT NULL,
+	tx_hash      BLOB NOT NULL,
+	block_number INTEGER NOT NULL,
+	received     BLOB,
+	surplus      BLOB,
+	reason       TEXT NOT NULL,
+	created_at   INTEGER NOT NULL,
+	updated_at   INTEGER NOT NULL
+) WITHOUT ROWID;
+CREATE INDEX IF NOT EXISTS orders_user_nonce ON orders (user, nonce);
+CREATE INDEX IF NOT EXISTS orders_deadline ON orders (deadline);
+CREATE INDEX IF NOT EXISTS orders_input_token ON orders (input_token, deadline);
+CREATE INDEX IF NOT EXISTS orders_output_token ON orders (output_token, deadline);
+CREATE INDEX IF NOT EXISTS orders_state ON orders (state, deadline);
+`
+
+const columns = `hash, user, input_token, output_token, input_amt, user_amt_out, recipient, deadline, nonce,
+	signature, swap_hint, state, tx_hash, block_number, received, surplus, reason, created_at, updated_at`
+
+// SQLiteStore is a Store backed by a SQLite database.
+type SQLiteStore struct {
+	db *sql.DB
+
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+var _ Store = (*SQLiteStore)(nil)
+
+// OpenSQLite opens (creating if needed) the database at path, which may be
+// ":memory:".
+func OpenSQLite(path string) (*SQLiteStore, error) {
+	// _txlock=immediate takes the write lock at BEGIN, so a Transition's
+	// read-check-write cannot interleave with another writer.
+	db, err := sql.Open("sqlite3", "file:"+path+"?_txlock=immediate&_busy_timeout=5000&_journal_mode=WAL")
+	if err != nil {
+		return nil, err
+	}
+	// One connection serialises writers and keeps ":memory:" a single
+	// database.
+	db.SetMaxOpenConns(1)
+	s, err := NewSQLiteStore(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// NewSQLiteStore creates the schema in db if needed and returns a store over
+// it. The store takes ownership of db.
+func NewSQLiteStore(db *sql.DB) (*SQLiteStore, error) {
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("orderbook: create schema: %w", err)
+	}
+	return &SQLiteStore{db: db}, nil
+}
+
+func (s *SQLiteStore) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+// Insert implements Store.
+func (s *SQLiteStore) Insert(ctx context.Context, o *Order) error {
+	cp := newOrder(o, s.now())
+	args, err := orderArgs(cp)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, "INSERT INTO orders ("+columns+") VALUES ("+placeholders(len(args))+")", args...)
+	var serr sqlite3.Error
+	if errors.As(err, &serr) && serr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey {
+		return ErrDuplicate
+	}
+	return err
+}
+
+// Get implements Store.
+func (s *SQLiteStore) Get(ctx context.Context, hash common.Hash) (*Order, error) {
+	return getOrder(ctx, s.db, hash)
+}
+
+// Transition implements Store.
+func (s *SQLiteStore) Transition(ctx context.Context, hash common.Hash, to State, fn func(*Order) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	cur, err := getOrder(ctx, tx, hash)
+	if err != nil {
+		return err
+	}
+	next, err := apply(cur, to, fn, s.now())
+	if err != nil {
+		return err
+	}
+	swapHint, err := swapHintBytes(next.SwapHint)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `UPDATE orders SET swap_hint = ?, state = ?, tx_hash = ?, block_number = ?,
+		received = ?, surplus = ?, reason = ?, updated_at = ? WHERE hash = ?`,
+		swapHint, string(next.State), next.TxHash.Bytes(), int64(next.BlockNumber),
+		optWord(next.Received), optWord(next.Surplus), next.Reason, next.UpdatedAt.UnixNano(), hash.Bytes())
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Find implements Store.
+func (s *SQLiteStore) Find(ctx context.Context, f Filter) ([]*Order, error) {
+	var (
+		where []string
+		args  []interface{}
+	)
+	if f.User != nil {
+		where, args = append(where, "user = ?"), append(args, f.User.Bytes())
+	}
+	if f.InputToken != nil {
+		where, args = append(where, "input_token = ?"), append(args, f.InputToken.Bytes())
+	}
+	if f.OutputToken != nil {
+		where, args = append(where, "output_token = ?"), append(args, f.OutputToken.Bytes())
+	}
+	if f.Nonce != nil {
+		where, args = append(where, "nonce = ?"), append(args, word(f.Nonce))
+	}
+	if f.DeadlineBefore != nil {
+		if f.DeadlineBefore.Sign() <= 0 {
+			return nil, nil
+		}
+		if err := codec.CheckUint256("deadlineBefore", f.DeadlineBefore); err == nil {
+			where, args = append(where, "deadline < ?"), append(args, word(f.DeadlineBefore))
+		}
+	}
+	if len(f.States) > 0 {
+		where = append(where, "state IN ("+placeholders(len(f.States))+")")
+		for _, st := range f.States {
+			args = append(args, string(st))
+		}
+	}
+	q := "SELECT " + columns + " FROM orders"
+	if len(where) > 0 {
+		q += " WHERE " + strings.Join(where, " AND ")
+	}
+	q += " ORDER BY deadline, hash"
+	if f.Limit > 0 {
+		q += fmt.Sprintf(" LIMIT %d", f.Limit)
+	}
+	rows, err := s.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*Order
+	for rows.Next() {
+		o, err := scanOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, o)
+	}
+	return out, rows.Err()
+}
+
+// Close implements Store.
+func (s *SQLiteStore) Close() error { return s.db.Close() }
+
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func getOrder(ctx context.Context, q querier, hash common.Hash) (*Order, error) {
+	o, err := scanOrder(q.QueryRowContext(ctx, "SELECT "+columns+" FROM orders WHERE hash = ?", hash.Bytes()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return o, err
+}
+
+func orderArgs(o *Order) ([]interface{}, error) {
+	if err := codec.Intent(o.Intent).Validate(); err != nil {
+		return nil, err
+	}
+	swapHint, err := swapHintBytes(o.SwapHint)
+	if err != nil {
+		return nil, err
+	}
+	i := o.Intent
+	return []interface{}{
+		o.Hash.Bytes(), i.User.Bytes(), i.InputToken.Bytes(), i.OutputToken.Bytes(),
+		word(i.InputAmt), word(i.UserAmtOut), i.Recipient.Bytes(), word(i.Deadline), word(i.Nonce),
+		common.CopyBytes(o.Signature), swapHint, string(o.State), o.TxHash.Bytes(), int64(o.BlockNumber),
+		optWord(o.Received), optWord(o.Surplus), o.Reason, o.CreatedAt.UnixNano(), o.UpdatedAt.UnixNano(),
+	}, nil
+}
+
+func scanOrder(row scanner) (*Order, error) {
+	var (
+		o                                                    Order
+		hash, user, in, out, inAmt, amtOut, recipient        []byte
+		deadline, nonce, swapHint, txHash, received, surplus []byte
+		state                                                string
+		block, created, updated                              int64
+	)
+	err := row.Scan(&hash, &user, &in, &out, &inAmt, &amtOut, &recipient, &deadline, &nonce,
+		&o.Signature, &swapHint, &state, &txHash, &block, &received, &surplus, &o.Reason, &created, &updated)
+	if err != nil {
+		return nil, err
+	}
+	o.Hash = common.BytesToHash(hash)
+	o.Intent = settlement.Intent{
+		User:        common.BytesToAddress(user),
+		InputToken:  common.BytesToAddress(in),
+		OutputToken: common.BytesToAddress(out),
+		InputAmt:    new(big.Int).SetBytes(inAmt),
+		UserAmtOut:  new(big.Int).SetBytes(amtOut),
+		Recipient:   common.BytesToAddress(recipient),
+		Deadline:    new(big.Int).SetBytes(deadline),
+		Nonce:       new(big.Int).SetBytes(nonce),
+	}
+	if swapHint != nil {
+		var sc codec.SwapCall
+		if err := sc.UnmarshalProto(swapHint); err != nil {
+			return nil, fmt.Errorf("orderbook: order %s: swap hint: %w", o.Hash, err)
+		}
+		hint := settlement.SwapCall(sc)
+		o.SwapHint = &hint
+	}
+	o.State = State(state)
+	o.TxHash = common.BytesToHash(txHash)
+	o.BlockNumber = uint64(block)
+	if received != nil {
+		o.Received = new(big.Int).SetBytes(received)
+	}
+	if surplus != nil {
+		o.Surplus = new(big.Int).SetBytes(surplus)
+	}
+	o.CreatedAt = time.Unix(0, created)
+	o.UpdatedAt = time.Unix(0, updated)
+	return &o, nil
+}
+
+func swapHintBytes(c *settlement.SwapCall) ([]byte, error) {
+	if c == nil {
+		return nil, nil
+	}
+	return codec.SwapCall(*c).MarshalProto()
+}
+
+func word(x *big.Int) []byte { return common.BigToHash(x).Bytes() }
+
+func optWord(x *big.Int) interface{} {
+	if x == nil {
+		return nil
+	}
+	return word(x)
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
@@ -0,0 +1,65 @@
+package permit2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+)
+
+const erc1271ABI = `[{"type":"function","name":"isValidSignature","stateMutability":"view","inputs":[{"name":"hash","type":"bytes32"},{"name":"signature","type":"bytes"}],"outputs":[{"name":"","type":"bytes4"}]}]`
+
+var erc1271 = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(erc1271ABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// ERC1271MagicValue is isValidSignature's selector, which ERC-1271 contracts
+// return for a valid signature.
+var ERC1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+// VerifyAny checks sig the way Permit2's SignatureVerification does: as an
+// EOA signature when intent.User has no code, and otherwise by asking the
+// contract — typically a smart account — through ERC-1271
+// isValidSignature(digest, sig). Only a revert or a wrong return value is
+// ErrInvalidSignature; a failed RPC is returned as is, so callers can retry
+// rather than reject a signature they could not check.
+func (d Domain) VerifyAny(ctx context.Context, chain bind.ContractCaller, spender common.Address, intent fastsettlementv3.IFastSettlementV3Intent, sig []byte) error {
+	code, err := chain.CodeAt(ctx, intent.User, nil)
+	if err != nil {
+		return fmt.Errorf("permit2: code of %s: %w", intent.User, err)
+	}
+	if len(code) == 0 {
+		return d.Verify(spender, intent, sig)
+	}
+	data, err := erc1271.Pack("isValidSignature", d.Digest(spender, intent), sig)
+	if err != nil {
+		return err
+	}
+	user := intent.User
+	ret, err := chain.CallContract(ctx, ethereum.CallMsg{To: &user, Data: data}, nil)
+	if err != nil {
+		if _, ok := reverts.Data(err); ok || strings.Contains(err.Error(), "execution reverted") {
+			return fmt.Errorf("%w: isValidSignature on %s: %v", ErrInvalidSignature, intent.User, err)
+		}
+		return fmt.Errorf("permit2: isValidSignature on %s: %w", intent.User, err)
+	}
+	out, err := erc1271.Unpack("isValidSignature", ret)
+	if err != nil {
+		return fmt.Errorf("%w: isValidSignature on %s returned %x", ErrInvalidSignature, intent.User, ret)
+	}
+	magic := *abi.ConvertType(out[0], new([4]byte)).(*[4]byte)
+	if magic != ERC1271MagicValue {
+		return fmt.Errorf("%w: %s returned %x from isValidSignature", ErrInvalidSignature, intent.User, magic)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package permit2_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/testharness"
+)
+
+// revertError mimics the RPC error bind returns for a reverted eth_call.
+type revertError struct{ data string }
+
+func (e revertError) Error() string          { return "execution reverted" }
+func (e revertError) ErrorData() interface{} { return e.data }
+
+// stubAccount is a contract wallet whose isValidSignature answers with ret,
+// or fails the call with err.
+type stubAccount struct {
+	ret []byte
+	err error
+}
+
+func (s stubAccount) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x60, 0x00}, nil
+}
+
+func (s stubAccount) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return s.ret, s.err
+}
+
+func word4(b [4]byte) []byte {
+	out := make([]byte, 32)
+	copy(out, b[:])
+	return out
+}
+
+func TestVerifyAnyERC1271(t *testing.T) {
+	transport := errors.New("dial tcp: connection refused")
+	tests := []struct {
+		name    string
+		account stubAccount
+		invalid bool
+		wantErr error
+	}{
+		{name: "magic", account: stubAccount{ret: word4(permit2.ERC1271MagicValue)}},
+		{name: "wrong magic", account: stubAccount{ret: word4([4]byte{0xff, 0xff, 0xff, 0xff})}, invalid: true},
+		{name: "empty return", account: stubAccount{ret: nil}, invalid: true},
+		{name: "revert with data", account: stubAccount{err: revertError{data: "0x"}}, invalid: true},
+		{name: "revert message", account: stubAccount{err: errors.New("execution reverted")}, invalid: true},
+		{name: "transport", account: stubAccount{err: transport}, wantErr: transport},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := domain.VerifyAny(context.Background(), tt.account, spender, testIntent(), []byte{1})
+			if got := errors.Is(err, permit2.ErrInvalidSignature); got != tt.invalid {
+				t.Fatalf("err = %v, invalid %v, want %v", err, got, tt.invalid)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want it to wrap %v", err, tt.wantErr)
+			}
+			if !tt.invalid && tt.wantErr == nil && err != nil {
+				t.Fatalf("err = %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyAnySmartAccount(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{SmartAccounts: true})
+	account, err := h.DeploySmartAccount(h.User, big.NewInt(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	intent, err := h.NewIntent(h.TokenIn, h.TokenOut, testharness.Ether, testharness.Ether)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intent.User = account
+	d := h.Permit2Domain()
+	ctx := context.Background()
+
+	sig, err := h.SignIntent(h.User, intent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.VerifyAny(ctx, h.Client, h.Proxy, intent, sig); err != nil {
+		t.Fatalf("owner signature: %v", err)
+	}
+
+	other, err := h.SignIntent(h.Recipient, intent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.VerifyAny(ctx, h.Client, h.Proxy, intent, other); !errors.Is(err, permit2.ErrInvalidSignature) {
+		t.Fatalf("foreign signature: err = %v, want ErrInvalidSignature", err)
+	}
+
+	// An EOA user is checked off-chain.
+	intent.User = h.User.Address
+	if err := d.VerifyAny(ctx, h.Client, h.Proxy, intent, sig); !errors.Is(err, permit2.ErrInvalidSignature) {
+		t.Fatalf("EOA with a signature over another intent: err = %v, want ErrInvalidSignature", err)
+	}
+}
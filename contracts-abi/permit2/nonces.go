@@ -0,0 +1,31 @@
+package permit2
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ipermit2signaturetransfer "github.com/primev/fastprotocolapp/contracts-abi/clients/IPermit2SignatureTransfer"
+)
+
+// Nonces reads SignatureTransfer's unordered nonce bitmap. Permit2 stores a
+// nonce's usage as bit (nonce & 0xff) of nonceBitmap(owner, nonce >> 8).
+type Nonces struct {
+	Caller  bind.ContractCaller
+	Address common.Address
+}
+
+// NonceUsed reports whether owner has consumed nonce, either by a transfer or
+// through invalidateUnorderedNonces.
+func (n Nonces) NonceUsed(ctx context.Context, owner common.Address, nonce *big.Int) (bool, error) {
+	c, err := ipermit2signaturetransfer.NewIpermit2signaturetransferCaller(n.Address, n.Caller)
+	if err != nil {
+		return false, err
+	}
+	bitmap, err := c.NonceBitmap(&bind.CallOpts{Context: ctx}, owner, new(big.Int).Rsh(nonce, 8))
+	if err != nil {
+		return false, err
+	}
+	return bitmap.Bit(int(nonce.Uint64()&0xff)) == 1, nil
+}
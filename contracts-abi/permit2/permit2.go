@@ -0,0 +1,174 @@
+// Package permit2 implements the Permit2 SignatureTransfer witness hashing used
+// by FastSettlementV3.executeWithPermit, so intents can be signed and verified
+// off-chain exactly as the contracts check them on-chain.
+package permit2
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+)
+
+// CanonicalAddress is the deterministic Permit2 deployment address shared by
+// every chain Uniswap has deployed it to.
+var CanonicalAddress = common.HexToAddress("0x000000000022D473030F116dDEE9F6B43aC78BA3")
+
+const (
+	// IntentTypeString mirrors FastSettlementV3.INTENT_TYPEHASH's preimage.
+	IntentTypeString = "Intent(address user,address inputToken,address outputToken,uint256 inputAmt,uint256 userAmtOut,address recipient,uint256 deadline,uint256 nonce)"
+
+	// TokenPermissionsTypeString is Permit2's TokenPermissions struct type.
+	TokenPermissionsTypeString = "TokenPermissions(address token,uint256 amount)"
+
+	// WitnessTypeString mirrors FastSettlementV3.WITNESS_TYPE_STRING.
+	WitnessTypeString = "Intent witness)" + IntentTypeString + TokenPermissionsTypeString
+
+	permitWitnessTransferFromStub = "PermitWitnessTransferFrom(TokenPermissions permitted,address spender,uint256 nonce,uint256 deadline,"
+	domainTypeString              = "EIP712Domain(string name,uint256 chainId,address verifyingContract)"
+)
+
+var (
+	// IntentTypeHash is keccak256(IntentTypeString).
+	IntentTypeHash = crypto.Keccak256Hash([]byte(IntentTypeString))
+
+	// TokenPermissionsTypeHash is keccak256(TokenPermissionsTypeString).
+	TokenPermissionsTypeHash = crypto.Keccak256Hash([]byte(TokenPermissionsTypeString))
+
+	// PermitWitnessTransferFromTypeHash is the typehash Permit2 derives for the
+	// FastSettlementV3 witness type string.
+	PermitWitnessTransferFromTypeHash = crypto.Keccak256Hash([]byte(permitWitnessTransferFromStub + WitnessTypeString))
+
+	domainTypeHash = crypto.Keccak256Hash([]byte(domainTypeString))
+	nameHash       = crypto.Keccak256Hash([]byte("Permit2"))
+)
+
+// ErrInvalidSignature is returned when a signature is malformed or does not
+// recover to the intent's user.
+var ErrInvalidSignature = errors.New("permit2: invalid signature")
+
+// Domain identifies the Permit2 deployment a signature is bound to.
+type Domain struct {
+	ChainID *big.Int
+	Permit2 common.Address
+}
+
+// Separator returns the EIP-712 domain separator Permit2 computes for itself.
+func (d Domain) Separator() common.Hash {
+	return crypto.Keccak256Hash(
+		domainTypeHash.Bytes(),
+		nameHash.Bytes(),
+		word(d.ChainID),
+		common.LeftPadBytes(d.Permit2.Bytes(), 32),
+	)
+}
+
+// WitnessHash returns keccak256(abi.encode(INTENT_TYPEHASH, intent)), the
+// witness FastSettlementV3 passes to Permit2. It uniquely identifies an intent.
+func WitnessHash(intent fastsettlementv3.IFastSettlementV3Intent) common.Hash {
+	return crypto.Keccak256Hash(
+		IntentTypeHash.Bytes(),
+		common.LeftPadBytes(intent.User.Bytes(), 32),
+		common.LeftPadBytes(intent.InputToken.Bytes(), 32),
+		common.LeftPadBytes(intent.OutputToken.Bytes(), 32),
+		word(intent.InputAmt),
+		word(intent.UserAmtOut),
+		common.LeftPadBytes(intent.Recipient.Bytes(), 32),
+		word(intent.Deadline),
+		word(intent.Nonce),
+	)
+}
+
+// StructHash returns the PermitWitnessTransferFrom struct hash for intent as
+// seen by Permit2 when spender (the settlement proxy) pulls the input.
+func StructHash(spender common.Address, intent fastsettlementv3.IFastSettlementV3Intent) common.Hash {
+	tokenPermissions := crypto.Keccak256Hash(
+		TokenPermissionsTypeHash.Bytes(),
+		common.LeftPadBytes(intent.InputToken.Bytes(), 32),
+		word(intent.InputAmt),
+	)
+	witness := WitnessHash(intent)
+	return crypto.Keccak256Hash(
+		PermitWitnessTransferFromTypeHash.Bytes(),
+		tokenPermissions.Bytes(),
+		common.LeftPadBytes(spender.Bytes(), 32),
+		word(intent.Nonce),
+		word(intent.Deadline),
+		witness.Bytes(),
+	)
+}
+
+// Digest returns the EIP-712 digest the intent's user signs.
+func (d Domain) Digest(spender common.Address, intent fastsettlementv3.IFastSettlementV3Intent) common.Hash {
+	sep := d.Separator()
+	structHash := StructHash(spender, intent)
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, sep.Bytes(), structHash.Bytes())
+}
+
+// Sign produces the 65-byte (r, s, v) signature Permit2 expects for intent,
+// with v in {27, 28}.
+func (d Domain) Sign(key *ecdsa.PrivateKey, spender common.Address, intent fastsettlementv3.IFastSettlementV3Intent) ([]byte, error) {
+	digest := d.Digest(spender, intent)
+	sig, err := crypto.Sign(digest.Bytes(), key)
+	if err != nil {
+		return nil, fmt.Errorf("permit2: sign intent: %w", err)
+	}
+	sig[64] += 27
+	return sig, nil
+}
+
+// Recover returns the EOA that produced sig over intent. Both the 65-byte and
+// the 64-byte EIP-2098 compact forms are accepted, matching Permit2's
+// SignatureVerification. A 65-byte signature must carry v as 27 or 28: the
+// contract hands v to ecrecover unchanged, which rejects 0 and 1.
+func (d Domain) Recover(spender common.Address, intent fastsettlementv3.IFastSettlementV3Intent, sig []byte) (common.Address, error) {
+	var rsv [65]byte
+	switch len(sig) {
+	case 65:
+		copy(rsv[:], sig)
+		if v := rsv[64]; v != 27 && v != 28 {
+			return common.Address{}, fmt.Errorf("%w: v is %d, want 27 or 28", ErrInvalidSignature, v)
+		}
+		rsv[64] -= 27
+	case 64:
+		copy(rsv[:32], sig[:32])
+		vs := new(big.Int).SetBytes(sig[32:])
+		rsv[64] = byte(vs.Bit(255))
+		vs.SetBit(vs, 255, 0)
+		vs.FillBytes(rsv[32:64])
+	default:
+		return common.Address{}, fmt.Errorf("%w: length %d", ErrInvalidSignature, len(sig))
+	}
+	if rsv[64] > 1 {
+		return common.Address{}, fmt.Errorf("%w: bad recovery id", ErrInvalidSignature)
+	}
+	digest := d.Digest(spender, intent)
+	pub, err := crypto.SigToPub(digest.Bytes(), rsv[:])
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// Verify checks that sig is a valid EOA signature by intent.User.
+func (d Domain) Verify(spender common.Address, intent fastsettlementv3.IFastSettlementV3Intent, sig []byte) error {
+	signer, err := d.Recover(spender, intent, sig)
+	if err != nil {
+		return err
+	}
+	if signer != intent.User {
+		return fmt.Errorf("%w: signed by %s, intent user is %s", ErrInvalidSignature, signer, intent.User)
+	}
+	return nil
+}
+
+func word(x *big.Int) []byte {
+	if x == nil {
+		return make([]byte, 32)
+	}
+	return common.BigToHash(x).Bytes()
+}
@@ -0,0 +1,99 @@
+package permit2_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+)
+
+var (
+	testKey, _ = crypto.ToECDSA(crypto.Keccak256([]byte("user")))
+	testUser   = crypto.PubkeyToAddress(testKey.PublicKey)
+	spender    = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	domain     = permit2.Domain{ChainID: big.NewInt(1), Permit2: permit2.CanonicalAddress}
+)
+
+func testIntent() fastsettlementv3.IFastSettlementV3Intent {
+	return fastsettlementv3.IFastSettlementV3Intent{
+		User:        testUser,
+		InputToken:  common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+		OutputToken: common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"),
+		InputAmt:    big.NewInt(1_000_000),
+		UserAmtOut:  big.NewInt(300_000_000_000_000),
+		Recipient:   testUser,
+		Deadline:    big.NewInt(1_900_000_000),
+		Nonce:       big.NewInt(7),
+	}
+}
+
+func TestSignRecover(t *testing.T) {
+	intent := testIntent()
+	sig, err := domain.Sign(testKey, spender, intent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := sig[64]; v != 27 && v != 28 {
+		t.Fatalf("Sign produced v=%d", v)
+	}
+	got, err := domain.Recover(spender, intent, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != testUser {
+		t.Fatalf("Recover = %s, want %s", got, testUser)
+	}
+	if err := domain.Verify(spender, intent, sig); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+
+	intent.UserAmtOut = big.NewInt(1)
+	if err := domain.Verify(spender, intent, sig); !errors.Is(err, permit2.ErrInvalidSignature) {
+		t.Fatalf("Verify of a modified intent = %v, want ErrInvalidSignature", err)
+	}
+}
+
+func TestRecoverCompact(t *testing.T) {
+	intent := testIntent()
+	sig, err := domain.Sign(testKey, spender, intent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// EIP-2098: vs = s | (v-27) << 255.
+	compact := make([]byte, 64)
+	copy(compact, sig[:64])
+	if sig[64] == 28 {
+		compact[32] |= 0x80
+	}
+	got, err := domain.Recover(spender, intent, compact)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != testUser {
+		t.Fatalf("Recover(compact) = %s, want %s", got, testUser)
+	}
+}
+
+func TestRecoverRejectsBadV(t *testing.T) {
+	intent := testIntent()
+	sig, err := domain.Sign(testKey, spender, intent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []byte{0, 1, 26, 29, 255} {
+		bad := append([]byte(nil), sig...)
+		bad[64] = v
+		if _, err := domain.Recover(spender, intent, bad); !errors.Is(err, permit2.ErrInvalidSignature) {
+			t.Errorf("v=%d: err = %v, want ErrInvalidSignature", v, err)
+		}
+	}
+	for _, n := range []int{0, 63, 66} {
+		if _, err := domain.Recover(spender, intent, make([]byte, n)); !errors.Is(err, permit2.ErrInvalidSignature) {
+			t.Errorf("length %d: err = %v, want ErrInvalidSignature", n, err)
+		}
+	}
+}
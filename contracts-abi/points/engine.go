@@ -0,0 +1,257 @@
+// Package points computes campaign points from FastSettlementV3's
+// IntentExecuted logs.
+//
+// An Engine replays the settlement's logs under a Rules file and records
+// one Award per settled intent in a Ledger: the swap's volume, the base
+// points, the multiplier applied, whether the user held a GenesisSBT at
+// the swap's block, and any referral credit. Per-user totals are derived
+// from the awards, so every point can be traced to the log that earned it.
+//
+// Awards depend only on the log, the rules (token prices included) and
+// GenesisSBT balances at the log's block, and all arithmetic is integer, so re-running
+// a sync reproduces the same ledger. Each sync re-reads the last ReorgDepth
+// blocks already in the ledger and replaces their awards, which removes
+// awards of orphaned logs after a reorg. Before that it checks the block hash
+// of the newest award it keeps; after a deeper reorg it walks back to the
+// newest award whose block is still canonical and re-reads from there. Changing the rules discards the
+// ledger and replays the campaign from its first block.
+package points
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/primev/fastprotocolapp/contracts-abi/amount"
+	igenesissbt "github.com/primev/fastprotocolapp/contracts-abi/clients/IGenesisSBT"
+	"github.com/primev/fastprotocolapp/contracts-abi/leaderboard"
+	"github.com/primev/fastprotocolapp/contracts-abi/profit"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"github.com/primev/fastprotocolapp/contracts-abi/tokens"
+)
+
+// Decimals is the precision of points: 1 point is 10^6 base units.
+const Decimals = 6
+
+// Symbol labels points amounts.
+const Symbol = "PTS"
+
+var (
+	ether = big.NewInt(1e18)
+)
+
+// Backend is the chain access the engine needs; ethclient.Client satisfies
+// it. GenesisSBT balances are read at each swap's block, which needs an
+// archive node for blocks older than the node's state history.
+type Backend interface {
+	bind.ContractCaller
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Config configures an Engine.
+type Config struct {
+	// Backend is required.
+	Backend Backend
+	// Settlement is the FastSettlementV3 proxy. Required.
+	Settlement common.Address
+	// Rules is required.
+	Rules *Rules
+	// WETH legs are valued 1:1 with ETH.
+	WETH common.Address
+	// Confirmations holds back the newest blocks. Defaults to 0.
+	Confirmations uint64
+	// ChunkSize is the block span of each log query. Defaults to
+	// settlement.DefaultChunkSize.
+	ChunkSize uint64
+	// ReorgDepth is how many blocks already in the ledger are re-read on
+	// each sync. Defaults to 64.
+	ReorgDepth uint64
+}
+
+// Engine applies Rules to settlement logs.
+type Engine struct {
+	cfg    Config
+	hash   common.Hash
+	oracle *profit.StaticOracle
+}
+
+// NewEngine returns an Engine for cfg.
+func NewEngine(cfg Config) (*Engine, error) {
+	if cfg.Backend == nil {
+		return nil, errors.New("points: Config.Backend is required")
+	}
+	if cfg.Settlement == (common.Address{}) {
+		return nil, errors.New("points: Config.Settlement is required")
+	}
+	if cfg.Rules == nil {
+		return nil, errors.New("points: Config.Rules is required")
+	}
+	if cfg.ReorgDepth == 0 {
+		cfg.ReorgDepth = 64
+	}
+	return &Engine{cfg: cfg, hash: cfg.Rules.Hash(), oracle: profit.NewStaticOracle(cfg.Rules.Rates)}, nil
+}
+
+// Sync brings l up to the chain head less Confirmations, or to the end of
+// the campaign. l is only modified when the whole range was read.
+func (e *Engine) Sync(ctx context.Context, l *Ledger) error {
+	rules := e.cfg.Rules
+	if l.Rules != e.hash || l.Settlement != e.cfg.Settlement {
+		*l = Ledger{Rules: e.hash, Settlement: e.cfg.Settlement}
+	}
+	head, err := e.cfg.Backend.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("points: head: %w", err)
+	}
+	if head < e.cfg.Confirmations {
+		return nil
+	}
+	to := head - e.cfg.Confirmations
+	if rules.ToBlock != 0 && to > rules.ToBlock {
+		to = rules.ToBlock
+	}
+	from := rules.FromBlock
+	if l.Synced != nil && *l.Synced+1 > from+e.cfg.ReorgDepth {
+		from = *l.Synced + 1 - e.cfg.ReorgDepth
+	}
+	if from, err = e.canonical(ctx, l, from); err != nil {
+		return err
+	}
+	if to < from {
+		return nil
+	}
+
+	holders := make(map[holding]bool)
+	var fresh []*Award
+	err = settlement.ReadIntentExecuted(ctx, e.cfg.Backend, e.cfg.Settlement, from, to, e.cfg.ChunkSize, func(ev *settlement.IntentExecuted) error {
+		a, err := e.award(ctx, ev, holders)
+		if err != nil {
+			return err
+		}
+		fresh = append(fresh, a)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	kept := l.Awards[:0:0]
+	for _, a := range l.Awards {
+		if a.Block < from {
+			kept = append(kept, a)
+		}
+	}
+	l.Awards = append(kept, fresh...)
+	sort.SliceStable(l.Awards, func(i, j int) bool {
+		if l.Awards[i].Block != l.Awards[j].Block {
+			return l.Awards[i].Block < l.Awards[j].Block
+		}
+		return l.Awards[i].LogIndex < l.Awards[j].LogIndex
+	})
+	l.Synced = &to
+	return nil
+}
+
+// canonical returns the block to re-read from so that every award kept below
+// it is still on the chain: from itself when the newest kept award's block
+// hash matches, otherwise the block after the newest award that does, or the
+// campaign's first block when none does.
+func (e *Engine) canonical(ctx context.Context, l *Ledger, from uint64) (uint64, error) {
+	reorged := false
+	for i := len(l.Awards) - 1; i >= 0; i-- {
+		a := l.Awards[i]
+		if a.Block >= from {
+			continue
+		}
+		h, err := e.cfg.Backend.HeaderByNumber(ctx, new(big.Int).SetUint64(a.Block))
+		if err != nil {
+			return 0, fmt.Errorf("points: header %d: %w", a.Block, err)
+		}
+		if h.Hash() == a.BlockHash {
+			if reorged {
+				return a.Block + 1, nil
+			}
+			return from, nil
+		}
+		reorged, from = true, a.Block
+	}
+	if reorged {
+		return e.cfg.Rules.FromBlock, nil
+	}
+	return from, nil
+}
+
+type holding struct {
+	user  common.Address
+	block uint64
+}
+
+// award applies the rules to one settled intent.
+func (e *Engine) award(ctx context.Context, ev *settlement.IntentExecuted, holders map[holding]bool) (*Award, error) {
+	rules := e.cfg.Rules
+	volume, err := leaderboard.Volume(ctx, e.oracle, e.cfg.WETH, ev)
+	if err != nil {
+		return nil, fmt.Errorf("points: %s: %w", ev.Raw.TxHash, err)
+	}
+	a := &Award{
+		TxHash:    ev.Raw.TxHash,
+		LogIndex:  ev.Raw.Index,
+		Block:     ev.Raw.BlockNumber,
+		BlockHash: ev.Raw.BlockHash,
+		User:      ev.User,
+		Volume:    amount.Of(volume, tokens.ETH),
+	}
+	perETH := amount.Div(new(big.Int).Mul(rules.PointsPerETH, volume), ether, amount.Down)
+	base := new(big.Int).Add(rules.SwapPoints, perETH)
+	a.Base = points(base)
+	a.MultiplierBps = rules.multiplier(volume)
+	earned := amount.MulBps(base, a.MultiplierBps, amount.Down)
+
+	bonus := new(big.Int)
+	if rules.SBT != nil {
+		key := holding{ev.User, ev.Raw.BlockNumber}
+		held, ok := holders[key]
+		if !ok {
+			if held, err = e.holdsSBT(ctx, key); err != nil {
+				return nil, err
+			}
+			holders[key] = held
+		}
+		if held {
+			a.SBTHolder = true
+			bonus = amount.MulBps(earned, rules.SBT.BonusBps, amount.Down)
+		}
+	}
+	a.SBTBonus = points(bonus)
+	total := new(big.Int).Add(earned, bonus)
+	a.Points = points(total)
+
+	a.ReferralPoints = points(nil)
+	if referrer, ok := rules.Referrals[ev.User]; ok && rules.ReferralShareBps > 0 {
+		a.Referrer = &referrer
+		a.ReferralPoints = points(amount.MulBps(total, rules.ReferralShareBps, amount.Down))
+	}
+	return a, nil
+}
+
+func (e *Engine) holdsSBT(ctx context.Context, h holding) (bool, error) {
+	sbt, err := igenesissbt.NewIgenesissbtCaller(e.cfg.Rules.SBT.Address, e.cfg.Backend)
+	if err != nil {
+		return false, err
+	}
+	bal, err := sbt.BalanceOf(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(h.block)}, h.user)
+	if err != nil {
+		return false, fmt.Errorf("points: GenesisSBT balance of %s at %d: %w", h.user, h.block, err)
+	}
+	return bal.Sign() > 0, nil
+}
+
+func points(raw *big.Int) amount.Amount { return amount.New(raw, Decimals, Symbol) }
@@ -0,0 +1,151 @@
+package points_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	"github.com/primev/fastprotocolapp/contracts-abi/points"
+)
+
+var (
+	proxy = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	alice = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	bob   = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	carol = common.HexToAddress("0x00000000000000000000000000000000000ca201")
+	usdc  = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+)
+
+type swap struct {
+	block uint64
+	user  common.Address
+}
+
+// chain serves IntentExecuted logs for swaps of 1 ETH. A block's hash
+// depends on its fork, so bumping the fork of a block reorgs it.
+type chain struct {
+	bind.ContractCaller
+	head  uint64
+	forks map[uint64]byte
+	swaps []swap
+}
+
+func (c *chain) header(n uint64) *types.Header {
+	return &types.Header{Number: new(big.Int).SetUint64(n), Extra: []byte{c.forks[n]}}
+}
+
+// reorg replaces the swaps from block on with swaps on a new fork.
+func (c *chain) reorg(block uint64, swaps ...swap) {
+	kept := c.swaps[:0]
+	for _, s := range c.swaps {
+		if s.block < block {
+			kept = append(kept, s)
+		}
+	}
+	c.swaps = append(kept, swaps...)
+	for n := block; n <= c.head; n++ {
+		c.forks[n]++
+	}
+}
+
+func (c *chain) BlockNumber(context.Context) (uint64, error) { return c.head, nil }
+
+func (c *chain) HeaderByNumber(_ context.Context, n *big.Int) (*types.Header, error) {
+	return c.header(n.Uint64()), nil
+}
+
+func (c *chain) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	parsed, err := fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	ev := parsed.Events["IntentExecuted"]
+	ether := big.NewInt(1e18)
+	var logs []types.Log
+	for i, s := range c.swaps {
+		if s.block < q.FromBlock.Uint64() || s.block > q.ToBlock.Uint64() {
+			continue
+		}
+		data, err := ev.Inputs.NonIndexed().Pack(ether, big.NewInt(3_000e6), big.NewInt(3_001e6), big.NewInt(1e6))
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, types.Log{
+			Address:     proxy,
+			Topics:      []common.Hash{ev.ID, common.BytesToHash(s.user.Bytes()), {}, common.BytesToHash(usdc.Bytes())},
+			Data:        data,
+			BlockNumber: s.block,
+			BlockHash:   c.header(s.block).Hash(),
+			TxHash:      common.BigToHash(big.NewInt(int64(i + 1))),
+		})
+	}
+	return logs, nil
+}
+
+func TestSyncDeepReorg(t *testing.T) {
+	c := &chain{head: 10, forks: map[uint64]byte{}, swaps: []swap{{3, alice}, {5, bob}}}
+	rules := parse(t, `{"swapPoints": "10", "pointsPerETH": "100", "fromBlock": 1}`)
+	e, err := points.NewEngine(points.Config{Backend: c, Settlement: proxy, Rules: rules, ReorgDepth: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	users := func(l *points.Ledger) []common.Address {
+		var out []common.Address
+		for _, a := range l.Awards {
+			out = append(out, a.User)
+		}
+		return out
+	}
+	equal := func(got, want []common.Address) bool {
+		if len(got) != len(want) {
+			return false
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	l := &points.Ledger{}
+	if err := e.Sync(context.Background(), l); err != nil {
+		t.Fatal(err)
+	}
+	if got := users(l); !equal(got, []common.Address{alice, bob}) {
+		t.Fatalf("awards to %v", got)
+	}
+	if got := l.Awards[0].Points.Text(); got != "110" {
+		t.Fatalf("points %s, want 110", got)
+	}
+
+	// The reorg starts at block 4, below the 2 blocks re-read each sync.
+	c.reorg(4, swap{6, carol})
+	c.head = 11
+	if err := e.Sync(context.Background(), l); err != nil {
+		t.Fatal(err)
+	}
+	if got := users(l); !equal(got, []common.Address{alice, carol}) {
+		t.Fatalf("after reorg, awards to %v, want alice and carol", got)
+	}
+
+	// A reorg of every awarded block replays the campaign.
+	c.reorg(2, swap{2, bob})
+	c.head = 12
+	if err := e.Sync(context.Background(), l); err != nil {
+		t.Fatal(err)
+	}
+	if got := users(l); !equal(got, []common.Address{bob}) {
+		t.Fatalf("after full reorg, awards to %v, want bob", got)
+	}
+	for _, a := range l.Awards {
+		if a.BlockHash != c.header(a.Block).Hash() {
+			t.Fatalf("award at %d has a stale block hash", a.Block)
+		}
+	}
+}
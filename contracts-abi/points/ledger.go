@@ -0,0 +1,141 @@
+package points
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/amount"
+)
+
+// Award is the audit record of one settled intent.
+type Award struct {
+	TxHash    common.Hash    `json:"txHash"`
+	LogIndex  uint           `json:"logIndex"`
+	Block     uint64         `json:"block"`
+	BlockHash common.Hash    `json:"blockHash"`
+	User      common.Address `json:"user"`
+	// Volume is the swap's value in ETH.
+	Volume amount.Amount `json:"volume"`
+	// Base is swapPoints plus pointsPerETH times Volume.
+	Base          amount.Amount `json:"base"`
+	MultiplierBps uint64        `json:"multiplierBps"`
+	SBTHolder     bool          `json:"sbtHolder"`
+	SBTBonus      amount.Amount `json:"sbtBonus"`
+	// Points is credited to User: Base scaled by the multiplier, plus
+	// SBTBonus.
+	Points   amount.Amount   `json:"points"`
+	Referrer *common.Address `json:"referrer,omitempty"`
+	// ReferralPoints is credited to Referrer on top of Points.
+	ReferralPoints amount.Amount `json:"referralPoints"`
+}
+
+// Ledger is the engine's persistent state: every award of the campaign so
+// far, in chain order.
+type Ledger struct {
+	// Rules is the hash of the rules the awards were computed under.
+	Rules      common.Hash    `json:"rules"`
+	Settlement common.Address `json:"settlement"`
+	// Synced is the last block read; nil before the first sync.
+	Synced *uint64  `json:"synced"`
+	Awards []*Award `json:"awards"`
+}
+
+// LoadLedger reads a ledger saved by Save. A missing file is an empty
+// ledger.
+func LoadLedger(path string) (*Ledger, error) {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Ledger{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var l Ledger
+	if err := json.Unmarshal(raw, &l); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// Save writes the ledger to path atomically, so an interrupted run leaves
+// the previous ledger in place.
+func (l *Ledger) Save(path string) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(l); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Balance is one user's points.
+type Balance struct {
+	User  common.Address `json:"user"`
+	Swaps int            `json:"swaps"`
+	// Swap is earned from the user's own swaps, Referral from referees'.
+	Swap     amount.Amount `json:"swap"`
+	Referral amount.Amount `json:"referral"`
+	Total    amount.Amount `json:"total"`
+}
+
+// Balances sums the awards per user, by total descending, ties by address.
+func (l *Ledger) Balances() []*Balance {
+	type sums struct {
+		swaps          int
+		swap, referral *big.Int
+	}
+	by := make(map[common.Address]*sums)
+	get := func(u common.Address) *sums {
+		s := by[u]
+		if s == nil {
+			s = &sums{swap: new(big.Int), referral: new(big.Int)}
+			by[u] = s
+		}
+		return s
+	}
+	for _, a := range l.Awards {
+		s := get(a.User)
+		s.swaps++
+		s.swap.Add(s.swap, a.Points.Raw())
+		if a.Referrer != nil {
+			r := get(*a.Referrer)
+			r.referral.Add(r.referral, a.ReferralPoints.Raw())
+		}
+	}
+	out := make([]*Balance, 0, len(by))
+	for u, s := range by {
+		out = append(out, &Balance{
+			User:     u,
+			Swaps:    s.swaps,
+			Swap:     points(s.swap),
+			Referral: points(s.referral),
+			Total:    points(new(big.Int).Add(s.swap, s.referral)),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if c := out[i].Total.Raw().Cmp(out[j].Total.Raw()); c != 0 {
+			return c > 0
+		}
+		return bytes.Compare(out[i].User[:], out[j].User[:]) < 0
+	})
+	return out
+}
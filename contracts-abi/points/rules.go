@@ -0,0 +1,224 @@
+package points
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primev/fastprotocolapp/contracts-abi/amount"
+)
+
+// Rules is a points campaign, loaded from a JSON file:
+//
+//	{
+//	  "swapPoints": "10",
+//	  "pointsPerETH": "100",
+//	  "multipliers": [
+//	    {"minVolumeETH": "1", "bps": 15000},
+//	    {"minVolumeETH": "10", "bps": 20000}
+//	  ],
+//	  "sbt": {"address": "0xGenesisSBT", "bonusBps": 1000},
+//	  "referralShareBps": 500,
+//	  "referrals": {"0xReferee": "0xReferrer"},
+//	  "rates": {"0xUSDC": "312500000"},
+//	  "fromBlock": 19000000,
+//	  "toBlock": 0
+//	}
+//
+// Points are decimals with Decimals places. A swap earns swapPoints plus
+// pointsPerETH per ETH of volume, scaled by the multiplier of the highest
+// tier its volume reaches; holding a GenesisSBT at the swap's block adds
+// bonusBps of that. A referee's swap points credit the referrer with
+// referralShareBps of them on top. toBlock 0 leaves the campaign open.
+//
+// Volume is in ETH: ETH and WETH legs count 1:1, other tokens at their rate
+// in wei per base unit, a decimal or fraction. A swap with no priced leg
+// has zero volume and earns only swapPoints. Rates are part of the rules, so
+// re-pricing a campaign replays it from its first block.
+type Rules struct {
+	SwapPoints       *big.Int
+	PointsPerETH     *big.Int
+	Multipliers      []Multiplier
+	SBT              *SBTRule
+	ReferralShareBps uint64
+	Referrals        map[common.Address]common.Address
+	Rates            map[common.Address]*big.Rat
+	FromBlock        uint64
+	ToBlock          uint64
+}
+
+// Multiplier scales the points of swaps of at least MinVolume wei.
+type Multiplier struct {
+	MinVolume *big.Int
+	Bps       uint64
+}
+
+// SBTRule grants GenesisSBT holders BonusBps more points.
+type SBTRule struct {
+	Address  common.Address
+	BonusBps uint64
+}
+
+type rulesJSON struct {
+	SwapPoints       string                            `json:"swapPoints"`
+	PointsPerETH     string                            `json:"pointsPerETH"`
+	Multipliers      []multiplierJSON                  `json:"multipliers,omitempty"`
+	SBT              *sbtRuleJSON                      `json:"sbt,omitempty"`
+	ReferralShareBps uint64                            `json:"referralShareBps,omitempty"`
+	Referrals        map[common.Address]common.Address `json:"referrals,omitempty"`
+	Rates            map[common.Address]string         `json:"rates,omitempty"`
+	FromBlock        uint64                            `json:"fromBlock"`
+	ToBlock          uint64                            `json:"toBlock,omitempty"`
+}
+
+type multiplierJSON struct {
+	MinVolumeETH string `json:"minVolumeETH"`
+	Bps          uint64 `json:"bps"`
+}
+
+type sbtRuleJSON struct {
+	Address  common.Address `json:"address"`
+	BonusBps uint64         `json:"bonusBps"`
+}
+
+// LoadRules reads Rules from a JSON file. Unknown fields are rejected so
+// that a misspelt key cannot silently change a campaign.
+func LoadRules(path string) (*Rules, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := ParseRules(raw)
+	if err != nil {
+		return nil, fmt.Errorf("points: %s: %w", path, err)
+	}
+	return r, nil
+}
+
+// ParseRules decodes and validates Rules.
+func ParseRules(raw []byte) (*Rules, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	var j rulesJSON
+	if err := dec.Decode(&j); err != nil {
+		return nil, err
+	}
+	r := &Rules{
+		ReferralShareBps: j.ReferralShareBps,
+		Referrals:        j.Referrals,
+		FromBlock:        j.FromBlock,
+		ToBlock:          j.ToBlock,
+	}
+	var err error
+	if r.SwapPoints, err = nonNegative("swapPoints", j.SwapPoints, Decimals); err != nil {
+		return nil, err
+	}
+	if r.PointsPerETH, err = nonNegative("pointsPerETH", j.PointsPerETH, Decimals); err != nil {
+		return nil, err
+	}
+	for i, m := range j.Multipliers {
+		minVolume, err := nonNegative(fmt.Sprintf("multipliers[%d].minVolumeETH", i), m.MinVolumeETH, 18)
+		if err != nil {
+			return nil, err
+		}
+		r.Multipliers = append(r.Multipliers, Multiplier{MinVolume: minVolume, Bps: m.Bps})
+	}
+	sort.SliceStable(r.Multipliers, func(a, b int) bool {
+		return r.Multipliers[a].MinVolume.Cmp(r.Multipliers[b].MinVolume) < 0
+	})
+	if j.SBT != nil {
+		if j.SBT.Address == (common.Address{}) {
+			return nil, fmt.Errorf("sbt.address is required")
+		}
+		r.SBT = &SBTRule{Address: j.SBT.Address, BonusBps: j.SBT.BonusBps}
+	}
+	if r.ReferralShareBps > amount.BPS {
+		return nil, fmt.Errorf("referralShareBps %d exceeds %d", r.ReferralShareBps, amount.BPS)
+	}
+	for referee, referrer := range r.Referrals {
+		if referee == referrer {
+			return nil, fmt.Errorf("referrals: %s refers itself", referee)
+		}
+	}
+	for token, text := range j.Rates {
+		rate, ok := new(big.Rat).SetString(text)
+		if !ok || rate.Sign() < 0 {
+			return nil, fmt.Errorf("rates: invalid rate %q for %s", text, token)
+		}
+		if r.Rates == nil {
+			r.Rates = make(map[common.Address]*big.Rat)
+		}
+		r.Rates[token] = rate
+	}
+	if r.ToBlock != 0 && r.ToBlock < r.FromBlock {
+		return nil, fmt.Errorf("toBlock %d is before fromBlock %d", r.ToBlock, r.FromBlock)
+	}
+	return r, nil
+}
+
+func nonNegative(field, s string, decimals uint8) (*big.Int, error) {
+	if s == "" {
+		return new(big.Int), nil
+	}
+	v, err := amount.ParseUnits(s, decimals)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", field, err)
+	}
+	if v.Sign() < 0 {
+		return nil, fmt.Errorf("%s: %s is negative", field, s)
+	}
+	return v, nil
+}
+
+// MarshalJSON encodes the rules in LoadRules' format with a canonical
+// layout: multipliers by threshold, referrals and rates by address, rates
+// as reduced fractions.
+func (r *Rules) MarshalJSON() ([]byte, error) {
+	j := rulesJSON{
+		SwapPoints:       amount.New(r.SwapPoints, Decimals, "").Text(),
+		PointsPerETH:     amount.New(r.PointsPerETH, Decimals, "").Text(),
+		ReferralShareBps: r.ReferralShareBps,
+		Referrals:        r.Referrals,
+		FromBlock:        r.FromBlock,
+		ToBlock:          r.ToBlock,
+	}
+	for _, m := range r.Multipliers {
+		j.Multipliers = append(j.Multipliers, multiplierJSON{MinVolumeETH: amount.New(m.MinVolume, 18, "").Text(), Bps: m.Bps})
+	}
+	if r.SBT != nil {
+		j.SBT = &sbtRuleJSON{Address: r.SBT.Address, BonusBps: r.SBT.BonusBps}
+	}
+	for token, rate := range r.Rates {
+		if j.Rates == nil {
+			j.Rates = make(map[common.Address]string)
+		}
+		j.Rates[token] = rate.RatString()
+	}
+	return json.Marshal(j)
+}
+
+// Hash identifies the rules independently of how the file was formatted.
+// A ledger built under other rules is recomputed from scratch.
+func (r *Rules) Hash() common.Hash {
+	b, err := r.MarshalJSON()
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(b)
+}
+
+// multiplier returns the bps of the highest tier volume reaches.
+func (r *Rules) multiplier(volume *big.Int) uint64 {
+	bps := uint64(amount.BPS)
+	for _, m := range r.Multipliers {
+		if volume.Cmp(m.MinVolume) >= 0 {
+			bps = m.Bps
+		}
+	}
+	return bps
+}
@@ -0,0 +1,57 @@
+package points_test
+
+import (
+	"testing"
+
+	"github.com/primev/fastprotocolapp/contracts-abi/points"
+)
+
+const rulesFile = `{
+  "swapPoints": "10",
+  "pointsPerETH": "100",
+  "multipliers": [{"minVolumeETH": "10", "bps": 20000}, {"minVolumeETH": "1", "bps": 15000}],
+  "rates": {"0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48": "312500000"},
+  "fromBlock": 19000000
+}`
+
+func parse(t *testing.T, raw string) *points.Rules {
+	t.Helper()
+	r, err := points.ParseRules([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestRulesHash(t *testing.T) {
+	base := parse(t, rulesFile)
+	// Formatting, tier order and how a rate is written do not change the hash.
+	same := parse(t, `{"fromBlock": 19000000, "swapPoints": "10.000", "pointsPerETH": "100",
+		"multipliers": [{"minVolumeETH": "1", "bps": 15000}, {"minVolumeETH": "10.0", "bps": 20000}],
+		"rates": {"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48": "625000000/2"}}`)
+	if same.Hash() != base.Hash() {
+		t.Fatal("equivalent rules hash differently")
+	}
+	// A price is a rule: changing it replays the campaign.
+	repriced := parse(t, `{"swapPoints": "10", "pointsPerETH": "100",
+		"multipliers": [{"minVolumeETH": "1", "bps": 15000}, {"minVolumeETH": "10", "bps": 20000}],
+		"rates": {"0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48": "312500001"}, "fromBlock": 19000000}`)
+	if repriced.Hash() == base.Hash() {
+		t.Fatal("changing a rate kept the hash")
+	}
+}
+
+func TestParseRulesErrors(t *testing.T) {
+	for name, raw := range map[string]string{
+		"unknown field":  `{"swapPoints": "1", "pointsPerWETH": "1"}`,
+		"negative rate":  `{"rates": {"0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48": "-1"}}`,
+		"invalid rate":   `{"rates": {"0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48": "one"}}`,
+		"self referral":  `{"referrals": {"0x00000000000000000000000000000000000a11ce": "0x00000000000000000000000000000000000a11ce"}}`,
+		"reversed range": `{"fromBlock": 10, "toBlock": 5}`,
+		"share over 1":   `{"referralShareBps": 10001}`,
+	} {
+		if _, err := points.ParseRules([]byte(raw)); err == nil {
+			t.Errorf("%s: parsed", name)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// Package profit keeps the executor from paying more gas for a settlement
+// than the settlement earns. The executor pays for every executeWithPermit
+// while the surplus goes to the treasury, so a Guard estimates the gas,
+// prices the expected surplus in ETH through an Oracle, and decides whether
+// to submit now, delay until gas is cheaper, or reject.
+//
+//	margin = (surplus in wei − gas × gas price) / (gas × gas price)
+//
+// Decisions are logged and counted in Metrics.
+package profit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/batch"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+)
+
+// Decision is the guard's verdict.
+type Decision string
+
+const (
+	Submit Decision = "submit"
+	Delay  Decision = "delay"
+	Reject Decision = "reject"
+)
+
+// Reasons label decisions in logs and metrics.
+const (
+	ReasonProfitable  = "profitable"
+	ReasonGasPrice    = "gas_price"
+	ReasonBelowMargin = "below_margin"
+	ReasonReverted    = "reverted"
+	ReasonNoPrice     = "no_price"
+	ReasonDeadline    = "deadline"
+	ReasonUnsigned    = "unsigned"
+)
+
+// Config configures a Guard.
+type Config struct {
+	Backend bind.ContractBackend
+	// Settlement is the FastSettlementV3 proxy.
+	Settlement common.Address
+	// From is the executor the settlement is estimated and simulated from:
+	// the executor EOA, or the batch aggregator holding the role.
+	From   common.Address
+	Oracle Oracle
+	// MinMargin is the smallest acceptable margin; 0.1 requires the surplus
+	// to exceed the gas cost by 10%.
+	MinMargin float64
+	// DelayGasPrice is the gas price, in wei, a delayed settlement waits for.
+	// A settlement that misses MinMargin now but would meet it at this price
+	// is delayed rather than rejected. Nil disables delays.
+	DelayGasPrice *big.Int
+	// RetryAfter is how long a delayed settlement waits. Defaults to 12s.
+	RetryAfter time.Duration
+	// MinTimeToDeadline rejects instead of delaying when the intent would
+	// expire before the retry could land. Defaults to 30s.
+	MinTimeToDeadline time.Duration
+	// Logger defaults to slog.Default().
+	Logger  *slog.Logger
+	Metrics *Metrics
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+// Guard evaluates settlements.
+type Guard struct {
+	cfg Config
+}
+
+// NewGuard returns a Guard for cfg.
+func NewGuard(cfg Config) (*Guard, error) {
+	if cfg.Backend == nil || cfg.Oracle == nil {
+		return nil, errors.New("profit: Backend and Oracle are required")
+	}
+	if cfg.RetryAfter == 0 {
+		cfg.RetryAfter = 12 * time.Second
+	}
+	if cfg.MinTimeToDeadline == 0 {
+		cfg.MinTimeToDeadline = 30 * time.Second
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	return &Guard{cfg: cfg}, nil
+}
+
+// Evaluation is the outcome of Evaluate.
+type Evaluation struct {
+	Decision Decision
+	// Reason is one of the Reason* labels.
+	Reason string
+	// Err carries the decoded revert or pricing failure behind a rejection.
+	Err error
+
+	Gas uint64
+	// GasPrice is the effective price paid: base fee plus priority fee.
+	GasPrice *big.Int
+	GasCost  *big.Int
+	// Surplus is in the intent's output token; SurplusWei is its value.
+	Surplus    *big.Int
+	SurplusWei *big.Int
+	Profit     *big.Int
+	Margin     float64
+	// RetryAt is set for Delay.
+	RetryAt time.Time
+}
+
+// Evaluate estimates and simulates the settlement of it at the current gas
+// price and decides what to do with it. Only RPC failures are returned as
+// errors; reverts, unsigned items and missing prices become rejections.
+func (g *Guard) Evaluate(ctx context.Context, it batch.Item) (*Evaluation, error) {
+	ev, err := g.evaluate(ctx, it)
+	if err != nil {
+		return nil, err
+	}
+	g.log(ctx, it, ev)
+	g.cfg.Metrics.observe(ev)
+	return ev, nil
+}
+
+func (g *Guard) evaluate(ctx context.Context, it batch.Item) (*Evaluation, error) {
+	data, err := it.Calldata()
+	if errors.Is(err, batch.ErrUnsigned) {
+		return &Evaluation{Decision: Reject, Reason: ReasonUnsigned, Err: err}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	msg := ethereum.CallMsg{From: g.cfg.From, To: &g.cfg.Settlement, Data: data}
+	gas, err := g.cfg.Backend.EstimateGas(ctx, msg)
+	if err != nil {
+		if rev := reverts.FromError(err); rev != nil {
+			return &Evaluation{Decision: Reject, Reason: ReasonReverted, Err: rev}, nil
+		}
+		return nil, fmt.Errorf("profit: estimate gas: %w", err)
+	}
+	msg.Gas = gas
+	out, err := g.cfg.Backend.CallContract(ctx, msg, nil)
+	if err != nil {
+		if rev := reverts.FromError(err); rev != nil {
+			return &Evaluation{Decision: Reject, Reason: ReasonReverted, Err: rev, Gas: gas}, nil
+		}
+		return nil, fmt.Errorf("profit: simulate: %w", err)
+	}
+	res, err := settlementABI.Unpack("executeWithPermit", out)
+	if err != nil {
+		return nil, fmt.Errorf("profit: decode executeWithPermit result: %w", err)
+	}
+	surplus := res[1].(*big.Int)
+
+	price, err := g.gasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ev := &Evaluation{
+		Gas:      gas,
+		GasPrice: price,
+		GasCost:  new(big.Int).Mul(price, new(big.Int).SetUint64(gas)),
+		Surplus:  surplus,
+	}
+	ev.SurplusWei, err = g.cfg.Oracle.ETHValue(ctx, it.Intent.OutputToken, surplus)
+	if err != nil {
+		ev.Decision, ev.Reason, ev.Err = Reject, ReasonNoPrice, err
+		return ev, nil
+	}
+	ev.Profit = new(big.Int).Sub(ev.SurplusWei, ev.GasCost)
+	ev.Margin = ratio(ev.Profit, ev.GasCost)
+	if ev.Margin >= g.cfg.MinMargin {
+		ev.Decision, ev.Reason = Submit, ReasonProfitable
+		return ev, nil
+	}
+
+	ev.Decision, ev.Reason = Reject, ReasonBelowMargin
+	if g.cfg.DelayGasPrice == nil || g.cfg.DelayGasPrice.Cmp(price) >= 0 {
+		return ev, nil
+	}
+	cheapCost := new(big.Int).Mul(g.cfg.DelayGasPrice, new(big.Int).SetUint64(gas))
+	if ratio(new(big.Int).Sub(ev.SurplusWei, cheapCost), cheapCost) < g.cfg.MinMargin {
+		return ev, nil
+	}
+	retryAt := g.cfg.Now().Add(g.cfg.RetryAfter)
+	if it.Intent.Deadline.Cmp(big.NewInt(retryAt.Add(g.cfg.MinTimeToDeadline).Unix())) < 0 {
+		ev.Reason = ReasonDeadline
+		return ev, nil
+	}
+	ev.Decision, ev.Reason, ev.RetryAt = Delay, ReasonGasPrice, retryAt
+	return ev, nil
+}
+
+// gasPrice returns the latest base fee plus the suggested priority fee.
+func (g *Guard) gasPrice(ctx context.Context) (*big.Int, error) {
+	head, err := g.cfg.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("profit: latest header: %w", err)
+	}
+	tip, err := g.cfg.Backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("profit: suggest tip: %w", err)
+	}
+	price := new(big.Int).Set(tip)
+	if head.BaseFee != nil {
+		price.Add(price, head.BaseFee)
+	}
+	return price, nil
+}
+
+func (g *Guard) log(ctx context.Context, it batch.Item, ev *Evaluation) {
+	attrs := []interface{}{
+		"hash", it.Hash,
+		"decision", ev.Decision,
+		"reason", ev.Reason,
+		"gas", ev.Gas,
+	}
+	if ev.GasCost != nil {
+		attrs = append(attrs, "gasCostWei", ev.GasCost, "surplus", ev.Surplus)
+	}
+	if ev.Profit != nil {
+		attrs = append(attrs, "surplusWei", ev.SurplusWei, "profitWei", ev.Profit, "margin", ev.Margin)
+	}
+	if ev.Err != nil {
+		attrs = append(attrs, "err", ev.Err)
+	}
+	if !ev.RetryAt.IsZero() {
+		attrs = append(attrs, "retryAt", ev.RetryAt)
+	}
+	level := slog.LevelInfo
+	if ev.Decision == Reject {
+		level = slog.LevelWarn
+	}
+	g.cfg.Logger.Log(ctx, level, "settlement decision", attrs...)
+}
+
+// ratio returns num/den, or +Inf for a free settlement.
+func ratio(num, den *big.Int) float64 {
+	if den.Sign() == 0 {
+		if num.Sign() < 0 {
+			return -1
+		}
+		return math.Inf(1)
+	}
+	r, _ := new(big.Rat).SetFrac(num, den).Float64()
+	return r
+}
+
+var settlementABI = func() abi.ABI {
+	parsed, err := fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return *parsed
+}()
@@ -0,0 +1,113 @@
+package profit_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/primev/fastprotocolapp/contracts-abi/batch"
+	"github.com/primev/fastprotocolapp/contracts-abi/profit"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+var (
+	now      = time.Unix(1_800_000_000, 0)
+	proxy    = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	tokenOut = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+	gwei     = big.NewInt(1_000_000_000)
+)
+
+// chain answers the guard's calls: a fixed estimate, an executeWithPermit
+// result of (received, surplus), and a base fee with a 1 gwei tip.
+type chain struct {
+	bind.ContractBackend
+	gas     uint64
+	surplus *big.Int
+	baseFee *big.Int
+	calls   int
+}
+
+func (c *chain) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	c.calls++
+	return c.gas, nil
+}
+
+func (c *chain) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return append(common.LeftPadBytes(c.surplus.Bytes(), 32), common.LeftPadBytes(c.surplus.Bytes(), 32)...), nil
+}
+
+func (c *chain) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{BaseFee: c.baseFee}, nil
+}
+
+func (c *chain) SuggestGasTipCap(context.Context) (*big.Int, error) { return gwei, nil }
+
+func item(signed bool) batch.Item {
+	it := batch.Item{
+		Intent: settlement.Intent{
+			OutputToken: tokenOut,
+			InputAmt:    big.NewInt(1),
+			UserAmtOut:  big.NewInt(1),
+			Deadline:    big.NewInt(now.Unix() + 3600),
+			Nonce:       big.NewInt(1),
+		},
+		SwapCall: settlement.SwapCall{Value: new(big.Int)},
+	}
+	if signed {
+		it.Signature = make([]byte, 65)
+	}
+	return it
+}
+
+func TestEvaluate(t *testing.T) {
+	// Gas costs 100000 × (base fee + 1 gwei); the surplus is worth 1e15 wei.
+	surplus := new(big.Int).Mul(big.NewInt(1_000_000), gwei)
+	tests := []struct {
+		name     string
+		signed   bool
+		baseFee  int64
+		delay    *big.Int
+		decision profit.Decision
+		reason   string
+	}{
+		{"profitable", true, 1, nil, profit.Submit, profit.ReasonProfitable},
+		{"below margin", true, 19, nil, profit.Reject, profit.ReasonBelowMargin},
+		{"delayed", true, 19, big.NewInt(2_000_000_000), profit.Delay, profit.ReasonGasPrice},
+		{"unsigned", false, 1, nil, profit.Reject, profit.ReasonUnsigned},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &chain{gas: 100_000, surplus: surplus, baseFee: new(big.Int).Mul(big.NewInt(tt.baseFee), gwei)}
+			g, err := profit.NewGuard(profit.Config{
+				Backend:       c,
+				Settlement:    proxy,
+				Oracle:        profit.NewStaticOracle(map[common.Address]*big.Rat{tokenOut: big.NewRat(1, 1)}),
+				MinMargin:     0.1,
+				DelayGasPrice: tt.delay,
+				Logger:        slog.New(slog.NewTextHandler(io.Discard, nil)),
+				Now:           func() time.Time { return now },
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			ev, err := g.Evaluate(context.Background(), item(tt.signed))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ev.Decision != tt.decision || ev.Reason != tt.reason {
+				t.Fatalf("%s/%s, want %s/%s (margin %v)", ev.Decision, ev.Reason, tt.decision, tt.reason, ev.Margin)
+			}
+			if !tt.signed && (c.calls != 0 || !errors.Is(ev.Err, batch.ErrUnsigned)) {
+				t.Fatalf("unsigned item: %d estimates, err %v", c.calls, ev.Err)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package profit
+
+import (
+	"math/big"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics exposes guard decisions to Prometheus.
+type Metrics struct {
+	Decisions *prometheus.CounterVec
+	GasUsed   prometheus.Histogram
+	// Margin is (surplus − gas cost) / gas cost per evaluated intent.
+	Margin prometheus.Histogram
+	// ProfitWei is the cumulative expected profit of submitted intents.
+	ProfitWei prometheus.Counter
+}
+
+// NewMetrics creates the guard's collectors and registers them with reg when
+// it is non-nil.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		Decisions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "profit",
+			Name:      "decisions_total",
+			Help:      "Guard decisions by outcome and reason.",
+		}, []string{"decision", "reason"}),
+		GasUsed: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "profit",
+			Name:      "gas_estimate",
+			Help:      "Estimated gas of evaluated settlements.",
+			Buckets:   prometheus.LinearBuckets(100_000, 100_000, 10),
+		}),
+		Margin: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "profit",
+			Name:      "margin_ratio",
+			Help:      "Expected surplus over gas cost, minus one.",
+			Buckets:   []float64{-1, -0.5, -0.25, 0, 0.1, 0.25, 0.5, 1, 2, 5, 10},
+		}),
+		ProfitWei: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "profit",
+			Name:      "expected_profit_wei_total",
+			Help:      "Expected surplus minus gas cost of submitted settlements, in wei.",
+		}),
+	}
+	if reg != nil {
+		reg.MustRegister(m.Decisions, m.GasUsed, m.Margin, m.ProfitWei)
+	}
+	return m
+}
+
+func (m *Metrics) observe(e *Evaluation) {
+	if m == nil {
+		return
+	}
+	m.Decisions.WithLabelValues(string(e.Decision), e.Reason).Inc()
+	if e.Gas > 0 {
+		m.GasUsed.Observe(float64(e.Gas))
+	}
+	if e.Profit != nil {
+		m.Margin.Observe(e.Margin)
+	}
+	if e.Decision == Submit && e.Profit.Sign() > 0 {
+		f, _ := new(big.Float).SetInt(e.Profit).Float64()
+		m.ProfitWei.Add(f)
+	}
+}
@@ -0,0 +1,60 @@
+package profit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrNoPrice is returned by an Oracle that cannot price a token.
+var ErrNoPrice = errors.New("profit: no price for token")
+
+// Oracle values token amounts in wei.
+type Oracle interface {
+	ETHValue(ctx context.Context, token common.Address, amount *big.Int) (*big.Int, error)
+}
+
+// StaticOracle prices tokens at fixed rates: wei per token base unit. ETH
+// (the zero address) is always priced at 1; WETH must be listed like any
+// other token.
+type StaticOracle struct {
+	mu    sync.RWMutex
+	rates map[common.Address]*big.Rat
+}
+
+var _ Oracle = (*StaticOracle)(nil)
+
+// NewStaticOracle returns an oracle with the given rates.
+func NewStaticOracle(rates map[common.Address]*big.Rat) *StaticOracle {
+	o := &StaticOracle{rates: map[common.Address]*big.Rat{}}
+	for t, r := range rates {
+		o.Set(t, r)
+	}
+	return o
+}
+
+// Set updates a token's rate in wei per base unit.
+func (o *StaticOracle) Set(token common.Address, weiPerUnit *big.Rat) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.rates[token] = new(big.Rat).Set(weiPerUnit)
+}
+
+// ETHValue implements Oracle, rounding down.
+func (o *StaticOracle) ETHValue(_ context.Context, token common.Address, amount *big.Int) (*big.Int, error) {
+	if token == (common.Address{}) {
+		return new(big.Int).Set(amount), nil
+	}
+	o.mu.RLock()
+	rate, ok := o.rates[token]
+	o.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrNoPrice, token)
+	}
+	v := new(big.Rat).Mul(rate, new(big.Rat).SetInt(amount))
+	return new(big.Int).Quo(v.Num(), v.Denom()), nil
+}
@@ -0,0 +1,201 @@
+package rescue
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+)
+
+// TxRequest is an unsigned call for From to send.
+type TxRequest struct {
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	Value       *big.Int       `json:"value,omitempty"`
+	Data        hexutil.Bytes  `json:"data,omitempty"`
+	Description string         `json:"description"`
+}
+
+// Refund is an amount owed to one beneficiary.
+type Refund struct {
+	Beneficiary common.Address `json:"beneficiary"`
+	Amount      *big.Int       `json:"amount"`
+	Reason      Reason         `json:"reason"`
+	// TxHashes are the transactions that left the funds behind.
+	TxHashes []common.Hash `json:"txHashes"`
+}
+
+// TokenPlan is the rescue of one token.
+type TokenPlan struct {
+	Token       common.Address `json:"token"`
+	Balance     *big.Int       `json:"balance"`
+	Unexplained *big.Int       `json:"unexplained"`
+	Refunds     []Refund       `json:"refunds"`
+	// Rescue moves the whole balance to the owner; Transfers then pay each
+	// refund from the owner. Unexplained funds stay with the owner.
+	Rescue    *TxRequest  `json:"rescue,omitempty"`
+	Transfers []TxRequest `json:"transfers,omitempty"`
+	Notes     []string    `json:"notes,omitempty"`
+}
+
+// Plan is a reviewable set of transactions returning stranded funds.
+type Plan struct {
+	Settlement common.Address `json:"settlement"`
+	Owner      common.Address `json:"owner"`
+	FromBlock  uint64         `json:"fromBlock"`
+	ToBlock    uint64         `json:"toBlock"`
+	Tokens     []*TokenPlan   `json:"tokens"`
+}
+
+// BuildPlan drafts, for every token with a balance, the owner's
+// rescueTokens call and one transfer per beneficiary of its outstanding
+// leftovers. Unattributed leftovers get no transfer and are listed in the
+// token's notes for a reviewer to decide.
+func BuildPlan(r *Report, owner common.Address) (*Plan, error) {
+	p := &Plan{Settlement: r.Settlement, Owner: owner, FromBlock: r.FromBlock, ToBlock: r.ToBlock}
+	for _, tr := range r.Tokens {
+		if tr.Balance.Sign() == 0 {
+			continue
+		}
+		tp := &TokenPlan{
+			Token:       tr.Token,
+			Balance:     tr.Balance,
+			Unexplained: tr.Unexplained,
+			Notes:       append([]string(nil), tr.Notes...),
+		}
+		data, err := pack("rescueTokens", tr.Token, tr.Balance)
+		if err != nil {
+			return nil, err
+		}
+		tp.Rescue = &TxRequest{
+			From:        owner,
+			To:          r.Settlement,
+			Data:        data,
+			Description: fmt.Sprintf("rescueTokens(%s, %s)", tokenName(tr.Token), tr.Balance),
+		}
+
+		tp.Refunds = refunds(tr)
+		for _, rf := range tp.Refunds {
+			if rf.Beneficiary == (common.Address{}) {
+				tp.Notes = append(tp.Notes, fmt.Sprintf("%s of %s is unattributed (txs %v); review before transferring", rf.Amount, tokenName(tr.Token), rf.TxHashes))
+				continue
+			}
+			tx, err := transfer(owner, tr.Token, rf)
+			if err != nil {
+				return nil, err
+			}
+			tp.Transfers = append(tp.Transfers, tx)
+		}
+		if tr.Unexplained.Sign() > 0 {
+			tp.Notes = append(tp.Notes, fmt.Sprintf("%s of %s is unexplained by the scanned range and stays with the owner", tr.Unexplained, tokenName(tr.Token)))
+		}
+		p.Tokens = append(p.Tokens, tp)
+	}
+	return p, nil
+}
+
+// refunds groups outstanding leftovers by beneficiary and reason, capping
+// the total at the attributed amount, newest leftovers trimmed first.
+func refunds(tr *TokenReport) []Refund {
+	budget := new(big.Int).Set(tr.Attributed)
+	type key struct {
+		who    common.Address
+		reason Reason
+	}
+	byKey := make(map[key]*Refund)
+	var order []key
+	for _, lo := range tr.Leftovers {
+		if lo.Outstanding.Sign() == 0 || budget.Sign() == 0 {
+			continue
+		}
+		amt := new(big.Int).Set(lo.Outstanding)
+		if amt.Cmp(budget) > 0 {
+			amt.Set(budget)
+		}
+		budget.Sub(budget, amt)
+		k := key{lo.Beneficiary, lo.Reason}
+		rf := byKey[k]
+		if rf == nil {
+			rf = &Refund{Beneficiary: lo.Beneficiary, Amount: new(big.Int), Reason: lo.Reason}
+			byKey[k] = rf
+			order = append(order, k)
+		}
+		rf.Amount.Add(rf.Amount, amt)
+		rf.TxHashes = append(rf.TxHashes, lo.TxHash)
+	}
+	out := make([]Refund, 0, len(order))
+	for _, k := range order {
+		out = append(out, *byKey[k])
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Amount.Cmp(out[j].Amount) > 0 })
+	return out
+}
+
+func transfer(owner, token common.Address, rf Refund) (TxRequest, error) {
+	desc := fmt.Sprintf("return %s %s to %s (%s)", rf.Amount, tokenName(token), rf.Beneficiary, rf.Reason)
+	if token == (common.Address{}) {
+		return TxRequest{From: owner, To: rf.Beneficiary, Value: rf.Amount, Description: desc}, nil
+	}
+	data, err := erc20ABI.Pack("transfer", rf.Beneficiary, rf.Amount)
+	if err != nil {
+		return TxRequest{}, err
+	}
+	return TxRequest{From: owner, To: token, Data: data, Description: desc}, nil
+}
+
+func pack(method string, args ...interface{}) ([]byte, error) {
+	parsed, err := fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack(method, args...)
+}
+
+func tokenName(token common.Address) string {
+	if token == (common.Address{}) {
+		return "ETH"
+	}
+	return token.Hex()
+}
+
+// WriteText writes the plan for human review.
+func (p *Plan) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "Rescue plan for %s, blocks %d-%d, owner %s\n", p.Settlement, p.FromBlock, p.ToBlock, p.Owner)
+	if len(p.Tokens) == 0 {
+		_, err := fmt.Fprintln(w, "\nNo stranded balances.")
+		return err
+	}
+	step := 1
+	for _, tp := range p.Tokens {
+		fmt.Fprintf(w, "\n%s: balance %s, unexplained %s\n", tokenName(tp.Token), tp.Balance, tp.Unexplained)
+		for _, rf := range tp.Refunds {
+			who := rf.Beneficiary.Hex()
+			if rf.Beneficiary == (common.Address{}) {
+				who = "UNATTRIBUTED"
+			}
+			fmt.Fprintf(w, "  owed %s to %s (%s) from %d tx(s)\n", rf.Amount, who, rf.Reason, len(rf.TxHashes))
+			for _, h := range rf.TxHashes {
+				fmt.Fprintf(w, "    %s\n", h)
+			}
+		}
+		for _, tx := range append([]TxRequest{*tp.Rescue}, tp.Transfers...) {
+			fmt.Fprintf(w, "  %d. %s\n     from %s to %s", step, tx.Description, tx.From, tx.To)
+			if tx.Value != nil {
+				fmt.Fprintf(w, " value %s", tx.Value)
+			}
+			if len(tx.Data) > 0 {
+				fmt.Fprintf(w, "\n     data %s", tx.Data)
+			}
+			fmt.Fprintln(w)
+			step++
+		}
+		for _, n := range tp.Notes {
+			fmt.Fprintf(w, "  note: %s\n", n)
+		}
+	}
+	return nil
+}
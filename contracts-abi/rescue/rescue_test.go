@@ -0,0 +1,206 @@
+package rescue_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	ierc20token "github.com/primev/fastprotocolapp/contracts-abi/clients/IERC20Token"
+	"github.com/primev/fastprotocolapp/contracts-abi/rescue"
+	"github.com/primev/fastprotocolapp/contracts-abi/testharness"
+)
+
+var (
+	proxy    = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	owner    = common.HexToAddress("0x0000000000000000000000000000000000000a0a")
+	token    = common.HexToAddress("0x000000000000000000000000000000000000000a")
+	alice    = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	treasury = common.HexToAddress("0x0000000000000000000000000000000000007e57")
+)
+
+func leftover(n byte, who common.Address, reason rescue.Reason, outstanding int64) *rescue.Leftover {
+	return &rescue.Leftover{
+		Token:       token,
+		TxHash:      common.Hash{n},
+		Amount:      big.NewInt(outstanding),
+		Beneficiary: who,
+		Reason:      reason,
+		Outstanding: big.NewInt(outstanding),
+	}
+}
+
+func TestBuildPlan(t *testing.T) {
+	r := &rescue.Report{
+		Settlement: proxy,
+		Tokens: []*rescue.TokenReport{
+			{Token: common.Address{}, Balance: new(big.Int), Attributed: new(big.Int), Unexplained: new(big.Int)},
+			{
+				Token:   token,
+				Balance: big.NewInt(100),
+				Leftovers: []*rescue.Leftover{
+					leftover(1, alice, rescue.UnrefundedInput, 10),
+					leftover(2, treasury, rescue.UnpaidSurplus, 40),
+					leftover(3, alice, rescue.UnrefundedInput, 20),
+					leftover(4, common.Address{}, rescue.Unattributed, 5),
+				},
+				Attributed:  big.NewInt(75),
+				Unexplained: big.NewInt(25),
+			},
+		},
+	}
+	p, err := rescue.BuildPlan(r, owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Tokens) != 1 {
+		t.Fatalf("%d token plans, want 1: empty balances are skipped", len(p.Tokens))
+	}
+	tp := p.Tokens[0]
+
+	settlementABI, _ := fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	want, _ := settlementABI.Pack("rescueTokens", token, big.NewInt(100))
+	if tp.Rescue.From != owner || tp.Rescue.To != proxy || !bytes.Equal(tp.Rescue.Data, want) {
+		t.Errorf("rescue %+v", tp.Rescue)
+	}
+
+	// Refunds group by beneficiary and reason, largest first.
+	for i, w := range []struct {
+		who    common.Address
+		amount int64
+		txs    int
+	}{
+		{treasury, 40, 1},
+		{alice, 30, 2},
+		{common.Address{}, 5, 1},
+	} {
+		rf := tp.Refunds[i]
+		if rf.Beneficiary != w.who || rf.Amount.Int64() != w.amount || len(rf.TxHashes) != w.txs {
+			t.Errorf("refund %d: %s %s from %d txs, want %s %d from %d", i, rf.Beneficiary, rf.Amount, len(rf.TxHashes), w.who, w.amount, w.txs)
+		}
+	}
+
+	// The unattributed refund gets a note, not a transfer.
+	erc20ABI, _ := ierc20token.Ierc20tokenMetaData.GetAbi()
+	if len(tp.Transfers) != 2 {
+		t.Fatalf("%d transfers, want 2", len(tp.Transfers))
+	}
+	want, _ = erc20ABI.Pack("transfer", alice, big.NewInt(30))
+	if tx := tp.Transfers[1]; tx.From != owner || tx.To != token || !bytes.Equal(tx.Data, want) {
+		t.Errorf("transfer %+v", tx)
+	}
+	notes := strings.Join(tp.Notes, "\n")
+	if !strings.Contains(notes, "unattributed") || !strings.Contains(notes, "25 of") {
+		t.Errorf("notes %q, want the unattributed and unexplained amounts", notes)
+	}
+
+	var out strings.Builder
+	if err := p.WriteText(&out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "UNATTRIBUTED") || !strings.Contains(out.String(), "3. return 30") {
+		t.Errorf("text:\n%s", out.String())
+	}
+}
+
+func TestBuildPlanCapsRefunds(t *testing.T) {
+	// Leftovers exceed the balance, as with a fee-on-transfer token; the
+	// newest are trimmed.
+	r := &rescue.Report{
+		Settlement: proxy,
+		Tokens: []*rescue.TokenReport{{
+			Token:       token,
+			Balance:     big.NewInt(15),
+			Leftovers:   []*rescue.Leftover{leftover(1, alice, rescue.UnrefundedInput, 10), leftover(2, treasury, rescue.UnpaidSurplus, 10)},
+			Attributed:  big.NewInt(15),
+			Unexplained: new(big.Int),
+		}},
+	}
+	p, err := rescue.BuildPlan(r, owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	refunds := p.Tokens[0].Refunds
+	if len(refunds) != 2 || refunds[0].Beneficiary != alice || refunds[0].Amount.Int64() != 10 || refunds[1].Amount.Int64() != 5 {
+		t.Fatalf("refunds %+v, want 10 to alice then 5 to the treasury", refunds)
+	}
+}
+
+// TestScan strands tokens in the harness settlement: a direct transfer from
+// the user, partly rescued, and a mint before the scanned range.
+func TestScan(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{})
+	ctx := context.Background()
+	erc20, _ := h.Artifact("HarnessERC20")
+
+	if err := h.Mint(h.TokenOut, h.Proxy, big.NewInt(7)); err != nil {
+		t.Fatal(err)
+	}
+	head, err := h.Client.BlockNumber(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := head + 1
+
+	receipt, err := h.Transact(h.User, erc20, h.TokenIn, "transfer", h.Proxy, big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A settlement leaves balances unchanged.
+	amount := testharness.Ether
+	intent, err := h.NewIntent(h.TokenIn, h.TokenOut, amount, amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := h.SignIntent(h.User, intent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call, err := h.SwapCall(h.TokenIn, amount, h.TokenOut, amount, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.ExecuteWithPermit(intent, sig, call); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Mine(h.Settlement.RescueTokens(h.Opts(h.Owner), h.TokenIn, big.NewInt(2))); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := rescue.Scan(ctx, h.Client, rescue.Config{
+		Settlement: h.Proxy,
+		Treasury:   h.Treasury.Address,
+		WETH:       h.WETH,
+		Tokens:     []common.Address{h.TokenIn, h.TokenOut, {}},
+		FromBlock:  from,
+		ChunkSize:  2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	in, out, eth := r.Tokens[0], r.Tokens[1], r.Tokens[2]
+	if in.Balance.Int64() != 3 || len(in.Leftovers) != 1 || in.Unexplained.Sign() != 0 {
+		t.Fatalf("TokenIn report %+v", in)
+	}
+	lo := in.Leftovers[0]
+	if lo.TxHash != receipt.TxHash || lo.Beneficiary != h.User.Address || lo.Reason != rescue.DirectTransfer || lo.Outstanding.Int64() != 3 {
+		t.Errorf("leftover %+v, want the user's transfer with 3 outstanding", lo)
+	}
+	if out.Balance.Int64() != 7 || len(out.Leftovers) != 0 || out.Unexplained.Int64() != 7 {
+		t.Errorf("TokenOut report %+v, want 7 unexplained", out)
+	}
+	if len(eth.Notes) == 0 {
+		t.Error("ETH report has no note")
+	}
+
+	p, err := rescue.BuildPlan(r, h.Owner.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Tokens) != 2 || len(p.Tokens[0].Transfers) != 1 || len(p.Tokens[1].Transfers) != 0 {
+		t.Fatalf("plan %+v, want one transfer back to the user", p.Tokens)
+	}
+}
@@ -0,0 +1,382 @@
+// Package rescue finds funds stranded in the settlement contract, attributes
+// them to the settlements that left them behind, and drafts a reviewable
+// plan of rescueTokens calls and follow-up transfers to the affected users.
+//
+// _execute refunds unused input and pays out the user and treasury within
+// the transaction, so a settlement should leave the contract's balances
+// unchanged. Scan replays the ERC-20 Transfer logs into and out of the
+// contract (and WETH Deposit/Withdrawal, since executeWithETH wraps without
+// a Transfer) and computes each transaction's net balance change per token.
+// A transaction that left a positive balance is a Leftover, attributed
+// through the transaction's IntentExecuted logs:
+//
+//   - input token of an intent: unrefunded input, owed to the intent's user;
+//   - output token of an intent: undistributed surplus, owed to the treasury;
+//   - no IntentExecuted: a direct transfer, owed back to the sender.
+//
+// Later transactions that drain the contract (a rescue, or a settlement
+// paying out more than it received) consume leftovers oldest first. What
+// the replay cannot explain, such as ETH or history before the scanned
+// range, is reported as Unexplained and left to the reviewer.
+package rescue
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	ierc20token "github.com/primev/fastprotocolapp/contracts-abi/clients/IERC20Token"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+var (
+	erc20ABI = func() *abi.ABI {
+		parsed, err := ierc20token.Ierc20tokenMetaData.GetAbi()
+		if err != nil {
+			panic(err)
+		}
+		return parsed
+	}()
+
+	transferTopic   = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	depositTopic    = crypto.Keccak256Hash([]byte("Deposit(address,uint256)"))
+	withdrawalTopic = crypto.Keccak256Hash([]byte("Withdrawal(address,uint256)"))
+)
+
+// Reason explains a Leftover's attribution.
+type Reason string
+
+const (
+	UnrefundedInput Reason = "unrefunded-input"
+	UnpaidSurplus   Reason = "undistributed-surplus"
+	DirectTransfer  Reason = "direct-transfer"
+	// Unattributed leftovers need a reviewer: the transaction settled
+	// intents, but none of them involved the token, or several did for
+	// different users.
+	Unattributed Reason = "unattributed"
+)
+
+// Backend is the chain access Scan needs; ethclient.Client satisfies it.
+type Backend interface {
+	bind.ContractCaller
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Config configures a Scan.
+type Config struct {
+	// Settlement is the FastSettlementV3 proxy.
+	Settlement common.Address
+	// Treasury receives undistributed surplus.
+	Treasury common.Address
+	// WETH is the contract's WETH, whose Deposit and Withdrawal logs are
+	// replayed alongside its Transfers.
+	WETH common.Address
+	// Tokens to scan. The zero address is ETH, which has no logs, so its
+	// whole balance is Unexplained.
+	Tokens []common.Address
+	// FromBlock should be the settlement's deployment block; leftovers from
+	// before it are Unexplained.
+	FromBlock uint64
+	// ToBlock defaults to the head.
+	ToBlock *uint64
+	// ChunkSize is the block span of each log query. Defaults to
+	// settlement.DefaultChunkSize.
+	ChunkSize uint64
+}
+
+// Leftover is a transaction's net positive balance change of one token.
+type Leftover struct {
+	Token       common.Address `json:"token"`
+	TxHash      common.Hash    `json:"txHash"`
+	Block       uint64         `json:"block"`
+	Amount      *big.Int       `json:"amount"`
+	Beneficiary common.Address `json:"beneficiary"`
+	Reason      Reason         `json:"reason"`
+	// Outstanding is what remains after later drains.
+	Outstanding *big.Int `json:"outstanding"`
+	// Intents holds the transaction's IntentExecuted logs.
+	Intents []*settlement.IntentExecuted `json:"-"`
+}
+
+// TokenReport is the scan result for one token.
+type TokenReport struct {
+	Token   common.Address `json:"token"`
+	Balance *big.Int       `json:"balance"`
+	// Leftovers are in chain order, including fully drained ones.
+	Leftovers []*Leftover `json:"leftovers"`
+	// Attributed is the sum of outstanding leftovers, capped at Balance.
+	Attributed *big.Int `json:"attributed"`
+	// Unexplained is Balance minus Attributed.
+	Unexplained *big.Int `json:"unexplained"`
+	Notes       []string `json:"notes,omitempty"`
+}
+
+// Report is the result of a Scan.
+type Report struct {
+	Settlement common.Address `json:"settlement"`
+	FromBlock  uint64         `json:"fromBlock"`
+	ToBlock    uint64         `json:"toBlock"`
+	Tokens     []*TokenReport `json:"tokens"`
+}
+
+// delta is one log's effect on the settlement's balance.
+type delta struct {
+	token  common.Address
+	tx     common.Hash
+	block  uint64
+	index  uint
+	amount *big.Int
+	// from is the sender of an incoming Transfer.
+	from common.Address
+}
+
+// Scan reads balances and replays logs over cfg's block range.
+func Scan(ctx context.Context, backend Backend, cfg Config) (*Report, error) {
+	var to uint64
+	if cfg.ToBlock != nil {
+		to = *cfg.ToBlock
+	} else {
+		head, err := backend.BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		to = head
+	}
+	report := &Report{Settlement: cfg.Settlement, FromBlock: cfg.FromBlock, ToBlock: to}
+
+	var tokens []common.Address
+	for _, t := range cfg.Tokens {
+		if t != (common.Address{}) {
+			tokens = append(tokens, t)
+		}
+	}
+	deltas, executed, err := replay(ctx, backend, cfg, tokens, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range cfg.Tokens {
+		bal, err := balance(ctx, backend, cfg.Settlement, token, to)
+		if err != nil {
+			return nil, fmt.Errorf("rescue: balance of %s: %w", token, err)
+		}
+		tr := &TokenReport{Token: token, Balance: bal}
+		if token == (common.Address{}) {
+			tr.Notes = append(tr.Notes, "ETH moves without logs; its balance cannot be attributed")
+		} else {
+			attribute(tr, deltas[token], executed, cfg)
+		}
+		reconcile(tr)
+		report.Tokens = append(report.Tokens, tr)
+	}
+	return report, nil
+}
+
+// replay collects the settlement's token balance changes and IntentExecuted
+// logs over [cfg.FromBlock, to].
+func replay(ctx context.Context, backend Backend, cfg Config, tokens []common.Address, to uint64) (map[common.Address][]delta, map[common.Hash][]*settlement.IntentExecuted, error) {
+	parser, err := settlement.NewV3(cfg.Settlement, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	holder := common.BytesToHash(cfg.Settlement.Bytes())
+	deltas := make(map[common.Address][]delta)
+	executed := make(map[common.Hash][]*settlement.IntentExecuted)
+
+	queries := []ethereum.FilterQuery{
+		{Addresses: []common.Address{cfg.Settlement}, Topics: [][]common.Hash{{settlement.IntentExecutedTopic}}},
+	}
+	if len(tokens) > 0 {
+		queries = append(queries,
+			ethereum.FilterQuery{Addresses: tokens, Topics: [][]common.Hash{{transferTopic}, nil, {holder}}},
+			ethereum.FilterQuery{Addresses: tokens, Topics: [][]common.Hash{{transferTopic}, {holder}}},
+		)
+	}
+	if cfg.WETH != (common.Address{}) && contains(tokens, cfg.WETH) {
+		queries = append(queries, ethereum.FilterQuery{
+			Addresses: []common.Address{cfg.WETH},
+			Topics:    [][]common.Hash{{depositTopic, withdrawalTopic}, {holder}},
+		})
+	}
+	err = settlement.ReadLogs(ctx, backend, cfg.FromBlock, to, cfg.ChunkSize, queries, func(l types.Log) error {
+		if l.Address == cfg.Settlement {
+			ev, err := parser.ParseIntentExecuted(l)
+			if err != nil {
+				return err
+			}
+			executed[l.TxHash] = append(executed[l.TxHash], ev)
+			return nil
+		}
+		if d, ok := decode(l, cfg.Settlement); ok {
+			deltas[d.token] = append(deltas[d.token], d)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for token, ds := range deltas {
+		sort.Slice(ds, func(i, j int) bool {
+			if ds[i].block != ds[j].block {
+				return ds[i].block < ds[j].block
+			}
+			return ds[i].index < ds[j].index
+		})
+		deltas[token] = ds
+	}
+	return deltas, executed, nil
+}
+
+// decode turns a Transfer, Deposit or Withdrawal log into a signed balance
+// change of holder.
+func decode(l types.Log, holder common.Address) (delta, bool) {
+	if len(l.Topics) == 0 || len(l.Data) < 32 {
+		return delta{}, false
+	}
+	d := delta{token: l.Address, tx: l.TxHash, block: l.BlockNumber, index: l.Index}
+	amount := new(big.Int).SetBytes(l.Data[:32])
+	switch {
+	case l.Topics[0] == transferTopic && len(l.Topics) == 3:
+		src, dst := common.BytesToAddress(l.Topics[1].Bytes()), common.BytesToAddress(l.Topics[2].Bytes())
+		switch {
+		case src == dst:
+			return delta{}, false
+		case dst == holder:
+			d.amount, d.from = amount, src
+		case src == holder:
+			d.amount = amount.Neg(amount)
+		default:
+			return delta{}, false
+		}
+	case l.Topics[0] == depositTopic && len(l.Topics) == 2:
+		d.amount = amount
+	case l.Topics[0] == withdrawalTopic && len(l.Topics) == 2:
+		d.amount = amount.Neg(amount)
+	default:
+		return delta{}, false
+	}
+	return d, true
+}
+
+// attribute nets deltas per transaction into leftovers and drains.
+func attribute(tr *TokenReport, deltas []delta, executed map[common.Hash][]*settlement.IntentExecuted, cfg Config) {
+	for i := 0; i < len(deltas); {
+		tx := deltas[i].tx
+		net := new(big.Int)
+		senders := make(map[common.Address]bool)
+		j := i
+		for ; j < len(deltas) && deltas[j].tx == tx; j++ {
+			net.Add(net, deltas[j].amount)
+			if deltas[j].amount.Sign() > 0 {
+				senders[deltas[j].from] = true
+			}
+		}
+		block := deltas[i].block
+		i = j
+		switch net.Sign() {
+		case 0:
+			continue
+		case -1:
+			drain(tr, new(big.Int).Neg(net))
+			continue
+		}
+		lo := &Leftover{Token: tr.Token, TxHash: tx, Block: block, Amount: net, Outstanding: new(big.Int).Set(net), Intents: executed[tx]}
+		lo.Beneficiary, lo.Reason = beneficiary(tr.Token, lo.Intents, senders, cfg)
+		tr.Leftovers = append(tr.Leftovers, lo)
+	}
+}
+
+func beneficiary(token common.Address, intents []*settlement.IntentExecuted, senders map[common.Address]bool, cfg Config) (common.Address, Reason) {
+	if len(intents) == 0 {
+		if len(senders) == 1 {
+			for s := range senders {
+				return s, DirectTransfer
+			}
+		}
+		return common.Address{}, Unattributed
+	}
+	users := make(map[common.Address]bool)
+	surplus := false
+	for _, ev := range intents {
+		input := ev.InputToken
+		if input == (common.Address{}) {
+			// executeWithETH swaps WETH.
+			input = cfg.WETH
+		}
+		if input == token {
+			users[ev.User] = true
+		}
+		if ev.OutputToken == token {
+			surplus = true
+		}
+	}
+	switch {
+	case len(users) == 1:
+		for u := range users {
+			return u, UnrefundedInput
+		}
+	case len(users) == 0 && surplus && cfg.Treasury != (common.Address{}):
+		return cfg.Treasury, UnpaidSurplus
+	}
+	return common.Address{}, Unattributed
+}
+
+// drain consumes outstanding leftovers oldest first.
+func drain(tr *TokenReport, amount *big.Int) {
+	for _, lo := range tr.Leftovers {
+		if amount.Sign() == 0 {
+			return
+		}
+		take := lo.Outstanding
+		if take.Cmp(amount) > 0 {
+			take = amount
+		}
+		take = new(big.Int).Set(take)
+		lo.Outstanding.Sub(lo.Outstanding, take)
+		amount.Sub(amount, take)
+	}
+}
+
+// reconcile compares outstanding leftovers with the actual balance.
+func reconcile(tr *TokenReport) {
+	outstanding := new(big.Int)
+	for _, lo := range tr.Leftovers {
+		outstanding.Add(outstanding, lo.Outstanding)
+	}
+	tr.Attributed = outstanding
+	if outstanding.Cmp(tr.Balance) > 0 {
+		tr.Notes = append(tr.Notes, fmt.Sprintf("leftovers total %s but the balance is only %s; a token with transfer fees or rebasing can cause this", outstanding, tr.Balance))
+		tr.Attributed = new(big.Int).Set(tr.Balance)
+	}
+	tr.Unexplained = new(big.Int).Sub(tr.Balance, tr.Attributed)
+}
+
+func balance(ctx context.Context, backend Backend, holder, token common.Address, block uint64) (*big.Int, error) {
+	at := new(big.Int).SetUint64(block)
+	if token == (common.Address{}) {
+		return backend.BalanceAt(ctx, holder, at)
+	}
+	c, err := ierc20token.NewIerc20tokenCaller(token, backend)
+	if err != nil {
+		return nil, err
+	}
+	return c.BalanceOf(&bind.CallOpts{Context: ctx, BlockNumber: at}, holder)
+}
+
+func contains(list []common.Address, addr common.Address) bool {
+	for _, a := range list {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
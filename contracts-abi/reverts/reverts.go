@@ -0,0 +1,216 @@
+// Package reverts decodes EVM revert data returned by eth_call and
+// eth_estimateGas into named custom errors from the settlement ABIs.
+package reverts
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+)
+
+var (
+	errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0} // Error(string)
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71} // Panic(uint256)
+)
+
+// Error is a decoded revert.
+type Error struct {
+	// Name is the Solidity error name, "Error" for require/revert strings,
+	// "Panic" for assertion failures, or "" when the selector is unknown.
+	Name string
+	// Selector is the 4-byte error selector, zero for an empty revert.
+	Selector [4]byte
+	// Args holds the decoded error arguments in declaration order.
+	Args []interface{}
+	// Data is the raw revert payload.
+	Data []byte
+}
+
+func (e *Error) Error() string {
+	switch {
+	case e.Name == "" && len(e.Data) == 0:
+		return "execution reverted"
+	case e.Name == "":
+		return fmt.Sprintf("execution reverted: unknown error %s", hexutil.Encode(e.Data))
+	case len(e.Args) == 0:
+		return fmt.Sprintf("execution reverted: %s()", e.Name)
+	default:
+		args := make([]string, len(e.Args))
+		for i, a := range e.Args {
+			args[i] = fmt.Sprint(a)
+		}
+		return fmt.Sprintf("execution reverted: %s(%s)", e.Name, strings.Join(args, ", "))
+	}
+}
+
+// Is reports whether e is the named custom error, so callers can write
+// errors.Is(err, reverts.Named("InsufficientOut")).
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	if t.Name != "" {
+		return t.Name == e.Name
+	}
+	return bytes.Equal(t.Data, e.Data)
+}
+
+// Named returns a sentinel matching any decoded revert with the given name.
+func Named(name string) *Error { return &Error{Name: name} }
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[[4]byte]abi.Error{}
+)
+
+// permit2ErrorsABI lists the SignatureTransfer errors Permit2 raises from
+// inside executeWithPermit.
+const permit2ErrorsABI = `[
+	{"type":"error","name":"InvalidNonce","inputs":[]},
+	{"type":"error","name":"InvalidSigner","inputs":[]},
+	{"type":"error","name":"InvalidSignature","inputs":[]},
+	{"type":"error","name":"InvalidSignatureLength","inputs":[]},
+	{"type":"error","name":"InvalidContractSignature","inputs":[]},
+	{"type":"error","name":"SignatureExpired","inputs":[{"name":"signatureDeadline","type":"uint256"}]},
+	{"type":"error","name":"InvalidAmount","inputs":[{"name":"maxAmount","type":"uint256"}]}
+]`
+
+func init() {
+	parsed, err := fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	Register(*parsed)
+	p2, err := abi.JSON(strings.NewReader(permit2ErrorsABI))
+	if err != nil {
+		panic(err)
+	}
+	Register(p2)
+}
+
+// Register adds every custom error of contractABI to the decoder. The
+// FastSettlementV3 and Permit2 errors are registered by default.
+func Register(contractABI abi.ABI) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for _, e := range contractABI.Errors {
+		var sel [4]byte
+		copy(sel[:], e.ID[:4])
+		registry[sel] = e
+	}
+}
+
+// New encodes a revert of the registered custom error name with args, as a
+// node would return it.
+func New(name string, args ...interface{}) *Error {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	for sel, e := range registry {
+		if e.Name != name {
+			continue
+		}
+		packed, err := e.Inputs.Pack(args...)
+		if err != nil {
+			break
+		}
+		data := append(sel[:], packed...)
+		return &Error{Name: name, Selector: sel, Args: args, Data: data}
+	}
+	return &Error{Name: name, Args: args}
+}
+
+// PanicArithmetic is the Panic code of a checked arithmetic overflow or
+// underflow.
+const PanicArithmetic = 0x11
+
+// Panic encodes the Panic(code) revert Solidity raises for failed assertions
+// and checked arithmetic.
+func Panic(code uint64) *Error {
+	data := append(append([]byte(nil), panicSelector...), common.LeftPadBytes(new(big.Int).SetUint64(code).Bytes(), 32)...)
+	return Decode(data)
+}
+
+// Decode decodes a raw revert payload.
+func Decode(data []byte) *Error {
+	out := &Error{Data: data}
+	if len(data) < 4 {
+		return out
+	}
+	copy(out.Selector[:], data[:4])
+	switch {
+	case bytes.Equal(data[:4], errorSelector):
+		out.Name = "Error"
+		if reason, err := abi.UnpackRevert(data); err == nil {
+			out.Args = []interface{}{reason}
+		}
+		return out
+	case bytes.Equal(data[:4], panicSelector):
+		out.Name = "Panic"
+		if len(data) >= 36 {
+			out.Args = []interface{}{new(big.Int).SetBytes(data[4:36])}
+		}
+		return out
+	}
+	registryMu.RLock()
+	e, ok := registry[out.Selector]
+	registryMu.RUnlock()
+	if !ok {
+		return out
+	}
+	out.Name = e.Name
+	if args, err := e.Inputs.Unpack(data[4:]); err == nil {
+		out.Args = args
+	}
+	return out
+}
+
+type dataError interface {
+	ErrorData() interface{}
+}
+
+// Data extracts the revert payload carried by an RPC error, as returned by
+// bind when gas estimation or an eth_call reverts.
+func Data(err error) ([]byte, bool) {
+	var de dataError
+	if !errors.As(err, &de) {
+		return nil, false
+	}
+	switch d := de.ErrorData().(type) {
+	case string:
+		b, err := hexutil.Decode(d)
+		if err != nil {
+			return nil, false
+		}
+		return b, true
+	case []byte:
+		return d, true
+	default:
+		return nil, false
+	}
+}
+
+// FromError decodes the revert carried by err. It returns nil when err does
+// not carry revert data.
+func FromError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+	var decoded *Error
+	if errors.As(err, &decoded) {
+		return decoded
+	}
+	data, ok := Data(err)
+	if !ok {
+		return nil
+	}
+	return Decode(data)
+}
@@ -0,0 +1,75 @@
+package reverts_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+)
+
+// rpcError mimics the JSON-RPC error bind returns for a reverted call.
+type rpcError struct{ data interface{} }
+
+func (e rpcError) Error() string          { return "execution reverted" }
+func (e rpcError) ErrorCode() int         { return 3 }
+func (e rpcError) ErrorData() interface{} { return e.data }
+
+func TestDecode(t *testing.T) {
+	insufficient := reverts.New("InsufficientOut", big.NewInt(90), big.NewInt(100))
+	for _, tt := range []struct {
+		name     string
+		data     []byte
+		wantName string
+		wantMsg  string
+	}{
+		{"custom error", insufficient.Data, "InsufficientOut", "execution reverted: InsufficientOut(90, 100)"},
+		{"no arguments", reverts.New("IntentExpired").Data, "IntentExpired", "execution reverted: IntentExpired()"},
+		{"permit2", reverts.New("InvalidNonce").Data, "InvalidNonce", "execution reverted: InvalidNonce()"},
+		{"require string", hexutil.MustDecode("0x08c379a0" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000004" +
+			"626f6f6d00000000000000000000000000000000000000000000000000000000"), "Error", "execution reverted: Error(boom)"},
+		{"panic", reverts.Panic(reverts.PanicArithmetic).Data, "Panic", "execution reverted: Panic(17)"},
+		{"unknown selector", hexutil.MustDecode("0xdeadbeef"), "", "execution reverted: unknown error 0xdeadbeef"},
+		{"empty", nil, "", "execution reverted"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reverts.Decode(tt.data)
+			if got.Name != tt.wantName || got.Error() != tt.wantMsg {
+				t.Fatalf("Decode = %q (%s), want %q (%s)", got.Name, got, tt.wantName, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestFromError(t *testing.T) {
+	data := reverts.New("InsufficientOut", big.NewInt(90), big.NewInt(100)).Data
+	for _, tt := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"hex string data", rpcError{hexutil.Encode(data)}, true},
+		{"byte data", rpcError{data}, true},
+		{"wrapped", fmt.Errorf("estimate: %w", rpcError{hexutil.Encode(data)}), true},
+		{"already decoded", reverts.Decode(data), true},
+		{"no data", errors.New("connection refused"), false},
+		{"nil", nil, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reverts.FromError(tt.err)
+			if (got != nil) != tt.want {
+				t.Fatalf("FromError = %v, want decoded: %t", got, tt.want)
+			}
+			if tt.want && !errors.Is(got, reverts.Named("InsufficientOut")) {
+				t.Fatalf("%v is not InsufficientOut", got)
+			}
+			if tt.want && errors.Is(got, reverts.Named("IntentExpired")) {
+				t.Fatalf("%v matches IntentExpired", got)
+			}
+		})
+	}
+}
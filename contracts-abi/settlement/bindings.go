@@ -0,0 +1,253 @@
+package settlement
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	ifastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/IFastSettlementV3"
+)
+
+// V3 adapts the generated fastsettlementv3 binding to Settlement.
+type V3 struct {
+	*fastsettlementv3.Fastsettlementv3
+	address common.Address
+}
+
+var _ Settlement = (*V3)(nil)
+
+// NewV3 binds the full FastSettlementV3 ABI at address.
+func NewV3(address common.Address, backend bind.ContractBackend) (*V3, error) {
+	c, err := fastsettlementv3.NewFastsettlementv3(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &V3{Fastsettlementv3: c, address: address}, nil
+}
+
+// Address implements Settlement.
+func (s *V3) Address() common.Address { return s.address }
+
+// FilterIntentExecuted implements Filterer.
+func (s *V3) FilterIntentExecuted(opts *bind.FilterOpts, user, inputToken, outputToken []common.Address) ([]*IntentExecuted, error) {
+	it, err := s.Fastsettlementv3.FilterIntentExecuted(opts, user, inputToken, outputToken)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var out []*IntentExecuted
+	for it.Next() {
+		out = append(out, it.Event)
+	}
+	return out, it.Error()
+}
+
+// FilterExecutorUpdated implements Filterer.
+func (s *V3) FilterExecutorUpdated(opts *bind.FilterOpts, oldExecutor, newExecutor []common.Address) ([]*ExecutorUpdated, error) {
+	it, err := s.Fastsettlementv3.FilterExecutorUpdated(opts, oldExecutor, newExecutor)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var out []*ExecutorUpdated
+	for it.Next() {
+		out = append(out, it.Event)
+	}
+	return out, it.Error()
+}
+
+// FilterTreasuryUpdated implements Filterer.
+func (s *V3) FilterTreasuryUpdated(opts *bind.FilterOpts, oldTreasury, newTreasury []common.Address) ([]*TreasuryUpdated, error) {
+	it, err := s.Fastsettlementv3.FilterTreasuryUpdated(opts, oldTreasury, newTreasury)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var out []*TreasuryUpdated
+	for it.Next() {
+		out = append(out, it.Event)
+	}
+	return out, it.Error()
+}
+
+// FilterSwapTargetsUpdated implements Filterer.
+func (s *V3) FilterSwapTargetsUpdated(opts *bind.FilterOpts) ([]*SwapTargetsUpdated, error) {
+	it, err := s.Fastsettlementv3.FilterSwapTargetsUpdated(opts)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var out []*SwapTargetsUpdated
+	for it.Next() {
+		out = append(out, it.Event)
+	}
+	return out, it.Error()
+}
+
+// Interface adapts the generated ifastsettlementv3 binding to Settlement.
+// The interface ABI has no views, so Caller methods return ErrUnsupported.
+type Interface struct {
+	*ifastsettlementv3.Ifastsettlementv3
+	address common.Address
+}
+
+var _ Settlement = (*Interface)(nil)
+
+// NewInterface binds the IFastSettlementV3 ABI at address.
+func NewInterface(address common.Address, backend bind.ContractBackend) (*Interface, error) {
+	c, err := ifastsettlementv3.NewIfastsettlementv3(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Interface{Ifastsettlementv3: c, address: address}, nil
+}
+
+// Address implements Settlement.
+func (s *Interface) Address() common.Address { return s.address }
+
+// Executor implements Caller.
+func (s *Interface) Executor(*bind.CallOpts) (common.Address, error) {
+	return common.Address{}, ErrUnsupported
+}
+
+// Treasury implements Caller.
+func (s *Interface) Treasury(*bind.CallOpts) (common.Address, error) {
+	return common.Address{}, ErrUnsupported
+}
+
+// Owner implements Caller.
+func (s *Interface) Owner(*bind.CallOpts) (common.Address, error) {
+	return common.Address{}, ErrUnsupported
+}
+
+// AllowedSwapTargets implements Caller.
+func (s *Interface) AllowedSwapTargets(*bind.CallOpts, common.Address) (bool, error) {
+	return false, ErrUnsupported
+}
+
+// PERMIT2 implements Caller.
+func (s *Interface) PERMIT2(*bind.CallOpts) (common.Address, error) {
+	return common.Address{}, ErrUnsupported
+}
+
+// WETH implements Caller.
+func (s *Interface) WETH(*bind.CallOpts) (common.Address, error) {
+	return common.Address{}, ErrUnsupported
+}
+
+// ExecuteWithPermit implements Transactor.
+func (s *Interface) ExecuteWithPermit(opts *bind.TransactOpts, intent Intent, signature []byte, swapData SwapCall) (*types.Transaction, error) {
+	return s.Ifastsettlementv3.ExecuteWithPermit(opts, intent, signature, swapData)
+}
+
+// ExecuteWithETH implements Transactor.
+func (s *Interface) ExecuteWithETH(opts *bind.TransactOpts, intent Intent, swapData SwapCall) (*types.Transaction, error) {
+	return s.Ifastsettlementv3.ExecuteWithETH(opts, intent, swapData)
+}
+
+// RescueTokens implements Transactor.
+func (s *Interface) RescueTokens(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return s.Ifastsettlementv3.RescueTokens(opts, token, amount)
+}
+
+// FilterIntentExecuted implements Filterer.
+func (s *Interface) FilterIntentExecuted(opts *bind.FilterOpts, user, inputToken, outputToken []common.Address) ([]*IntentExecuted, error) {
+	it, err := s.Ifastsettlementv3.FilterIntentExecuted(opts, user, inputToken, outputToken)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var out []*IntentExecuted
+	for it.Next() {
+		ev := IntentExecuted(*it.Event)
+		out = append(out, &ev)
+	}
+	return out, it.Error()
+}
+
+// WatchIntentExecuted implements Filterer.
+func (s *Interface) WatchIntentExecuted(opts *bind.WatchOpts, sink chan<- *IntentExecuted, user, inputToken, outputToken []common.Address) (event.Subscription, error) {
+	inner := make(chan *ifastsettlementv3.Ifastsettlementv3IntentExecuted)
+	sub, err := s.Ifastsettlementv3.WatchIntentExecuted(opts, inner, user, inputToken, outputToken)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-inner:
+				converted := IntentExecuted(*ev)
+				select {
+				case sink <- &converted:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseIntentExecuted implements Filterer.
+func (s *Interface) ParseIntentExecuted(log types.Log) (*IntentExecuted, error) {
+	ev, err := s.Ifastsettlementv3.ParseIntentExecuted(log)
+	if err != nil {
+		return nil, err
+	}
+	converted := IntentExecuted(*ev)
+	return &converted, nil
+}
+
+// FilterExecutorUpdated implements Filterer.
+func (s *Interface) FilterExecutorUpdated(opts *bind.FilterOpts, oldExecutor, newExecutor []common.Address) ([]*ExecutorUpdated, error) {
+	it, err := s.Ifastsettlementv3.FilterExecutorUpdated(opts, oldExecutor, newExecutor)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var out []*ExecutorUpdated
+	for it.Next() {
+		ev := ExecutorUpdated(*it.Event)
+		out = append(out, &ev)
+	}
+	return out, it.Error()
+}
+
+// FilterTreasuryUpdated implements Filterer.
+func (s *Interface) FilterTreasuryUpdated(opts *bind.FilterOpts, oldTreasury, newTreasury []common.Address) ([]*TreasuryUpdated, error) {
+	it, err := s.Ifastsettlementv3.FilterTreasuryUpdated(opts, oldTreasury, newTreasury)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var out []*TreasuryUpdated
+	for it.Next() {
+		ev := TreasuryUpdated(*it.Event)
+		out = append(out, &ev)
+	}
+	return out, it.Error()
+}
+
+// FilterSwapTargetsUpdated implements Filterer.
+func (s *Interface) FilterSwapTargetsUpdated(opts *bind.FilterOpts) ([]*SwapTargetsUpdated, error) {
+	it, err := s.Ifastsettlementv3.FilterSwapTargetsUpdated(opts)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var out []*SwapTargetsUpdated
+	for it.Next() {
+		ev := SwapTargetsUpdated(*it.Event)
+		out = append(out, &ev)
+	}
+	return out, it.Error()
+}
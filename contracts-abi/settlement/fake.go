@@ -0,0 +1,735 @@
+package settlement
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+)
+
+var v3ABI *abi.ABI
+
+func init() {
+	var err error
+	if v3ABI, err = fastsettlementv3.Fastsettlementv3MetaData.GetAbi(); err != nil {
+		panic(err)
+	}
+}
+
+// ETH is the token address the contract uses for native ETH.
+var ETH = common.Address{}
+
+// SwapEnv is what a SwapHandler sees while the fake settlement is inside its
+// swap call. Settlement holds the approved input; the handler moves balances
+// to model what a router does.
+type SwapEnv struct {
+	Settlement common.Address
+	Target     common.Address
+	InputToken common.Address
+	Call       SwapCall
+
+	f         *Fake
+	allowance *big.Int
+}
+
+// Pull transfers amount of the input token from the settlement to the target,
+// spending the settlement's approval.
+func (e *SwapEnv) Pull(amount *big.Int) error {
+	if amount.Cmp(e.allowance) > 0 {
+		return fmt.Errorf("allowance exceeded: %s > %s", amount, e.allowance)
+	}
+	e.allowance = new(big.Int).Sub(e.allowance, amount)
+	return e.f.transfer(e.InputToken, e.Settlement, e.Target, amount)
+}
+
+// Pay credits amount of token to to, out of thin air. Paying the settlement
+// models a normal swap output; paying anyone else bypasses it.
+func (e *SwapEnv) Pay(token, to common.Address, amount *big.Int) {
+	e.f.credit(token, to, amount)
+}
+
+// Return transfers amount of token held by the target back to the
+// settlement, minting any shortfall.
+func (e *SwapEnv) Return(token common.Address, amount *big.Int) {
+	held := e.f.balance(token, e.Target)
+	if held.Cmp(amount) < 0 {
+		e.f.credit(token, e.Target, new(big.Int).Sub(amount, held))
+	}
+	_ = e.f.transfer(token, e.Target, e.Settlement, amount)
+}
+
+// SwapHandler models the swap target's behaviour. Returning an error makes
+// the swap call fail, which the contract surfaces as BadCallTarget.
+type SwapHandler func(env *SwapEnv) error
+
+// FakeConfig seeds a Fake.
+type FakeConfig struct {
+	Address  common.Address
+	Owner    common.Address
+	Executor common.Address
+	Treasury common.Address
+	Permit2  common.Address
+	WETH     common.Address
+	ChainID  *big.Int
+	// VerifySignatures checks Permit2 witness signatures in ExecuteWithPermit.
+	VerifySignatures bool
+}
+
+type fakeLog struct {
+	number uint64
+	value  interface{}
+}
+
+// Fake is an in-memory Settlement that models FastSettlementV3._execute:
+// executor and swap-target allowlists, intent validation, Permit2 nonces,
+// surplus to treasury, unused-input refunds and event emission. Each
+// successful transaction is mined in its own block; a reverting one leaves no
+// state behind and returns a *reverts.Error like a real node would.
+type Fake struct {
+	mu sync.Mutex
+
+	cfg      FakeConfig
+	owner    common.Address
+	executor common.Address
+	treasury common.Address
+	targets  map[common.Address]bool
+	handlers map[common.Address]SwapHandler
+
+	balances map[common.Address]map[common.Address]*big.Int
+	nonces   map[common.Address]map[string]bool
+
+	now      uint64
+	block    uint64
+	txNonce  uint64
+	logs     []fakeLog
+	watchers []*fakeWatcher
+}
+
+var _ Settlement = (*Fake)(nil)
+
+// NewFake returns a Fake with the given roles and no allowed swap targets.
+func NewFake(cfg FakeConfig) *Fake {
+	if cfg.ChainID == nil {
+		cfg.ChainID = big.NewInt(1)
+	}
+	return &Fake{
+		cfg:      cfg,
+		owner:    cfg.Owner,
+		executor: cfg.Executor,
+		treasury: cfg.Treasury,
+		targets:  map[common.Address]bool{},
+		handlers: map[common.Address]SwapHandler{},
+		balances: map[common.Address]map[common.Address]*big.Int{},
+		nonces:   map[common.Address]map[string]bool{},
+	}
+}
+
+// SetTime sets block.timestamp for subsequent transactions.
+func (f *Fake) SetTime(ts uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = ts
+}
+
+// SetSwapHandler installs the behaviour of a swap target. Targets still need
+// to be allowlisted through SetSwapTargets or AllowSwapTarget.
+func (f *Fake) SetSwapHandler(target common.Address, h SwapHandler) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.handlers[target] = h
+}
+
+// AllowSwapTarget allowlists target without going through the owner.
+func (f *Fake) AllowSwapTarget(target common.Address, allowed bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.targets[target] = allowed
+}
+
+// Mint credits amount of token (ETH for the zero address) to holder.
+func (f *Fake) Mint(token, holder common.Address, amount *big.Int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.credit(token, holder, amount)
+}
+
+// BalanceOf returns holder's balance of token.
+func (f *Fake) BalanceOf(token, holder common.Address) *big.Int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.balance(token, holder)
+}
+
+// NonceUsed reports whether the Permit2 nonce of user has been consumed.
+func (f *Fake) NonceUsed(user common.Address, nonce *big.Int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.nonces[user][nonceKey(nonce)]
+}
+
+// BlockNumber returns the number of the last mined block.
+func (f *Fake) BlockNumber() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.block
+}
+
+// Address implements Settlement.
+func (f *Fake) Address() common.Address { return f.cfg.Address }
+
+// Executor implements Caller.
+func (f *Fake) Executor(*bind.CallOpts) (common.Address, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.executor, nil
+}
+
+// Treasury implements Caller.
+func (f *Fake) Treasury(*bind.CallOpts) (common.Address, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.treasury, nil
+}
+
+// Owner implements Caller.
+func (f *Fake) Owner(*bind.CallOpts) (common.Address, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.owner, nil
+}
+
+// AllowedSwapTargets implements Caller.
+func (f *Fake) AllowedSwapTargets(_ *bind.CallOpts, target common.Address) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.targets[target], nil
+}
+
+// PERMIT2 implements Caller.
+func (f *Fake) PERMIT2(*bind.CallOpts) (common.Address, error) { return f.cfg.Permit2, nil }
+
+// WETH implements Caller.
+func (f *Fake) WETH(*bind.CallOpts) (common.Address, error) { return f.cfg.WETH, nil }
+
+// ExecuteWithPermit implements Transactor.
+func (f *Fake) ExecuteWithPermit(opts *bind.TransactOpts, intent Intent, signature []byte, swapData SwapCall) (*types.Transaction, error) {
+	return f.transact(opts, "executeWithPermit", []interface{}{intent, signature, swapData}, func() error {
+		if opts.From != f.executor {
+			return revert("UnauthorizedExecutor")
+		}
+		if err := f.validate(intent); err != nil {
+			return err
+		}
+		if !f.targets[swapData.To] {
+			return revert("UnauthorizedSwapTarget")
+		}
+		if intent.InputToken == ETH {
+			return revert("BadInputToken")
+		}
+		if err := f.pullWithPermit2(intent, signature); err != nil {
+			return err
+		}
+		return f.execute(intent, swapData, intent.InputToken)
+	})
+}
+
+// ExecuteWithETH implements Transactor.
+func (f *Fake) ExecuteWithETH(opts *bind.TransactOpts, intent Intent, swapData SwapCall) (*types.Transaction, error) {
+	return f.transact(opts, "executeWithETH", []interface{}{intent, swapData}, func() error {
+		value := opts.Value
+		if value == nil {
+			value = new(big.Int)
+		}
+		if intent.InputToken != ETH {
+			return revert("ExpectedETHInput")
+		}
+		if intent.User != opts.From {
+			return revert("UnauthorizedCaller")
+		}
+		if value.Cmp(intent.InputAmt) != 0 {
+			return revert("InvalidETHAmount")
+		}
+		if !f.targets[swapData.To] {
+			return revert("UnauthorizedSwapTarget")
+		}
+		if err := f.validate(intent); err != nil {
+			return err
+		}
+		if err := f.transfer(ETH, opts.From, f.cfg.Address, value); err != nil {
+			return err
+		}
+		// WETH.deposit: the ETH moves into WETH and the settlement is credited.
+		if err := f.transfer(ETH, f.cfg.Address, f.cfg.WETH, value); err != nil {
+			return err
+		}
+		f.credit(f.cfg.WETH, f.cfg.Address, value)
+		return f.execute(intent, swapData, f.cfg.WETH)
+	})
+}
+
+// SetSwapTargets implements Transactor.
+func (f *Fake) SetSwapTargets(opts *bind.TransactOpts, targets []common.Address, allowed []bool) (*types.Transaction, error) {
+	return f.transact(opts, "setSwapTargets", []interface{}{targets, allowed}, func() error {
+		if err := f.onlyOwner(opts.From); err != nil {
+			return err
+		}
+		if len(targets) != len(allowed) {
+			return revert("ArrayLengthMismatch")
+		}
+		for i, t := range targets {
+			f.targets[t] = allowed[i]
+		}
+		f.emit(&SwapTargetsUpdated{Targets: append([]common.Address(nil), targets...), Allowed: append([]bool(nil), allowed...)})
+		return nil
+	})
+}
+
+// SetExecutor implements Transactor.
+func (f *Fake) SetExecutor(opts *bind.TransactOpts, newExecutor common.Address) (*types.Transaction, error) {
+	return f.transact(opts, "setExecutor", []interface{}{newExecutor}, func() error {
+		if err := f.onlyOwner(opts.From); err != nil {
+			return err
+		}
+		old := f.executor
+		f.executor = newExecutor
+		f.emit(&ExecutorUpdated{OldExecutor: old, NewExecutor: newExecutor})
+		return nil
+	})
+}
+
+// SetTreasury implements Transactor.
+func (f *Fake) SetTreasury(opts *bind.TransactOpts, newTreasury common.Address) (*types.Transaction, error) {
+	return f.transact(opts, "setTreasury", []interface{}{newTreasury}, func() error {
+		if err := f.onlyOwner(opts.From); err != nil {
+			return err
+		}
+		if newTreasury == (common.Address{}) {
+			return revert("BadTreasury")
+		}
+		old := f.treasury
+		f.treasury = newTreasury
+		f.emit(&TreasuryUpdated{OldTreasury: old, NewTreasury: newTreasury})
+		return nil
+	})
+}
+
+// RescueTokens implements Transactor.
+func (f *Fake) RescueTokens(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return f.transact(opts, "rescueTokens", []interface{}{token, amount}, func() error {
+		if err := f.onlyOwner(opts.From); err != nil {
+			return err
+		}
+		return f.transfer(token, f.cfg.Address, opts.From, amount)
+	})
+}
+
+// FilterIntentExecuted implements Filterer.
+func (f *Fake) FilterIntentExecuted(opts *bind.FilterOpts, user, inputToken, outputToken []common.Address) ([]*IntentExecuted, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []*IntentExecuted
+	for _, l := range f.logs {
+		ev, ok := l.value.(*IntentExecuted)
+		if !ok || !inRange(opts, l.number) {
+			continue
+		}
+		if matches(user, ev.User) && matches(inputToken, ev.InputToken) && matches(outputToken, ev.OutputToken) {
+			out = append(out, ev)
+		}
+	}
+	return out, nil
+}
+
+// FilterExecutorUpdated implements Filterer.
+func (f *Fake) FilterExecutorUpdated(opts *bind.FilterOpts, oldExecutor, newExecutor []common.Address) ([]*ExecutorUpdated, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []*ExecutorUpdated
+	for _, l := range f.logs {
+		if ev, ok := l.value.(*ExecutorUpdated); ok && inRange(opts, l.number) &&
+			matches(oldExecutor, ev.OldExecutor) && matches(newExecutor, ev.NewExecutor) {
+			out = append(out, ev)
+		}
+	}
+	return out, nil
+}
+
+// FilterTreasuryUpdated implements Filterer.
+func (f *Fake) FilterTreasuryUpdated(opts *bind.FilterOpts, oldTreasury, newTreasury []common.Address) ([]*TreasuryUpdated, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []*TreasuryUpdated
+	for _, l := range f.logs {
+		if ev, ok := l.value.(*TreasuryUpdated); ok && inRange(opts, l.number) &&
+			matches(oldTreasury, ev.OldTreasury) && matches(newTreasury, ev.NewTreasury) {
+			out = append(out, ev)
+		}
+	}
+	return out, nil
+}
+
+// FilterSwapTargetsUpdated implements Filterer.
+func (f *Fake) FilterSwapTargetsUpdated(opts *bind.FilterOpts) ([]*SwapTargetsUpdated, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []*SwapTargetsUpdated
+	for _, l := range f.logs {
+		if ev, ok := l.value.(*SwapTargetsUpdated); ok && inRange(opts, l.number) {
+			out = append(out, ev)
+		}
+	}
+	return out, nil
+}
+
+// ParseIntentExecuted implements Filterer using the real event ABI.
+func (f *Fake) ParseIntentExecuted(log types.Log) (*IntentExecuted, error) {
+	c := bind.NewBoundContract(f.cfg.Address, *v3ABI, nil, nil, nil)
+	ev := new(IntentExecuted)
+	if err := c.UnpackLog(ev, "IntentExecuted", log); err != nil {
+		return nil, err
+	}
+	ev.Raw = log
+	return ev, nil
+}
+
+type fakeWatcher struct {
+	ch                  chan *IntentExecuted
+	user, input, output []common.Address
+}
+
+// WatchIntentExecuted implements Filterer. Events are delivered in order as
+// transactions succeed.
+func (f *Fake) WatchIntentExecuted(_ *bind.WatchOpts, sink chan<- *IntentExecuted, user, inputToken, outputToken []common.Address) (event.Subscription, error) {
+	w := &fakeWatcher{ch: make(chan *IntentExecuted, 256), user: user, input: inputToken, output: outputToken}
+	f.mu.Lock()
+	f.watchers = append(f.watchers, w)
+	f.mu.Unlock()
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer f.unwatch(w)
+		for {
+			select {
+			case ev := <-w.ch:
+				select {
+				case sink <- ev:
+				case <-quit:
+					return nil
+				}
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+func (f *Fake) unwatch(w *fakeWatcher) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, x := range f.watchers {
+		if x == w {
+			f.watchers = append(f.watchers[:i], f.watchers[i+1:]...)
+			return
+		}
+	}
+}
+
+// transact runs body against a snapshot of the state, committing it and
+// mining a block only when body succeeds.
+func (f *Fake) transact(opts *bind.TransactOpts, method string, args []interface{}, body func() error) (*types.Transaction, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	snap := f.snapshot()
+	pendingLogs := len(f.logs)
+	if err := body(); err != nil {
+		f.restore(snap)
+		f.logs = f.logs[:pendingLogs]
+		return nil, err
+	}
+	value := opts.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	to := f.cfg.Address
+	data := packCalldata(method, args)
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   f.cfg.ChainID,
+		Nonce:     f.txNonce,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+		GasTipCap: new(big.Int),
+		GasFeeCap: new(big.Int),
+	})
+	f.txNonce++
+	f.block++
+	for i := pendingLogs; i < len(f.logs); i++ {
+		f.logs[i].number = f.block
+		f.stampRaw(f.logs[i].value, tx.Hash(), uint(i-pendingLogs))
+		if ev, ok := f.logs[i].value.(*IntentExecuted); ok {
+			f.notify(ev)
+		}
+	}
+	return tx, nil
+}
+
+func (f *Fake) stampRaw(v interface{}, txHash common.Hash, index uint) {
+	raw := types.Log{Address: f.cfg.Address, BlockNumber: f.block, TxHash: txHash, Index: index}
+	switch ev := v.(type) {
+	case *IntentExecuted:
+		raw.Topics = []common.Hash{IntentExecutedTopic, addrTopic(ev.User), addrTopic(ev.InputToken), addrTopic(ev.OutputToken)}
+		raw.Data, _ = v3ABI.Events["IntentExecuted"].Inputs.NonIndexed().Pack(ev.InputAmt, ev.UserAmtOut, ev.Received, ev.Surplus)
+		ev.Raw = raw
+	case *ExecutorUpdated:
+		raw.Topics = []common.Hash{v3ABI.Events["ExecutorUpdated"].ID, addrTopic(ev.OldExecutor), addrTopic(ev.NewExecutor)}
+		ev.Raw = raw
+	case *TreasuryUpdated:
+		raw.Topics = []common.Hash{v3ABI.Events["TreasuryUpdated"].ID, addrTopic(ev.OldTreasury), addrTopic(ev.NewTreasury)}
+		ev.Raw = raw
+	case *SwapTargetsUpdated:
+		raw.Topics = []common.Hash{v3ABI.Events["SwapTargetsUpdated"].ID}
+		raw.Data, _ = v3ABI.Events["SwapTargetsUpdated"].Inputs.Pack(ev.Targets, ev.Allowed)
+		ev.Raw = raw
+	}
+}
+
+func (f *Fake) notify(ev *IntentExecuted) {
+	for _, w := range f.watchers {
+		if matches(w.user, ev.User) && matches(w.input, ev.InputToken) && matches(w.output, ev.OutputToken) {
+			select {
+			case w.ch <- ev:
+			default: // slow consumer; drop rather than block the writer
+			}
+		}
+	}
+}
+
+func (f *Fake) validate(intent Intent) error {
+	switch {
+	case intent.Deadline == nil || new(big.Int).SetUint64(f.now).Cmp(intent.Deadline) > 0:
+		return revert("IntentExpired")
+	case intent.Recipient == (common.Address{}):
+		return revert("BadRecipient")
+	case intent.InputAmt == nil || intent.InputAmt.Sign() == 0:
+		return revert("BadInputAmt")
+	case intent.UserAmtOut == nil || intent.UserAmtOut.Sign() == 0:
+		return revert("BadUserAmtOut")
+	}
+	return nil
+}
+
+func (f *Fake) pullWithPermit2(intent Intent, signature []byte) error {
+	if f.cfg.VerifySignatures {
+		domain := permit2.Domain{ChainID: f.cfg.ChainID, Permit2: f.cfg.Permit2}
+		if err := domain.Verify(f.cfg.Address, intent, signature); err != nil {
+			return revert("InvalidSigner")
+		}
+	}
+	used := f.nonces[intent.User]
+	if used == nil {
+		used = map[string]bool{}
+		f.nonces[intent.User] = used
+	}
+	key := nonceKey(intent.Nonce)
+	if used[key] {
+		return revert("InvalidNonce")
+	}
+	used[key] = true
+	return f.transfer(intent.InputToken, intent.User, f.cfg.Address, intent.InputAmt)
+}
+
+func (f *Fake) execute(intent Intent, swapData SwapCall, actualInputToken common.Address) error {
+	self := f.cfg.Address
+	outputToken := intent.OutputToken
+	startInputBal := f.balance(actualInputToken, self)
+	outputBalBefore := f.balance(outputToken, self)
+
+	env := &SwapEnv{
+		Settlement: self,
+		Target:     swapData.To,
+		InputToken: actualInputToken,
+		Call:       swapData,
+		f:          f,
+		allowance:  new(big.Int).Set(intent.InputAmt),
+	}
+	handler := f.handlers[swapData.To]
+	if handler == nil {
+		return revert("BadCallTarget")
+	}
+	if err := handler(env); err != nil {
+		return revert("BadCallTarget")
+	}
+
+	received := new(big.Int).Sub(f.balance(outputToken, self), outputBalBefore)
+	if received.Sign() < 0 {
+		// outputBalAfter - outputBalBefore underflows in checked arithmetic.
+		return reverts.Panic(reverts.PanicArithmetic)
+	}
+	if received.Cmp(intent.UserAmtOut) < 0 {
+		return revert("InsufficientOut", received, intent.UserAmtOut)
+	}
+	surplus := new(big.Int).Sub(received, intent.UserAmtOut)
+
+	if err := f.transfer(outputToken, self, intent.Recipient, intent.UserAmtOut); err != nil {
+		return err
+	}
+	if surplus.Sign() > 0 {
+		if err := f.transfer(outputToken, self, f.treasury, surplus); err != nil {
+			return err
+		}
+	}
+	if finalInputBal := f.balance(actualInputToken, self); finalInputBal.Cmp(startInputBal) > 0 {
+		unused := new(big.Int).Sub(finalInputBal, startInputBal)
+		if err := f.transfer(actualInputToken, self, intent.User, unused); err != nil {
+			return err
+		}
+	}
+
+	f.emit(&IntentExecuted{
+		User:        intent.User,
+		InputToken:  intent.InputToken,
+		OutputToken: outputToken,
+		InputAmt:    new(big.Int).Set(intent.InputAmt),
+		UserAmtOut:  new(big.Int).Set(intent.UserAmtOut),
+		Received:    received,
+		Surplus:     surplus,
+	})
+	return nil
+}
+
+func (f *Fake) onlyOwner(sender common.Address) error {
+	if sender != f.owner {
+		return revert("OwnableUnauthorizedAccount", sender)
+	}
+	return nil
+}
+
+func (f *Fake) emit(v interface{}) {
+	f.logs = append(f.logs, fakeLog{value: v})
+}
+
+func (f *Fake) balance(token, holder common.Address) *big.Int {
+	if b := f.balances[token][holder]; b != nil {
+		return new(big.Int).Set(b)
+	}
+	return new(big.Int)
+}
+
+func (f *Fake) credit(token, holder common.Address, amount *big.Int) {
+	m := f.balances[token]
+	if m == nil {
+		m = map[common.Address]*big.Int{}
+		f.balances[token] = m
+	}
+	m[holder] = new(big.Int).Add(f.balance(token, holder), amount)
+}
+
+func (f *Fake) transfer(token, from, to common.Address, amount *big.Int) error {
+	bal := f.balance(token, from)
+	if bal.Cmp(amount) < 0 {
+		if token == ETH {
+			return revert("InsufficientBalance", bal, amount)
+		}
+		return revert("ERC20InsufficientBalance", from, bal, amount)
+	}
+	f.credit(token, from, new(big.Int).Neg(amount))
+	f.credit(token, to, amount)
+	return nil
+}
+
+type fakeSnapshot struct {
+	owner, executor, treasury common.Address
+	targets                   map[common.Address]bool
+	balances                  map[common.Address]map[common.Address]*big.Int
+	nonces                    map[common.Address]map[string]bool
+}
+
+func (f *Fake) snapshot() fakeSnapshot {
+	s := fakeSnapshot{
+		owner: f.owner, executor: f.executor, treasury: f.treasury,
+		targets:  make(map[common.Address]bool, len(f.targets)),
+		balances: make(map[common.Address]map[common.Address]*big.Int, len(f.balances)),
+		nonces:   make(map[common.Address]map[string]bool, len(f.nonces)),
+	}
+	for k, v := range f.targets {
+		s.targets[k] = v
+	}
+	for token, holders := range f.balances {
+		m := make(map[common.Address]*big.Int, len(holders))
+		for h, b := range holders {
+			m[h] = new(big.Int).Set(b)
+		}
+		s.balances[token] = m
+	}
+	for user, used := range f.nonces {
+		m := make(map[string]bool, len(used))
+		for n := range used {
+			m[n] = true
+		}
+		s.nonces[user] = m
+	}
+	return s
+}
+
+func (f *Fake) restore(s fakeSnapshot) {
+	f.owner, f.executor, f.treasury = s.owner, s.executor, s.treasury
+	f.targets, f.balances, f.nonces = s.targets, s.balances, s.nonces
+}
+
+// revert builds the error a node returns for a custom error.
+func revert(name string, args ...interface{}) error {
+	return reverts.New(name, args...)
+}
+
+// packCalldata encodes the transaction input for the synthetic transaction.
+// Malformed arguments (nil big.Ints the contract would reject anyway) yield
+// empty calldata instead of the panic abi.Pack raises.
+func packCalldata(method string, args []interface{}) (data []byte) {
+	defer func() {
+		if recover() != nil {
+			data = nil
+		}
+	}()
+	data, _ = v3ABI.Pack(method, args...)
+	return data
+}
+
+func inRange(opts *bind.FilterOpts, number uint64) bool {
+	if opts == nil {
+		return true
+	}
+	if number < opts.Start {
+		return false
+	}
+	return opts.End == nil || number <= *opts.End
+}
+
+func matches(filter []common.Address, v common.Address) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, a := range filter {
+		if a == v {
+			return true
+		}
+	}
+	return false
+}
+
+func addrTopic(a common.Address) common.Hash {
+	return common.BytesToHash(common.LeftPadBytes(a.Bytes(), 32))
+}
+
+func nonceKey(n *big.Int) string {
+	if n == nil {
+		return "0"
+	}
+	return n.String()
+}
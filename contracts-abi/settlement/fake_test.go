@@ -0,0 +1,311 @@
+package settlement_test
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"github.com/primev/fastprotocolapp/contracts-abi/testharness"
+)
+
+var (
+	addrSettlement = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	owner          = common.HexToAddress("0x0000000000000000000000000000000000000001")
+	executor       = common.HexToAddress("0x0000000000000000000000000000000000000002")
+	treasury       = common.HexToAddress("0x0000000000000000000000000000000000000003")
+	router         = common.HexToAddress("0x0000000000000000000000000000000000000004")
+	tokenIn        = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	tokenOut       = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+	weth           = common.HexToAddress("0x00000000000000000000000000000000000000e7")
+	userKey, _     = crypto.ToECDSA(crypto.Keccak256([]byte("user")))
+	user           = crypto.PubkeyToAddress(userKey.PublicKey)
+)
+
+func newFake(t *testing.T) *settlement.Fake {
+	t.Helper()
+	f := settlement.NewFake(settlement.FakeConfig{
+		Address:          addrSettlement,
+		Owner:            owner,
+		Executor:         executor,
+		Treasury:         treasury,
+		Permit2:          permit2.CanonicalAddress,
+		WETH:             weth,
+		VerifySignatures: true,
+	})
+	f.SetTime(1_000)
+	f.AllowSwapTarget(router, true)
+	f.Mint(tokenIn, user, big.NewInt(1_000))
+	f.Mint(ETH(), user, big.NewInt(1_000))
+	return f
+}
+
+// ETH is the zero address, spelled out for readability in the table tests.
+func ETH() common.Address { return settlement.ETH }
+
+func intent(input, output common.Address, in, out, nonce int64) settlement.Intent {
+	return settlement.Intent{
+		User:        user,
+		InputToken:  input,
+		OutputToken: output,
+		InputAmt:    big.NewInt(in),
+		UserAmtOut:  big.NewInt(out),
+		Recipient:   user,
+		Deadline:    big.NewInt(2_000),
+		Nonce:       big.NewInt(nonce),
+	}
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, i settlement.Intent) []byte {
+	t.Helper()
+	sig, err := permit2.Domain{ChainID: big.NewInt(1), Permit2: permit2.CanonicalAddress}.Sign(key, addrSettlement, i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+// swap pulls pull of the input and pays out of tokenOut to the settlement.
+func swap(pull, out int64) settlement.SwapHandler {
+	return func(env *settlement.SwapEnv) error {
+		if err := env.Pull(big.NewInt(pull)); err != nil {
+			return err
+		}
+		env.Pay(tokenOut, env.Settlement, big.NewInt(out))
+		return nil
+	}
+}
+
+func from(addr common.Address) *bind.TransactOpts { return &bind.TransactOpts{From: addr} }
+
+func TestFakeExecuteWithPermit(t *testing.T) {
+	f := newFake(t)
+	f.SetSwapHandler(router, swap(600, 150))
+	in := intent(tokenIn, tokenOut, 1_000, 100, 1)
+	call := settlement.SwapCall{To: router, Value: new(big.Int)}
+
+	if _, err := f.ExecuteWithPermit(from(executor), in, sign(t, userKey, in), call); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		token, holder common.Address
+		want          int64
+	}{
+		{tokenOut, user, 100},
+		{tokenOut, treasury, 50},
+		{tokenOut, addrSettlement, 0},
+		// 400 of the input went unused and the router kept 600, but _execute
+		// only refunds growth of its input balance, so nothing comes back.
+		{tokenIn, user, 0},
+		{tokenIn, router, 600},
+		{tokenIn, addrSettlement, 400},
+	} {
+		if got := f.BalanceOf(c.token, c.holder); got.Int64() != c.want {
+			t.Errorf("balance of %s in %s = %s, want %d", c.holder, c.token, got, c.want)
+		}
+	}
+	if !f.NonceUsed(user, big.NewInt(1)) || f.BlockNumber() != 1 {
+		t.Fatalf("nonce used %v, block %d", f.NonceUsed(user, big.NewInt(1)), f.BlockNumber())
+	}
+	evs, err := f.FilterIntentExecuted(nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evs) != 1 || evs[0].Received.Int64() != 150 || evs[0].Surplus.Int64() != 50 {
+		t.Fatalf("events %+v", evs)
+	}
+	parsed, err := f.ParseIntentExecuted(evs[0].Raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.User != user || parsed.Received.Int64() != 150 {
+		t.Fatalf("re-parsed %+v", parsed)
+	}
+}
+
+func TestFakeReverts(t *testing.T) {
+	in := intent(tokenIn, tokenOut, 1_000, 100, 1)
+	call := settlement.SwapCall{To: router, Value: new(big.Int)}
+	other, _ := crypto.ToECDSA(crypto.Keccak256([]byte("other")))
+	expired := intent(tokenIn, tokenOut, 1_000, 100, 1)
+	expired.Deadline = big.NewInt(999)
+
+	tests := []struct {
+		name    string
+		handler settlement.SwapHandler
+		opts    *bind.TransactOpts
+		intent  settlement.Intent
+		sig     func(t *testing.T) []byte
+		call    settlement.SwapCall
+		want    string
+	}{
+		{"not executor", swap(1_000, 100), from(user), in, nil, call, "UnauthorizedExecutor"},
+		{"expired", swap(1_000, 100), from(executor), expired, nil, call, "IntentExpired"},
+		{"target", swap(1_000, 100), from(executor), in, nil, settlement.SwapCall{To: treasury, Value: new(big.Int)}, "UnauthorizedSwapTarget"},
+		{"signer", swap(1_000, 100), from(executor), in, func(t *testing.T) []byte { return sign(t, other, in) }, call, "InvalidSigner"},
+		{"short", swap(1_000, 99), from(executor), in, nil, call, "InsufficientOut"},
+		{"router fails", func(*settlement.SwapEnv) error { return errors.New("boom") }, from(executor), in, nil, call, "BadCallTarget"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFake(t)
+			f.SetSwapHandler(router, tt.handler)
+			sig := sign(t, userKey, tt.intent)
+			if tt.sig != nil {
+				sig = tt.sig(t)
+			}
+			_, err := f.ExecuteWithPermit(tt.opts, tt.intent, sig, tt.call)
+			if !errors.Is(err, reverts.Named(tt.want)) {
+				t.Fatalf("err = %v, want %s", err, tt.want)
+			}
+			// A revert leaves no state behind.
+			if f.BalanceOf(tokenIn, user).Int64() != 1_000 || f.NonceUsed(user, big.NewInt(1)) || f.BlockNumber() != 0 {
+				t.Fatalf("state changed by a reverted transaction")
+			}
+		})
+	}
+}
+
+func TestFakeNonceReplay(t *testing.T) {
+	f := newFake(t)
+	f.SetSwapHandler(router, swap(500, 100))
+	in := intent(tokenIn, tokenOut, 500, 100, 1)
+	sig := sign(t, userKey, in)
+	call := settlement.SwapCall{To: router, Value: new(big.Int)}
+	if _, err := f.ExecuteWithPermit(from(executor), in, sig, call); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.ExecuteWithPermit(from(executor), in, sig, call); !errors.Is(err, reverts.Named("InvalidNonce")) {
+		t.Fatalf("replay: err = %v, want InvalidNonce", err)
+	}
+}
+
+func TestFakeOutputBalanceUnderflow(t *testing.T) {
+	// Swapping a token for itself: the router pulls more of it than it pays
+	// back, so the settlement's output balance falls and
+	// outputBalAfter - outputBalBefore underflows.
+	f := newFake(t)
+	f.SetSwapHandler(router, func(env *settlement.SwapEnv) error {
+		if err := env.Pull(big.NewInt(1_000)); err != nil {
+			return err
+		}
+		env.Pay(tokenIn, env.Settlement, big.NewInt(10))
+		return nil
+	})
+	in := intent(tokenIn, tokenIn, 1_000, 1, 1)
+	_, err := f.ExecuteWithPermit(from(executor), in, sign(t, userKey, in), settlement.SwapCall{To: router, Value: new(big.Int)})
+	rev := reverts.FromError(err)
+	if rev == nil || rev.Name != "Panic" || len(rev.Args) != 1 || rev.Args[0].(*big.Int).Int64() != reverts.PanicArithmetic {
+		t.Fatalf("err = %v, want Panic(0x11)", err)
+	}
+	if f.BalanceOf(tokenIn, user).Int64() != 1_000 {
+		t.Fatalf("state changed by a reverted transaction")
+	}
+}
+
+func TestFakeExecuteWithETH(t *testing.T) {
+	f := newFake(t)
+	f.SetSwapHandler(router, swap(300, 120))
+	in := intent(ETH(), tokenOut, 300, 100, 1)
+	call := settlement.SwapCall{To: router, Value: new(big.Int)}
+
+	opts := from(executor)
+	opts.Value = big.NewInt(300)
+	if _, err := f.ExecuteWithETH(opts, in, call); !errors.Is(err, reverts.Named("UnauthorizedCaller")) {
+		t.Fatalf("sent by the executor: err = %v, want UnauthorizedCaller", err)
+	}
+	opts = from(user)
+	opts.Value = big.NewInt(299)
+	if _, err := f.ExecuteWithETH(opts, in, call); !errors.Is(err, reverts.Named("InvalidETHAmount")) {
+		t.Fatalf("short value: err = %v, want InvalidETHAmount", err)
+	}
+	opts.Value = big.NewInt(300)
+	if _, err := f.ExecuteWithETH(opts, in, call); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.BalanceOf(ETH(), user); got.Int64() != 700 {
+		t.Fatalf("user ETH = %s, want 700", got)
+	}
+	if got := f.BalanceOf(tokenOut, treasury); got.Int64() != 20 {
+		t.Fatalf("treasury surplus = %s, want 20", got)
+	}
+}
+
+// TestV3MatchesFake runs the same settlement against the contract and the
+// fake and compares the IntentExecuted they emit.
+func TestV3MatchesFake(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{})
+	amountIn := new(big.Int).Mul(big.NewInt(10), testharness.Ether)
+	amountOut := new(big.Int).Mul(big.NewInt(9), testharness.Ether)
+	minOut := new(big.Int).Mul(big.NewInt(8), testharness.Ether)
+
+	v3, err := settlement.NewV3(h.Proxy, h.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in, err := h.NewIntent(h.TokenIn, h.TokenOut, amountIn, minOut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := h.SignIntent(h.User, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call, err := h.SwapCall(h.TokenIn, amountIn, h.TokenOut, amountOut, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Mine(v3.ExecuteWithPermit(h.Opts(h.Executor), in, sig, call)); err != nil {
+		t.Fatal(err)
+	}
+	chain, err := v3.FilterIntentExecuted(&bind.FilterOpts{}, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := settlement.NewFake(settlement.FakeConfig{
+		Address:  h.Proxy,
+		Owner:    h.Owner.Address,
+		Executor: h.Executor.Address,
+		Treasury: h.Treasury.Address,
+		Permit2:  h.Permit2,
+		WETH:     h.WETH,
+		ChainID:  h.ChainID,
+	})
+	now, err := h.Now()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.SetTime(now)
+	f.AllowSwapTarget(h.Router, true)
+	f.Mint(h.TokenIn, h.User.Address, amountIn)
+	f.SetSwapHandler(h.Router, func(env *settlement.SwapEnv) error {
+		if err := env.Pull(amountIn); err != nil {
+			return err
+		}
+		env.Pay(h.TokenOut, env.Settlement, amountOut)
+		return nil
+	})
+	if _, err := f.ExecuteWithPermit(&bind.TransactOpts{From: h.Executor.Address}, in, sig, call); err != nil {
+		t.Fatal(err)
+	}
+	fake, err := f.FilterIntentExecuted(nil, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(chain) != 1 || len(fake) != 1 {
+		t.Fatalf("%d events on chain, %d from the fake", len(chain), len(fake))
+	}
+	c, k := chain[0], fake[0]
+	if c.User != k.User || c.Received.Cmp(k.Received) != 0 || c.Surplus.Cmp(k.Surplus) != 0 ||
+		common.Bytes2Hex(c.Raw.Data) != common.Bytes2Hex(k.Raw.Data) {
+		t.Fatalf("chain %+v, fake %+v", c, k)
+	}
+}
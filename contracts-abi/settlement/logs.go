@@ -0,0 +1,84 @@
+package settlement
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+)
+
+// DefaultChunkSize is the block span of each log query when none is
+// configured. Most providers cap eth_getLogs at 10,000 blocks.
+const DefaultChunkSize = 10_000
+
+// IntentExecutedTopic is topic 0 of IntentExecuted logs.
+var IntentExecutedTopic = func() common.Hash {
+	parsed, err := fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return parsed.Events["IntentExecuted"].ID
+}()
+
+// LogFilterer is the log access ReadLogs needs; ethclient.Client satisfies
+// it.
+type LogFilterer interface {
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// ReadLogs runs queries over [from, to] in spans of chunkSize blocks, or
+// DefaultChunkSize when zero, and calls fn with each log that was not
+// removed. Every query runs on a span before the next span is read; the
+// queries' own block ranges are ignored. An error from fn stops the read and
+// is returned as is.
+func ReadLogs(ctx context.Context, backend LogFilterer, from, to, chunkSize uint64, queries []ethereum.FilterQuery, fn func(types.Log) error) error {
+	if chunkSize == 0 {
+		chunkSize = DefaultChunkSize
+	}
+	for start := from; start <= to; start += chunkSize {
+		end := start + chunkSize - 1
+		if end > to || end < start {
+			end = to
+		}
+		for _, q := range queries {
+			q.FromBlock, q.ToBlock = new(big.Int).SetUint64(start), new(big.Int).SetUint64(end)
+			logs, err := backend.FilterLogs(ctx, q)
+			if err != nil {
+				return fmt.Errorf("settlement: logs %d-%d: %w", start, end, err)
+			}
+			for _, l := range logs {
+				if l.Removed {
+					continue
+				}
+				if err := fn(l); err != nil {
+					return err
+				}
+			}
+		}
+		if end == to {
+			break
+		}
+	}
+	return nil
+}
+
+// ReadIntentExecuted calls fn with the IntentExecuted events address emitted
+// in [from, to], in chain order. See ReadLogs.
+func ReadIntentExecuted(ctx context.Context, backend LogFilterer, address common.Address, from, to, chunkSize uint64, fn func(*IntentExecuted) error) error {
+	parser, err := NewV3(address, nil)
+	if err != nil {
+		return err
+	}
+	q := ethereum.FilterQuery{Addresses: []common.Address{address}, Topics: [][]common.Hash{{IntentExecutedTopic}}}
+	return ReadLogs(ctx, backend, from, to, chunkSize, []ethereum.FilterQuery{q}, func(l types.Log) error {
+		ev, err := parser.ParseIntentExecuted(l)
+		if err != nil {
+			return err
+		}
+		return fn(ev)
+	})
+}
@@ -0,0 +1,115 @@
+package settlement_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+// spans records each query's range and serves one log per block, removed on
+// even blocks.
+type spans struct {
+	ranges [][2]uint64
+	fail   error
+}
+
+func (s *spans) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	if s.fail != nil {
+		return nil, s.fail
+	}
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	s.ranges = append(s.ranges, [2]uint64{from, to})
+	var logs []types.Log
+	for n := from; n <= to; n++ {
+		logs = append(logs, types.Log{BlockNumber: n, Removed: n%2 == 0})
+		if n == to {
+			break
+		}
+	}
+	return logs, nil
+}
+
+func TestReadLogs(t *testing.T) {
+	s := &spans{}
+	var blocks []uint64
+	err := settlement.ReadLogs(context.Background(), s, 3, 12, 4, []ethereum.FilterQuery{{}}, func(l types.Log) error {
+		blocks = append(blocks, l.BlockNumber)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][2]uint64{{3, 6}, {7, 10}, {11, 12}}; len(s.ranges) != len(want) || s.ranges[0] != want[0] || s.ranges[1] != want[1] || s.ranges[2] != want[2] {
+		t.Fatalf("queried %v, want %v", s.ranges, want)
+	}
+	if want := []uint64{3, 5, 7, 9, 11}; len(blocks) != len(want) {
+		t.Fatalf("read blocks %v, want %v without removed logs", blocks, want)
+	}
+
+	// The last block of the uint64 range ends the read instead of wrapping.
+	s = &spans{}
+	max := ^uint64(0)
+	if err := settlement.ReadLogs(context.Background(), s, max-1, max, 0, []ethereum.FilterQuery{{}}, func(types.Log) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.ranges) != 1 {
+		t.Fatalf("queried %v", s.ranges)
+	}
+
+	stop := errors.New("stop")
+	err = settlement.ReadLogs(context.Background(), &spans{}, 1, 100, 10, []ethereum.FilterQuery{{}}, func(types.Log) error { return stop })
+	if err != stop {
+		t.Fatalf("callback error = %v, want it returned as is", err)
+	}
+	down := errors.New("down")
+	if err := settlement.ReadLogs(context.Background(), &spans{fail: down}, 1, 100, 10, []ethereum.FilterQuery{{}}, nil); !errors.Is(err, down) {
+		t.Fatalf("err = %v, want the backend's", err)
+	}
+}
+
+func TestReadIntentExecuted(t *testing.T) {
+	f := newFake(t)
+	f.SetSwapHandler(router, swap(100, 20))
+	f.Mint(tokenIn, user, big.NewInt(1_000))
+	call := settlement.SwapCall{To: router, Value: new(big.Int)}
+	for nonce := int64(1); nonce <= 3; nonce++ {
+		in := intent(tokenIn, tokenOut, 100, 10, nonce)
+		if _, err := f.ExecuteWithPermit(from(executor), in, sign(t, userKey, in), call); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var got []int64
+	err := settlement.ReadIntentExecuted(context.Background(), fakeLogs{f}, addrSettlement, 0, f.BlockNumber(), 1, func(ev *settlement.IntentExecuted) error {
+		got = append(got, int64(ev.Raw.BlockNumber))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[0] != 1 || got[2] != 3 {
+		t.Fatalf("read events at blocks %v, want 1, 2, 3", got)
+	}
+}
+
+// fakeLogs serves a Fake's IntentExecuted logs through FilterLogs.
+type fakeLogs struct{ f *settlement.Fake }
+
+func (l fakeLogs) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	evs, err := l.f.FilterIntentExecuted(nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var out []types.Log
+	for _, ev := range evs {
+		n := ev.Raw.BlockNumber
+		if n >= q.FromBlock.Uint64() && n <= q.ToBlock.Uint64() && ev.Raw.Topics[0] == q.Topics[0][0] && ev.Raw.Address == q.Addresses[0] {
+			out = append(out, ev.Raw)
+		}
+	}
+	return out, nil
+}
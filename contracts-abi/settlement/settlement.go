@@ -0,0 +1,72 @@
+// Package settlement defines a hand-written interface over the
+// FastSettlementV3 contract so services can depend on behaviour rather than on
+// the generated binding types, and be unit-tested against Fake instead of a
+// chain.
+package settlement
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+)
+
+// The generated V3 binding types are the canonical Go representation of the
+// contract's structs and events.
+type (
+	Intent             = fastsettlementv3.IFastSettlementV3Intent
+	SwapCall           = fastsettlementv3.IFastSettlementV3SwapCall
+	IntentExecuted     = fastsettlementv3.Fastsettlementv3IntentExecuted
+	ExecutorUpdated    = fastsettlementv3.Fastsettlementv3ExecutorUpdated
+	TreasuryUpdated    = fastsettlementv3.Fastsettlementv3TreasuryUpdated
+	SwapTargetsUpdated = fastsettlementv3.Fastsettlementv3SwapTargetsUpdated
+)
+
+// ErrUnsupported is returned by adapters whose underlying ABI lacks a method,
+// e.g. the views missing from the IFastSettlementV3 interface.
+var ErrUnsupported = errors.New("settlement: method not in bound ABI")
+
+// Caller covers the contract's read-only methods.
+type Caller interface {
+	Executor(opts *bind.CallOpts) (common.Address, error)
+	Treasury(opts *bind.CallOpts) (common.Address, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	AllowedSwapTargets(opts *bind.CallOpts, target common.Address) (bool, error)
+	PERMIT2(opts *bind.CallOpts) (common.Address, error)
+	WETH(opts *bind.CallOpts) (common.Address, error)
+}
+
+// Transactor covers the contract's state-changing methods.
+type Transactor interface {
+	ExecuteWithPermit(opts *bind.TransactOpts, intent Intent, signature []byte, swapData SwapCall) (*types.Transaction, error)
+	ExecuteWithETH(opts *bind.TransactOpts, intent Intent, swapData SwapCall) (*types.Transaction, error)
+	SetSwapTargets(opts *bind.TransactOpts, targets []common.Address, allowed []bool) (*types.Transaction, error)
+	SetExecutor(opts *bind.TransactOpts, newExecutor common.Address) (*types.Transaction, error)
+	SetTreasury(opts *bind.TransactOpts, newTreasury common.Address) (*types.Transaction, error)
+	RescueTokens(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// Filterer covers the contract's event queries. Unlike the generated
+// iterators, Filter* methods return fully drained slices.
+type Filterer interface {
+	FilterIntentExecuted(opts *bind.FilterOpts, user, inputToken, outputToken []common.Address) ([]*IntentExecuted, error)
+	WatchIntentExecuted(opts *bind.WatchOpts, sink chan<- *IntentExecuted, user, inputToken, outputToken []common.Address) (event.Subscription, error)
+	ParseIntentExecuted(log types.Log) (*IntentExecuted, error)
+	FilterExecutorUpdated(opts *bind.FilterOpts, oldExecutor, newExecutor []common.Address) ([]*ExecutorUpdated, error)
+	FilterTreasuryUpdated(opts *bind.FilterOpts, oldTreasury, newTreasury []common.Address) ([]*TreasuryUpdated, error)
+	FilterSwapTargetsUpdated(opts *bind.FilterOpts) ([]*SwapTargetsUpdated, error)
+}
+
+// Settlement is the full surface of a FastSettlementV3 deployment.
+type Settlement interface {
+	Caller
+	Transactor
+	Filterer
+
+	// Address returns the contract (proxy) address.
+	Address() common.Address
+}
@@ -0,0 +1,323 @@
+package settlement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	fastsettlementv2 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV2"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+)
+
+// Version identifies a settlement contract generation.
+type Version uint8
+
+const (
+	VersionUnknown Version = iota
+	Version2
+	Version3
+)
+
+func (v Version) String() string {
+	switch v {
+	case Version2:
+		return "v2"
+	case Version3:
+		return "v3"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrUnknownVersion is returned when the contract at an address answers
+// neither the V2 nor the V3 probe.
+var ErrUnknownVersion = errors.New("settlement: contract is neither FastSettlementV2 nor FastSettlementV3")
+
+// V2 event types.
+type (
+	IntentV2      = fastsettlementv2.IFastSettlementV2Intent
+	IntentSettled = fastsettlementv2.Fastsettlementv2IntentSettled
+)
+
+var intentSettledTopic common.Hash
+
+func init() {
+	v2, err := fastsettlementv2.Fastsettlementv2MetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	intentSettledTopic = v2.Events["IntentSettled"].ID
+}
+
+// DetectVersion probes address with views only one generation implements:
+// WETH() for V3 and getMinNonce(address) for V2. Neither contract has a
+// fallback, so the other generation's probe reverts.
+func DetectVersion(ctx context.Context, address common.Address, backend bind.ContractBackend) (Version, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	v3, err := fastsettlementv3.NewFastsettlementv3Caller(address, backend)
+	if err != nil {
+		return VersionUnknown, err
+	}
+	v3Err := func() error { _, err := v3.WETH(opts); return err }()
+	if v3Err == nil {
+		return Version3, nil
+	}
+	if errors.Is(v3Err, bind.ErrNoCode) {
+		return VersionUnknown, v3Err
+	}
+	v2, err := fastsettlementv2.NewFastsettlementv2Caller(address, backend)
+	if err != nil {
+		return VersionUnknown, err
+	}
+	if _, err := v2.GetMinNonce(opts, common.Address{}); err != nil {
+		return VersionUnknown, fmt.Errorf("%w: v3 probe: %v; v2 probe: %v", ErrUnknownVersion, v3Err, err)
+	}
+	return Version2, nil
+}
+
+// Record is a settlement normalized across contract generations, decoded
+// from either a V2 IntentSettled or a V3 IntentExecuted log.
+type Record struct {
+	Version  Version
+	Contract common.Address
+
+	// User is the intent signer: V2 maker, V3 user.
+	User        common.Address
+	InputToken  common.Address
+	OutputToken common.Address
+	InputAmt    *big.Int
+	// UserAmtOut is the amount paid to the recipient: V2 amountOut, V3
+	// userAmtOut.
+	UserAmtOut *big.Int
+	// Received is the output the settlement obtained before paying the user.
+	// V2 does not log it; it is reconstructed as amountOut + protocolAmt.
+	Received *big.Int
+	// Surplus is what the protocol kept: V2 protocolAmt, V3 surplus. V2 only
+	// captures surplus for ETH output, so ERC-20 V2 settlements report zero.
+	Surplus *big.Int
+
+	// IntentID is the V2 EIP-712 intent hash. V3 does not log an intent id
+	// and leaves it zero.
+	IntentID common.Hash
+
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// RecordFromV2 normalizes a V2 IntentSettled event.
+func RecordFromV2(ev *IntentSettled) *Record {
+	return &Record{
+		Version:     Version2,
+		Contract:    ev.Raw.Address,
+		User:        ev.Maker,
+		InputToken:  ev.TokenIn,
+		OutputToken: ev.TokenOut,
+		InputAmt:    ev.AmountIn,
+		UserAmtOut:  ev.AmountOut,
+		Received:    new(big.Int).Add(ev.AmountOut, ev.ProtocolAmt),
+		Surplus:     ev.ProtocolAmt,
+		IntentID:    ev.IntentId,
+		Raw:         ev.Raw,
+	}
+}
+
+// RecordFromV3 normalizes a V3 IntentExecuted event.
+func RecordFromV3(ev *IntentExecuted) *Record {
+	return &Record{
+		Version:     Version3,
+		Contract:    ev.Raw.Address,
+		User:        ev.User,
+		InputToken:  ev.InputToken,
+		OutputToken: ev.OutputToken,
+		InputAmt:    ev.InputAmt,
+		UserAmtOut:  ev.UserAmtOut,
+		Received:    ev.Received,
+		Surplus:     ev.Surplus,
+		Raw:         ev.Raw,
+	}
+}
+
+// Client reads settlements from either contract generation.
+type Client struct {
+	address common.Address
+	version Version
+	v2      *fastsettlementv2.Fastsettlementv2
+	v3      *V3
+}
+
+// NewClient detects the version deployed at address and binds it.
+func NewClient(ctx context.Context, address common.Address, backend bind.ContractBackend) (*Client, error) {
+	version, err := DetectVersion(ctx, address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientVersion(address, backend, version)
+}
+
+// NewClientVersion binds address as the given version without probing, for
+// historical reads at blocks where the probe would fail, e.g. a V2 address
+// whose code has since been removed.
+func NewClientVersion(address common.Address, backend bind.ContractBackend, version Version) (*Client, error) {
+	c := &Client{address: address, version: version}
+	var err error
+	switch version {
+	case Version2:
+		c.v2, err = fastsettlementv2.NewFastsettlementv2(address, backend)
+	case Version3:
+		c.v3, err = NewV3(address, backend)
+	default:
+		return nil, ErrUnknownVersion
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Address returns the bound contract address.
+func (c *Client) Address() common.Address { return c.address }
+
+// Version returns the bound contract generation.
+func (c *Client) Version() Version { return c.version }
+
+// V2 returns the V2 binding, or nil when the client is bound to V3.
+func (c *Client) V2() *fastsettlementv2.Fastsettlementv2 { return c.v2 }
+
+// V3 returns the V3 adapter, or nil when the client is bound to V2.
+func (c *Client) V3() Settlement {
+	if c.v3 == nil {
+		return nil
+	}
+	return c.v3
+}
+
+// FilterSettlements returns the settlements matching the optional user and
+// input token filters, which are indexed in both generations.
+func (c *Client) FilterSettlements(opts *bind.FilterOpts, user, inputToken []common.Address) ([]*Record, error) {
+	var out []*Record
+	switch c.version {
+	case Version2:
+		it, err := c.v2.FilterIntentSettled(opts, nil, user, inputToken)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		for it.Next() {
+			out = append(out, RecordFromV2(it.Event))
+		}
+		return out, it.Error()
+	default:
+		events, err := c.v3.FilterIntentExecuted(opts, user, inputToken, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, ev := range events {
+			out = append(out, RecordFromV3(ev))
+		}
+		return out, nil
+	}
+}
+
+// WatchSettlements streams normalized settlements into sink.
+func (c *Client) WatchSettlements(opts *bind.WatchOpts, sink chan<- *Record, user, inputToken []common.Address) (event.Subscription, error) {
+	switch c.version {
+	case Version2:
+		inner := make(chan *IntentSettled)
+		sub, err := c.v2.WatchIntentSettled(opts, inner, nil, user, inputToken)
+		if err != nil {
+			return nil, err
+		}
+		return forward(sub, inner, sink, RecordFromV2), nil
+	default:
+		inner := make(chan *IntentExecuted)
+		sub, err := c.v3.WatchIntentExecuted(opts, inner, user, inputToken, nil)
+		if err != nil {
+			return nil, err
+		}
+		return forward(sub, inner, sink, RecordFromV3), nil
+	}
+}
+
+func forward[T any](sub event.Subscription, inner <-chan T, sink chan<- *Record, convert func(T) *Record) event.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-inner:
+				select {
+				case sink <- convert(ev):
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	})
+}
+
+// ParseSettlement decodes a V2 IntentSettled or V3 IntentExecuted log. The
+// log's first topic selects the decoder, so logs from either generation can
+// be passed to a client of either version.
+func ParseSettlement(log types.Log) (*Record, error) {
+	if len(log.Topics) == 0 {
+		return nil, errors.New("settlement: log has no topics")
+	}
+	switch log.Topics[0] {
+	case intentSettledTopic:
+		f, err := fastsettlementv2.NewFastsettlementv2Filterer(log.Address, nil)
+		if err != nil {
+			return nil, err
+		}
+		ev, err := f.ParseIntentSettled(log)
+		if err != nil {
+			return nil, err
+		}
+		return RecordFromV2(ev), nil
+	case IntentExecutedTopic:
+		f, err := fastsettlementv3.NewFastsettlementv3Filterer(log.Address, nil)
+		if err != nil {
+			return nil, err
+		}
+		ev, err := f.ParseIntentExecuted(log)
+		if err != nil {
+			return nil, err
+		}
+		return RecordFromV3(ev), nil
+	default:
+		return nil, fmt.Errorf("settlement: log topic %s is not a settlement event", log.Topics[0])
+	}
+}
+
+// History filters every client and returns their settlements in chain order,
+// so analytics can span a V2 → V3 migration.
+func History(opts *bind.FilterOpts, user []common.Address, clients ...*Client) ([]*Record, error) {
+	var out []*Record
+	for _, c := range clients {
+		records, err := c.FilterSettlements(opts, user, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", c.version, c.address, err)
+		}
+		out = append(out, records...)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i].Raw, out[j].Raw
+		if a.BlockNumber != b.BlockNumber {
+			return a.BlockNumber < b.BlockNumber
+		}
+		if a.TxIndex != b.TxIndex {
+			return a.TxIndex < b.TxIndex
+		}
+		return a.Index < b.Index
+	})
+	return out, nil
+}
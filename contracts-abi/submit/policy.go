@@ -0,0 +1,161 @@
+package submit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrNotIncluded is returned when no route landed the transaction.
+var ErrNotIncluded = errors.New("submit: transaction not included")
+
+// ChainReader is the part of ethclient.Client inclusion tracking needs.
+type ChainReader interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error)
+}
+
+// Route is one step of a Policy.
+type Route struct {
+	Submitter Submitter
+	// Blocks is how many blocks to wait for inclusion before falling back to
+	// the next route. Defaults to 3.
+	Blocks uint64
+	// Resubmit sends the transaction again for every new block, as one-block
+	// bundles require.
+	Resubmit bool
+}
+
+// Policy submits through its routes in order until the transaction is mined.
+// Falling back reuses the same signed transaction, so a later route can
+// never double-execute an intent: at most one copy can be mined.
+type Policy struct {
+	Chain  ChainReader
+	Routes []Route
+	// PollInterval between inclusion checks. Defaults to 2s.
+	PollInterval time.Duration
+	// Logger defaults to slog.Default().
+	Logger *slog.Logger
+}
+
+// Attempt records one route's handling of a transaction.
+type Attempt struct {
+	Route       string
+	Submissions int
+	// FirstBlock and LastBlock are the heads seen while on the route.
+	FirstBlock uint64
+	LastBlock  uint64
+	// Err is the last submission error, if any.
+	Err error
+}
+
+// Inclusion reports how a transaction landed.
+type Inclusion struct {
+	Receipt *types.Receipt
+	// Route is the route that was active when the receipt appeared. A
+	// transaction can still be mined from an earlier route's delivery.
+	Route    string
+	Attempts []Attempt
+}
+
+// Send delivers tx and waits for its receipt. It returns ErrNotIncluded, with
+// the attempts made, when every route times out.
+func (p *Policy) Send(ctx context.Context, tx *types.Transaction) (*Inclusion, error) {
+	if len(p.Routes) == 0 {
+		return nil, errors.New("submit: policy has no routes")
+	}
+	poll := p.PollInterval
+	if poll == 0 {
+		poll = 2 * time.Second
+	}
+	log := p.Logger
+	if log == nil {
+		log = slog.Default()
+	}
+	log = log.With("tx", tx.Hash())
+
+	inc := &Inclusion{}
+	for _, route := range p.Routes {
+		blocks := route.Blocks
+		if blocks == 0 {
+			blocks = 3
+		}
+		name := route.Submitter.Name()
+		inc.Route = name
+		head, err := p.Chain.BlockNumber(ctx)
+		if err != nil {
+			return inc, err
+		}
+		att := Attempt{Route: name, FirstBlock: head, LastBlock: head}
+		var submitted uint64
+		for {
+			if receipt, err := p.receipt(ctx, log, tx.Hash()); err != nil {
+				return inc, err
+			} else if receipt != nil {
+				inc.Receipt = receipt
+				inc.Attempts = append(inc.Attempts, att)
+				log.Info("transaction included", "route", name, "block", receipt.BlockNumber, "status", receipt.Status)
+				return inc, nil
+			}
+			if head >= att.FirstBlock+blocks {
+				break
+			}
+			if submitted == 0 || (route.Resubmit && head+1 > submitted) {
+				submitted = head + 1
+				att.Submissions++
+				if err := route.Submitter.Submit(ctx, tx, submitted); err != nil {
+					att.Err = err
+					log.Warn("submission failed", "route", name, "target", submitted, "err", err)
+					if !route.Resubmit {
+						break
+					}
+				} else {
+					log.Debug("submitted", "route", name, "target", submitted)
+				}
+			}
+			select {
+			case <-ctx.Done():
+				inc.Attempts = append(inc.Attempts, att)
+				return inc, ctx.Err()
+			case <-time.After(poll):
+			}
+			if head, err = p.Chain.BlockNumber(ctx); err != nil {
+				return inc, err
+			}
+			att.LastBlock = head
+		}
+		inc.Attempts = append(inc.Attempts, att)
+		log.Info("falling back", "route", name, "blocks", att.LastBlock-att.FirstBlock, "err", att.Err)
+	}
+	// A late inclusion from the last route still counts.
+	if receipt, err := p.receipt(ctx, log, tx.Hash()); err != nil {
+		return inc, err
+	} else if receipt != nil {
+		inc.Receipt = receipt
+		return inc, nil
+	}
+	return inc, fmt.Errorf("%w after %d routes", ErrNotIncluded, len(p.Routes))
+}
+
+// receipt returns the transaction's receipt, or nil while it is pending.
+// Like bind.WaitMined it treats lookup errors other than cancellation as
+// transient: nodes report "transaction indexing is in progress" and similar
+// conditions for transactions they will find later.
+func (p *Policy) receipt(ctx context.Context, log *slog.Logger, hash common.Hash) (*types.Receipt, error) {
+	r, err := p.Chain.TransactionReceipt(ctx, hash)
+	switch {
+	case err == nil:
+		return r, nil
+	case ctx.Err() != nil:
+		return nil, ctx.Err()
+	case !errors.Is(err, ethereum.NotFound):
+		log.Debug("receipt lookup failed", "err", err)
+	}
+	return nil, nil
+}
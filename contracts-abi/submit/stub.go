@@ -0,0 +1,117 @@
+package submit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// StubCall is one request received by a StubServer.
+type StubCall struct {
+	Method string
+	Params json.RawMessage
+	Header http.Header
+}
+
+// StubServer is a local JSON-RPC relay. It answers eth_sendRawTransaction
+// with the transaction hash and eth_sendBundle with a bundle hash, passing
+// every decoded transaction to OnTx, e.g. to send it on to a simulated
+// backend. Other methods return a method-not-found error.
+type StubServer struct {
+	*httptest.Server
+
+	// OnTx receives the transactions of each request. Returning an error
+	// fails the request with that message.
+	OnTx func(method string, tx *types.Transaction) error
+
+	mu    sync.Mutex
+	calls []StubCall
+}
+
+// NewStubServer starts a StubServer. Close it when done.
+func NewStubServer(onTx func(method string, tx *types.Transaction) error) *StubServer {
+	s := &StubServer{OnTx: onTx}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Calls returns the requests received so far.
+func (s *StubServer) Calls() []StubCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]StubCall(nil), s.calls...)
+}
+
+func (s *StubServer) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	s.calls = append(s.calls, StubCall{Method: req.Method, Params: req.Params, Header: r.Header.Clone()})
+	s.mu.Unlock()
+
+	result, rpcErr := s.handle(req.Method, req.Params)
+	resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+	if rpcErr != nil {
+		resp["error"] = rpcErr
+	} else {
+		resp["result"] = result
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (s *StubServer) handle(method string, params json.RawMessage) (interface{}, *RPCError) {
+	switch method {
+	case "eth_sendRawTransaction":
+		var args []hexutil.Bytes
+		if err := json.Unmarshal(params, &args); err != nil || len(args) != 1 {
+			return nil, &RPCError{Code: -32602, Message: "expected one raw transaction"}
+		}
+		tx, rpcErr := s.deliver(method, args[0])
+		if rpcErr != nil {
+			return nil, rpcErr
+		}
+		return tx.Hash(), nil
+	case "eth_sendBundle":
+		var args []BundleArgs
+		if err := json.Unmarshal(params, &args); err != nil || len(args) != 1 || len(args[0].Txs) == 0 {
+			return nil, &RPCError{Code: -32602, Message: "expected one bundle with transactions"}
+		}
+		var hashes []byte
+		for _, raw := range args[0].Txs {
+			tx, rpcErr := s.deliver(method, raw)
+			if rpcErr != nil {
+				return nil, rpcErr
+			}
+			hashes = append(hashes, tx.Hash().Bytes()...)
+		}
+		return map[string]interface{}{"bundleHash": crypto.Keccak256Hash(hashes)}, nil
+	}
+	return nil, &RPCError{Code: -32601, Message: fmt.Sprintf("method %s not found", method)}
+}
+
+func (s *StubServer) deliver(method string, raw []byte) (*types.Transaction, *RPCError) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return nil, &RPCError{Code: -32602, Message: err.Error()}
+	}
+	if s.OnTx != nil {
+		if err := s.OnTx(method, tx); err != nil {
+			return nil, &RPCError{Code: -32000, Message: err.Error()}
+		}
+	}
+	return tx, nil
+}
@@ -0,0 +1,233 @@
+// Package submit delivers the executor's signed settlement transactions.
+// Transactions in the public mempool expose their SwapCall to sandwiching, so
+// submission is pluggable: a Submitter hands a transaction to one channel —
+// the node's mempool, an eth_sendBundle relay, or a Fast RPC / mev-commit
+// preconfirmation endpoint — and a Policy tries routes in order, tracking
+// inclusion and falling back when a route does not land the transaction in
+// time.
+//
+// StubServer is a local JSON-RPC endpoint speaking both eth_sendBundle and
+// eth_sendRawTransaction, for exercising the relay paths without a relay.
+package submit
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// FastRPCURL is the public Fast RPC endpoint, which forwards transactions to
+// mev-commit providers for preconfirmation.
+const FastRPCURL = "https://fastrpc.mev-commit.xyz"
+
+// Submitter hands a signed transaction to one delivery channel.
+type Submitter interface {
+	// Name identifies the route in logs and inclusion reports.
+	Name() string
+	// Submit delivers tx. target is the block the transaction should land
+	// in; only bundle relays use it.
+	Submit(ctx context.Context, tx *types.Transaction, target uint64) error
+}
+
+// TxSender is the part of ethclient.Client Mempool needs.
+type TxSender interface {
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// Mempool submits through a node's eth_sendRawTransaction, i.e. the public
+// mempool.
+type Mempool struct {
+	Client TxSender
+}
+
+// Name implements Submitter.
+func (m Mempool) Name() string { return "mempool" }
+
+// Submit implements Submitter.
+func (m Mempool) Submit(ctx context.Context, tx *types.Transaction, _ uint64) error {
+	return m.Client.SendTransaction(ctx, tx)
+}
+
+// RPCError is a JSON-RPC error returned by a relay.
+type RPCError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func (e *RPCError) Error() string { return fmt.Sprintf("json-rpc error %d: %s", e.Code, e.Message) }
+
+// endpoint is a minimal JSON-RPC client. Unlike rpc.Client it can sign the
+// exact request body, as Flashbots-style relays require.
+type endpoint struct {
+	url    string
+	client *http.Client
+	header http.Header
+	// authKey, when set, signs each body into X-Flashbots-Signature.
+	authKey *ecdsa.PrivateKey
+}
+
+var requestID atomic.Uint64
+
+func (e *endpoint) call(ctx context.Context, result interface{}, method string, params ...interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+	body, err := json.Marshal(struct {
+		JSONRPC string        `json:"jsonrpc"`
+		ID      uint64        `json:"id"`
+		Method  string        `json:"method"`
+		Params  []interface{} `json:"params"`
+	}{"2.0", requestID.Add(1), method, params})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, vs := range e.header {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.authKey != nil {
+		sig, err := crypto.Sign(accounts.TextHash([]byte(crypto.Keccak256Hash(body).Hex())), e.authKey)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("X-Flashbots-Signature", crypto.PubkeyToAddress(e.authKey.PublicKey).Hex()+":"+hexutil.Encode(sig))
+	}
+	client := e.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	var out struct {
+		Result json.RawMessage `json:"result"`
+		Error  *RPCError       `json:"error"`
+	}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return fmt.Errorf("%s: HTTP %d: %s", method, resp.StatusCode, bytes.TrimSpace(raw))
+	}
+	if out.Error != nil {
+		return out.Error
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: HTTP %d", method, resp.StatusCode)
+	}
+	if result == nil || len(out.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(out.Result, result)
+}
+
+// Raw posts eth_sendRawTransaction to a private endpoint, such as Fast RPC.
+type Raw struct {
+	name string
+	ep   endpoint
+}
+
+// NewRaw returns a Raw submitter for url. header is sent with every request,
+// e.g. an Authorization bearer token.
+func NewRaw(name, url string, header http.Header, client *http.Client) *Raw {
+	return &Raw{name: name, ep: endpoint{url: url, client: client, header: header}}
+}
+
+// NewFastRPC returns a Raw submitter for a Fast RPC endpoint; url defaults to
+// FastRPCURL.
+func NewFastRPC(url string, client *http.Client) *Raw {
+	if url == "" {
+		url = FastRPCURL
+	}
+	return NewRaw("fastrpc", url, nil, client)
+}
+
+// Name implements Submitter.
+func (r *Raw) Name() string { return r.name }
+
+// Submit implements Submitter.
+func (r *Raw) Submit(ctx context.Context, tx *types.Transaction, _ uint64) error {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	var hash common.Hash
+	if err := r.ep.call(ctx, &hash, "eth_sendRawTransaction", hexutil.Bytes(raw)); err != nil {
+		return err
+	}
+	if hash != tx.Hash() {
+		return fmt.Errorf("%s: endpoint returned hash %s for %s", r.name, hash, tx.Hash())
+	}
+	return nil
+}
+
+// BundleArgs is the eth_sendBundle parameter object.
+type BundleArgs struct {
+	Txs               []hexutil.Bytes `json:"txs"`
+	BlockNumber       hexutil.Uint64  `json:"blockNumber"`
+	MinTimestamp      *uint64         `json:"minTimestamp,omitempty"`
+	MaxTimestamp      *uint64         `json:"maxTimestamp,omitempty"`
+	RevertingTxHashes []common.Hash   `json:"revertingTxHashes,omitempty"`
+}
+
+// Bundle posts single-transaction bundles to an eth_sendBundle relay. A
+// bundle targets one block, so a Policy route using it should resubmit every
+// block.
+type Bundle struct {
+	name string
+	ep   endpoint
+	// LastHash is the bundle hash returned by the most recent Submit.
+	LastHash atomic.Pointer[common.Hash]
+}
+
+// NewBundle returns a Bundle submitter for url. authKey, when non-nil, signs
+// requests with the X-Flashbots-Signature header; it identifies the searcher
+// and need not hold funds.
+func NewBundle(name, url string, authKey *ecdsa.PrivateKey, client *http.Client) *Bundle {
+	return &Bundle{name: name, ep: endpoint{url: url, client: client, authKey: authKey}}
+}
+
+// Name implements Submitter.
+func (b *Bundle) Name() string { return b.name }
+
+// Submit implements Submitter.
+func (b *Bundle) Submit(ctx context.Context, tx *types.Transaction, target uint64) error {
+	if target == 0 {
+		return errors.New("submit: bundle needs a target block")
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	var res struct {
+		BundleHash common.Hash `json:"bundleHash"`
+	}
+	args := BundleArgs{Txs: []hexutil.Bytes{raw}, BlockNumber: hexutil.Uint64(target)}
+	if err := b.ep.call(ctx, &res, "eth_sendBundle", args); err != nil {
+		return err
+	}
+	b.LastHash.Store(&res.BundleHash)
+	return nil
+}
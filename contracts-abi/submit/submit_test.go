@@ -0,0 +1,189 @@
+package submit_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primev/fastprotocolapp/contracts-abi/submit"
+)
+
+func signedTx(t *testing.T, nonce uint64) *types.Transaction {
+	t.Helper()
+	key, _ := crypto.ToECDSA(crypto.Keccak256([]byte("executor")))
+	to := common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(1)), &types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(30e9),
+		Gas:       300_000,
+		To:        &to,
+		Data:      []byte{0xde, 0xad},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestRaw(t *testing.T) {
+	var got []*types.Transaction
+	stub := submit.NewStubServer(func(method string, tx *types.Transaction) error {
+		got = append(got, tx)
+		return nil
+	})
+	defer stub.Close()
+
+	tx := signedTx(t, 0)
+	raw := submit.NewRaw("fastrpc", stub.URL, http.Header{"Authorization": {"Bearer secret"}}, nil)
+	if err := raw.Submit(context.Background(), tx, 0); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Hash() != tx.Hash() {
+		t.Fatalf("stub received %v, want %s", got, tx.Hash())
+	}
+	calls := stub.Calls()
+	if calls[0].Method != "eth_sendRawTransaction" {
+		t.Errorf("method %s", calls[0].Method)
+	}
+	if h := calls[0].Header.Get("Authorization"); h != "Bearer secret" {
+		t.Errorf("Authorization %q", h)
+	}
+}
+
+func TestBundle(t *testing.T) {
+	stub := submit.NewStubServer(nil)
+	defer stub.Close()
+	authKey, _ := crypto.ToECDSA(crypto.Keccak256([]byte("searcher")))
+	b := submit.NewBundle("relay", stub.URL, authKey, nil)
+
+	tx := signedTx(t, 0)
+	if err := b.Submit(context.Background(), tx, 0); err == nil {
+		t.Fatal("bundle without a target block accepted")
+	}
+	if err := b.Submit(context.Background(), tx, 100); err != nil {
+		t.Fatal(err)
+	}
+	if want := crypto.Keccak256Hash(tx.Hash().Bytes()); *b.LastHash.Load() != want {
+		t.Errorf("bundle hash %s, want %s", b.LastHash.Load(), want)
+	}
+
+	call := stub.Calls()[0]
+	var args []submit.BundleArgs
+	if err := json.Unmarshal(call.Params, &args); err != nil {
+		t.Fatal(err)
+	}
+	if len(args) != 1 || uint64(args[0].BlockNumber) != 100 || len(args[0].Txs) != 1 {
+		t.Errorf("params %s", call.Params)
+	}
+	signer := crypto.PubkeyToAddress(authKey.PublicKey).Hex()
+	if sig := call.Header.Get("X-Flashbots-Signature"); !strings.HasPrefix(sig, signer+":0x") {
+		t.Errorf("X-Flashbots-Signature %q, want %s:<sig>", sig, signer)
+	}
+}
+
+func TestRelayError(t *testing.T) {
+	stub := submit.NewStubServer(func(string, *types.Transaction) error { return errors.New("nonce too low") })
+	defer stub.Close()
+	err := submit.NewFastRPC(stub.URL, nil).Submit(context.Background(), signedTx(t, 0), 0)
+	var rpcErr *submit.RPCError
+	if !errors.As(err, &rpcErr) || rpcErr.Message != "nonce too low" {
+		t.Fatalf("err = %v, want the relay's RPCError", err)
+	}
+}
+
+// chain advances one block per BlockNumber call and mines transactions
+// handed to it.
+type chain struct {
+	mu    sync.Mutex
+	head  uint64
+	mined map[common.Hash]*types.Receipt
+}
+
+func (c *chain) mine(tx *types.Transaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.mined[tx.Hash()] = &types.Receipt{TxHash: tx.Hash(), Status: types.ReceiptStatusSuccessful, BlockNumber: new(big.Int).SetUint64(c.head + 1)}
+	return nil
+}
+
+func (c *chain) BlockNumber(context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head++
+	return c.head, nil
+}
+
+func (c *chain) TransactionReceipt(_ context.Context, hash common.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if r, ok := c.mined[hash]; ok {
+		return r, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func TestPolicyFallback(t *testing.T) {
+	c := &chain{head: 100, mined: map[common.Hash]*types.Receipt{}}
+	// The private endpoint accepts the transaction but never lands it; the
+	// relay's bundles are mined.
+	private := submit.NewStubServer(nil)
+	defer private.Close()
+	relay := submit.NewStubServer(func(_ string, tx *types.Transaction) error { return c.mine(tx) })
+	defer relay.Close()
+
+	p := &submit.Policy{
+		Chain: c,
+		Routes: []submit.Route{
+			{Submitter: submit.NewFastRPC(private.URL, nil), Blocks: 2},
+			{Submitter: submit.NewBundle("relay", relay.URL, nil, nil), Blocks: 2, Resubmit: true},
+		},
+		PollInterval: time.Millisecond,
+	}
+	tx := signedTx(t, 0)
+	inc, err := p.Send(context.Background(), tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inc.Route != "relay" || inc.Receipt.TxHash != tx.Hash() {
+		t.Fatalf("included via %s with %v, want relay", inc.Route, inc.Receipt)
+	}
+	if len(inc.Attempts) != 2 || inc.Attempts[0].Route != "fastrpc" || inc.Attempts[0].Submissions != 1 {
+		t.Fatalf("attempts %+v, want one fastrpc submission then relay", inc.Attempts)
+	}
+	if n := len(private.Calls()); n != 1 {
+		t.Errorf("private endpoint called %d times, want 1", n)
+	}
+}
+
+func TestPolicyNotIncluded(t *testing.T) {
+	c := &chain{mined: map[common.Hash]*types.Receipt{}}
+	stub := submit.NewStubServer(nil)
+	defer stub.Close()
+	p := &submit.Policy{
+		Chain: c,
+		Routes: []submit.Route{
+			{Submitter: submit.NewBundle("relay", stub.URL, nil, nil), Blocks: 3, Resubmit: true},
+		},
+		PollInterval: time.Millisecond,
+	}
+	inc, err := p.Send(context.Background(), signedTx(t, 0))
+	if !errors.Is(err, submit.ErrNotIncluded) {
+		t.Fatalf("err = %v, want ErrNotIncluded", err)
+	}
+	// A one-block bundle is resubmitted for every block of the route.
+	if got := inc.Attempts[0].Submissions; got != 3 {
+		t.Errorf("%d submissions, want 3", got)
+	}
+}
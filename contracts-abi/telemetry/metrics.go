@@ -0,0 +1,100 @@
+package telemetry
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics exposes the settlement flow to Prometheus.
+type Metrics struct {
+	Received  prometheus.Counter
+	Simulated *prometheus.CounterVec
+	Submitted prometheus.Counter
+	// Routed counts deliveries by submission route and result.
+	Routed *prometheus.CounterVec
+	// Settled counts IntentExecuted logs.
+	Settled prometheus.Counter
+	// Failed counts intents that did not settle, by stage.
+	Failed *prometheus.CounterVec
+	// Reverts counts decoded revert reasons by stage and contract error name.
+	Reverts *prometheus.CounterVec
+	GasUsed prometheus.Histogram
+	// Surplus is the cumulative surplus per output token, in the token's
+	// base units.
+	Surplus *prometheus.CounterVec
+	// Latency is the time from signature to the observed IntentExecuted log.
+	Latency prometheus.Histogram
+}
+
+// NewMetrics creates the settlement flow collectors and registers them with
+// reg when it is non-nil.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		Received: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "intents",
+			Name:      "received_total",
+			Help:      "Intents accepted for execution.",
+		}),
+		Simulated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "intents",
+			Name:      "simulated_total",
+			Help:      "Pre-submission simulations by result (ok, revert, error).",
+		}, []string{"result"}),
+		Submitted: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "intents",
+			Name:      "submitted_total",
+			Help:      "Settlement transactions built for intents, including replacements.",
+		}),
+		Routed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "intents",
+			Name:      "routed_total",
+			Help:      "Settlement transaction deliveries by route and result.",
+		}, []string{"route", "result"}),
+		Settled: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "intents",
+			Name:      "settled_total",
+			Help:      "IntentExecuted logs observed.",
+		}),
+		Failed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "intents",
+			Name:      "failed_total",
+			Help:      "Intents that failed, by stage.",
+		}, []string{"stage"}),
+		Reverts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "intents",
+			Name:      "reverts_total",
+			Help:      "Decoded reverts by stage and contract error name.",
+		}, []string{"stage", "error"}),
+		GasUsed: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "intents",
+			Name:      "gas_used",
+			Help:      "Gas used by mined settlement transactions.",
+			Buckets:   prometheus.LinearBuckets(100_000, 100_000, 10),
+		}),
+		Surplus: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "intents",
+			Name:      "surplus_total",
+			Help:      "Cumulative IntentExecuted surplus by output token, in base units.",
+		}, []string{"token"}),
+		Latency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "fastsettlement",
+			Subsystem: "intents",
+			Name:      "settlement_latency_seconds",
+			Help:      "Time from intent signature to IntentExecuted.",
+			Buckets:   []float64{1, 2, 4, 8, 12, 16, 24, 36, 60, 120, 300},
+		}),
+	}
+	if reg != nil {
+		reg.MustRegister(m.Received, m.Simulated, m.Submitted, m.Routed, m.Settled,
+			m.Failed, m.Reverts, m.GasUsed, m.Surplus, m.Latency)
+	}
+	return m
+}
@@ -0,0 +1,449 @@
+// Package telemetry instruments the settlement flow with Prometheus metrics
+// and OpenTelemetry traces.
+//
+// Each intent gets a trace keyed by its witness hash (permit2.WitnessHash):
+// the trace ID is the hash's first 16 bytes, so the intake server, the
+// executor and the indexer add spans to the same trace without propagating
+// context between them, and a trace can be looked up from the hash alone.
+// The root "intent" span runs from signature to IntentExecuted, with one
+// child per stage:
+//
+//	intent ─┬─ receive    signature → accepted by intake
+//	        ├─ simulate   accepted → simulated
+//	        ├─ submit     simulated → transaction handed to a route
+//	        └─ include    submitted → mined
+//
+// Services report milestones through Received, Simulated, Submitted, Mined,
+// Executed and Failed, or wrap the settlement client and submitters with
+// WrapSettlement and WrapSubmitter. In the executor, intake.Config.Telemetry
+// reports Received for every accepted intent, batch.Config.Telemetry reports
+// Simulated, Submitted and Failed for intents the Batcher plans and sends,
+// and WrapSettlement covers intents sent through the settlement client
+// directly. Executed is fed by the indexer or a wrapped WatchIntentExecuted.
+//
+// An intent whose IntentExecuted log is never seen — dropped by every route,
+// or settled while nothing was watching — stops being tracked Retention after
+// its deadline and is counted as failed at the expired stage.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation scope of the package's spans.
+const TracerName = "github.com/primev/fastprotocolapp/contracts-abi/telemetry"
+
+// Span attribute keys.
+const (
+	AttrWitnessHash = attribute.Key("intent.witness_hash")
+	AttrUser        = attribute.Key("intent.user")
+	AttrInputToken  = attribute.Key("intent.input_token")
+	AttrOutputToken = attribute.Key("intent.output_token")
+	AttrTxHash      = attribute.Key("tx.hash")
+	AttrRoute       = attribute.Key("tx.route")
+	AttrGasUsed     = attribute.Key("tx.gas_used")
+	AttrRevert      = attribute.Key("revert.name")
+)
+
+// Stages label reverts and failures by where they surfaced.
+const (
+	StageSimulate = "simulate"
+	StageSubmit   = "submit"
+	StageMined    = "mined"
+	StageExpired  = "expired"
+)
+
+var (
+	errReverted = errors.New("telemetry: settlement transaction reverted")
+	errExpired  = errors.New("telemetry: intent expired without an observed IntentExecuted")
+)
+
+// sweepInterval is the least time between scans for expired intents.
+const sweepInterval = time.Minute
+
+// Config configures Telemetry.
+type Config struct {
+	// Registerer receives the collectors; nil leaves them unregistered.
+	Registerer prometheus.Registerer
+	// TracerProvider defaults to otel.GetTracerProvider().
+	TracerProvider trace.TracerProvider
+	// Retention is how long an intent stays tracked past its deadline.
+	// Defaults to 10 minutes.
+	Retention time.Duration
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+// Telemetry records metrics and spans for intents in flight.
+type Telemetry struct {
+	Metrics *Metrics
+
+	tracer    trace.Tracer
+	now       func() time.Time
+	retention time.Duration
+
+	mu        sync.Mutex
+	flows     map[common.Hash]*flow
+	byTx      map[common.Hash][]common.Hash
+	nextSweep time.Time
+}
+
+// flow is the trace state of one intent.
+type flow struct {
+	hash     common.Hash
+	intent   settlement.Intent
+	signedAt time.Time
+	// expires is the intent's deadline plus the retention.
+	expires time.Time
+	// ctx carries the root span; last is the end of the previous stage.
+	ctx     context.Context
+	root    trace.Span
+	last    time.Time
+	txHash  common.Hash
+	include trace.Span
+}
+
+// New returns a Telemetry for cfg.
+func New(cfg Config) *Telemetry {
+	if cfg.TracerProvider == nil {
+		cfg.TracerProvider = otel.GetTracerProvider()
+	}
+	if cfg.Retention == 0 {
+		cfg.Retention = 10 * time.Minute
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	return &Telemetry{
+		Metrics:   NewMetrics(cfg.Registerer),
+		tracer:    cfg.TracerProvider.Tracer(TracerName),
+		now:       cfg.Now,
+		retention: cfg.Retention,
+		flows:     make(map[common.Hash]*flow),
+		byTx:      make(map[common.Hash][]common.Hash),
+	}
+}
+
+// TraceID returns the trace ID of the intent with the given witness hash.
+func TraceID(hash common.Hash) trace.TraceID {
+	var id trace.TraceID
+	copy(id[:], hash[:16])
+	return id
+}
+
+// traceContext returns ctx with a remote parent whose trace ID is derived
+// from hash, so the root span joins the intent's trace.
+func traceContext(ctx context.Context, hash common.Hash) context.Context {
+	var span trace.SpanID
+	copy(span[:], hash[16:24])
+	return trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    TraceID(hash),
+		SpanID:     span,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	}))
+}
+
+// Received starts tracking an intent. signedAt is when the user signed it,
+// e.g. the intake record's CreatedAt when the client does not report it; the
+// zero time means now. The returned context carries the intent's root span.
+func (t *Telemetry) Received(ctx context.Context, hash common.Hash, intent settlement.Intent, signedAt time.Time) context.Context {
+	now := t.now()
+	if signedAt.IsZero() || signedAt.After(now) {
+		signedAt = now
+	}
+	t.Metrics.Received.Inc()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sweep(now)
+	if f, ok := t.flows[hash]; ok {
+		return f.ctx
+	}
+	attrs := []attribute.KeyValue{
+		AttrWitnessHash.String(hash.Hex()),
+		AttrUser.String(intent.User.Hex()),
+		AttrInputToken.String(intent.InputToken.Hex()),
+		AttrOutputToken.String(intent.OutputToken.Hex()),
+	}
+	rootCtx, root := t.tracer.Start(traceContext(ctx, hash), "intent",
+		trace.WithTimestamp(signedAt), trace.WithAttributes(attrs...))
+	expires := now
+	if intent.Deadline != nil && intent.Deadline.IsInt64() {
+		expires = time.Unix(intent.Deadline.Int64(), 0)
+	}
+	f := &flow{hash: hash, intent: intent, signedAt: signedAt, expires: expires.Add(t.retention), ctx: rootCtx, root: root, last: signedAt}
+	t.flows[hash] = f
+	f.stage(t.tracer, "receive", now, nil)
+	return rootCtx
+}
+
+// stage records a child span from the end of the previous stage to end.
+func (f *flow) stage(tracer trace.Tracer, name string, end time.Time, err error, attrs ...attribute.KeyValue) {
+	_, span := tracer.Start(f.ctx, name, trace.WithTimestamp(f.last),
+		trace.WithAttributes(append(attrs, AttrWitnessHash.String(f.hash.Hex()))...))
+	fail(span, err)
+	span.End(trace.WithTimestamp(end))
+	f.last = end
+}
+
+// fail marks span as failed by err, decoding reverts into their error name.
+func fail(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	if rev := reverts.FromError(err); rev != nil {
+		span.SetAttributes(AttrRevert.String(revertName(rev)))
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// revertName labels a decoded revert; unknown selectors share one label to
+// bound metric cardinality.
+func revertName(rev *reverts.Error) string {
+	if rev.Name == "" {
+		return "unknown"
+	}
+	return rev.Name
+}
+
+// Simulated records a pre-submission simulation. A failed simulation ends
+// the intent's trace.
+func (t *Telemetry) Simulated(ctx context.Context, hash common.Hash, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+		if rev := reverts.FromError(err); rev != nil {
+			result = "revert"
+			t.Metrics.Reverts.WithLabelValues(StageSimulate, revertName(rev)).Inc()
+		}
+	}
+	t.Metrics.Simulated.WithLabelValues(result).Inc()
+	if err != nil {
+		t.Metrics.Failed.WithLabelValues(StageSimulate).Inc()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	f := t.flows[hash]
+	if f == nil {
+		return
+	}
+	now := t.now()
+	f.stage(t.tracer, "simulate", now, err)
+	if err != nil {
+		t.finish(f, now, err)
+	}
+}
+
+// Submitted records the settlement transaction carrying the intent and
+// opens its include span.
+func (t *Telemetry) Submitted(ctx context.Context, hash, txHash common.Hash) {
+	t.Metrics.Submitted.Inc()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	f := t.flows[hash]
+	if f == nil {
+		return
+	}
+	now := t.now()
+	if f.include != nil {
+		// Resubmitted, e.g. with a higher fee: the old transaction is replaced.
+		f.include.End(trace.WithTimestamp(now))
+		t.untrack(f)
+	} else {
+		f.stage(t.tracer, "submit", now, nil, AttrTxHash.String(txHash.Hex()))
+	}
+	f.txHash = txHash
+	_, f.include = t.tracer.Start(f.ctx, "include", trace.WithTimestamp(now),
+		trace.WithAttributes(AttrWitnessHash.String(hash.Hex()), AttrTxHash.String(txHash.Hex())))
+	t.byTx[txHash] = append(t.byTx[txHash], hash)
+}
+
+// Routed records the delivery of a submitted transaction through a route.
+func (t *Telemetry) Routed(ctx context.Context, txHash common.Hash, route string, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	t.Metrics.Routed.WithLabelValues(route, result).Inc()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, hash := range t.byTx[txHash] {
+		if f := t.flows[hash]; f != nil && f.include != nil {
+			attrs := trace.WithAttributes(AttrRoute.String(route))
+			if err != nil {
+				f.include.AddEvent("route failed", attrs, trace.WithAttributes(attribute.String("error", err.Error())))
+			} else {
+				f.include.AddEvent("routed", attrs)
+			}
+		}
+	}
+}
+
+// Mined records a settlement receipt's gas use. A reverted receipt fails
+// every intent it carried.
+func (t *Telemetry) Mined(ctx context.Context, receipt *types.Receipt) {
+	t.Metrics.GasUsed.Observe(float64(receipt.GasUsed))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	hashes := t.byTx[receipt.TxHash]
+	for _, hash := range hashes {
+		f := t.flows[hash]
+		if f == nil || f.include == nil {
+			continue
+		}
+		f.include.SetAttributes(AttrGasUsed.Int64(int64(receipt.GasUsed)))
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			// Receipts carry no revert data, so there is no name to count.
+			t.Metrics.Failed.WithLabelValues(StageMined).Inc()
+			t.finish(f, t.now(), errReverted)
+		}
+	}
+}
+
+// Executed records an IntentExecuted log, as seen by the indexer or read
+// from a receipt. Every log counts towards the settled and surplus metrics;
+// the intent it settled, when tracked, has its trace completed. Call it once
+// per log: backfills over already-observed ranges count twice.
+func (t *Telemetry) Executed(ctx context.Context, ev *settlement.IntentExecuted) {
+	t.Metrics.Settled.Inc()
+	if ev.Surplus != nil && ev.Surplus.Sign() > 0 {
+		f, _ := new(big.Float).SetInt(ev.Surplus).Float64()
+		t.Metrics.Surplus.WithLabelValues(ev.OutputToken.Hex()).Add(f)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	f := t.match(ev)
+	if f == nil {
+		return
+	}
+	now := t.now()
+	t.Metrics.Latency.Observe(now.Sub(f.signedAt).Seconds())
+	f.root.SetAttributes(AttrTxHash.String(ev.Raw.TxHash.Hex()))
+	t.finish(f, now, nil)
+}
+
+// Failed records a failure outside simulation, e.g. a revert while
+// estimating or sending the settlement transaction, or an expired intent,
+// and ends the intent's trace.
+func (t *Telemetry) Failed(ctx context.Context, hash common.Hash, err error) {
+	t.fail(hash, StageSubmit, err)
+}
+
+func (t *Telemetry) fail(hash common.Hash, stage string, err error) {
+	if rev := reverts.FromError(err); rev != nil {
+		t.Metrics.Reverts.WithLabelValues(stage, revertName(rev)).Inc()
+	}
+	t.Metrics.Failed.WithLabelValues(stage).Inc()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if f := t.flows[hash]; f != nil {
+		t.finish(f, t.now(), err)
+	}
+}
+
+// match finds the tracked flow an IntentExecuted log settles: first among
+// the intents submitted in its transaction, then among all tracked intents,
+// since the transaction that landed need not be the one reported. The log
+// carries no nonce, so a fallback that finds several identical intents
+// matches none rather than guess.
+func (t *Telemetry) match(ev *settlement.IntentExecuted) *flow {
+	for _, hash := range t.byTx[ev.Raw.TxHash] {
+		if f := t.flows[hash]; f != nil && matches(f.intent, ev) {
+			return f
+		}
+	}
+	var found *flow
+	for _, f := range t.flows {
+		if !matches(f.intent, ev) {
+			continue
+		}
+		if found != nil {
+			return nil
+		}
+		found = f
+	}
+	return found
+}
+
+func matches(intent settlement.Intent, ev *settlement.IntentExecuted) bool {
+	return intent.User == ev.User && intent.InputToken == ev.InputToken &&
+		intent.OutputToken == ev.OutputToken && intent.InputAmt.Cmp(ev.InputAmt) == 0 &&
+		intent.UserAmtOut.Cmp(ev.UserAmtOut) == 0
+}
+
+// finish ends the flow's spans and stops tracking it.
+func (t *Telemetry) finish(f *flow, now time.Time, err error) {
+	if f.include != nil {
+		fail(f.include, err)
+		f.include.End(trace.WithTimestamp(now))
+	}
+	fail(f.root, err)
+	f.root.End(trace.WithTimestamp(now))
+	t.untrack(f)
+	delete(t.flows, f.hash)
+}
+
+func (t *Telemetry) untrack(f *flow) {
+	if f.txHash == (common.Hash{}) {
+		return
+	}
+	hashes := t.byTx[f.txHash]
+	for i, h := range hashes {
+		if h == f.hash {
+			hashes = append(hashes[:i:i], hashes[i+1:]...)
+			break
+		}
+	}
+	if len(hashes) == 0 {
+		delete(t.byTx, f.txHash)
+	} else {
+		t.byTx[f.txHash] = hashes
+	}
+}
+
+// sweep ends the traces of intents past their expiry, at most once per
+// sweepInterval.
+func (t *Telemetry) sweep(now time.Time) {
+	if now.Before(t.nextSweep) {
+		return
+	}
+	t.nextSweep = now.Add(sweepInterval)
+	for _, f := range t.flows {
+		if now.After(f.expires) {
+			t.Metrics.Failed.WithLabelValues(StageExpired).Inc()
+			t.finish(f, now, errExpired)
+		}
+	}
+}
+
+// Tracked returns the number of intents in flight, after dropping expired
+// ones.
+func (t *Telemetry) Tracked() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.nextSweep = time.Time{}
+	t.sweep(t.now())
+	return len(t.flows)
+}
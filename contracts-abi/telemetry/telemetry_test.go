@@ -0,0 +1,175 @@
+package telemetry_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"github.com/primev/fastprotocolapp/contracts-abi/telemetry"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var (
+	start = time.Unix(1_800_000_000, 0)
+	alice = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	usdc  = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	weth  = common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+)
+
+// clock is a settable time source.
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time          { return c.now }
+func (c *clock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTelemetry(c *clock) *telemetry.Telemetry {
+	return telemetry.New(telemetry.Config{Retention: time.Minute, Now: c.Now})
+}
+
+func intent(nonce int64, deadline time.Time) settlement.Intent {
+	return settlement.Intent{
+		User:        alice,
+		InputToken:  usdc,
+		OutputToken: weth,
+		InputAmt:    big.NewInt(1_000_000),
+		UserAmtOut:  big.NewInt(nonce),
+		Recipient:   alice,
+		Deadline:    big.NewInt(deadline.Unix()),
+		Nonce:       big.NewInt(nonce),
+	}
+}
+
+func executed(in settlement.Intent, tx common.Hash) *settlement.IntentExecuted {
+	return &settlement.IntentExecuted{
+		User:        in.User,
+		InputToken:  in.InputToken,
+		OutputToken: in.OutputToken,
+		InputAmt:    in.InputAmt,
+		UserAmtOut:  in.UserAmtOut,
+		Received:    new(big.Int).Add(in.UserAmtOut, big.NewInt(5)),
+		Surplus:     big.NewInt(5),
+		Raw:         types.Log{TxHash: tx},
+	}
+}
+
+func TestFlow(t *testing.T) {
+	c := &clock{now: start}
+	tel := newTelemetry(c)
+	ctx := context.Background()
+	in := intent(1, start.Add(time.Hour))
+	hash := common.HexToHash("0x01")
+	tx := common.HexToHash("0xaa")
+
+	tel.Received(ctx, hash, in, start.Add(-2*time.Second))
+	tel.Simulated(ctx, hash, nil)
+	tel.Submitted(ctx, hash, tx)
+	tel.Mined(ctx, &types.Receipt{TxHash: tx, Status: types.ReceiptStatusSuccessful, GasUsed: 150_000})
+	c.Advance(10 * time.Second)
+	tel.Executed(ctx, executed(in, tx))
+
+	if n := tel.Tracked(); n != 0 {
+		t.Fatalf("%d intents still tracked after IntentExecuted", n)
+	}
+	m := tel.Metrics
+	for name, got := range map[string]float64{
+		"received":  testutil.ToFloat64(m.Received),
+		"simulated": testutil.ToFloat64(m.Simulated.WithLabelValues("ok")),
+		"submitted": testutil.ToFloat64(m.Submitted),
+		"settled":   testutil.ToFloat64(m.Settled),
+		"surplus":   testutil.ToFloat64(m.Surplus.WithLabelValues(weth.Hex())),
+	} {
+		want := 1.0
+		if name == "surplus" {
+			want = 5
+		}
+		if got != want {
+			t.Errorf("%s = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestRevertedReceipt(t *testing.T) {
+	c := &clock{now: start}
+	tel := newTelemetry(c)
+	ctx := context.Background()
+	tx := common.HexToHash("0xaa")
+	for i, hash := range []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")} {
+		tel.Received(ctx, hash, intent(int64(i), start.Add(time.Hour)), time.Time{})
+		tel.Submitted(ctx, hash, tx)
+	}
+	tel.Mined(ctx, &types.Receipt{TxHash: tx, Status: types.ReceiptStatusFailed})
+	if n := tel.Tracked(); n != 0 {
+		t.Fatalf("%d intents tracked after their transaction reverted", n)
+	}
+	if got := testutil.ToFloat64(tel.Metrics.Failed.WithLabelValues(telemetry.StageMined)); got != 2 {
+		t.Fatalf("mined failures = %v, want 2", got)
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	c := &clock{now: start}
+	tel := newTelemetry(c)
+	ctx := context.Background()
+	early := intent(1, start.Add(time.Minute))
+	late := intent(2, start.Add(time.Hour))
+	tel.Received(ctx, common.HexToHash("0x01"), early, time.Time{})
+	tel.Submitted(ctx, common.HexToHash("0x01"), common.HexToHash("0xaa"))
+	tel.Received(ctx, common.HexToHash("0x02"), late, time.Time{})
+
+	// Deadline plus one minute of retention has not passed yet.
+	c.Advance(2 * time.Minute)
+	if n := tel.Tracked(); n != 2 {
+		t.Fatalf("tracked %d, want 2", n)
+	}
+	c.Advance(time.Second)
+	if n := tel.Tracked(); n != 1 {
+		t.Fatalf("tracked %d after the first intent's expiry, want 1", n)
+	}
+	if got := testutil.ToFloat64(tel.Metrics.Failed.WithLabelValues(telemetry.StageExpired)); got != 1 {
+		t.Fatalf("expired = %v, want 1", got)
+	}
+
+	// New intents sweep too, so a busy service needs no Tracked calls.
+	c.Advance(time.Hour)
+	tel.Received(ctx, common.HexToHash("0x03"), intent(3, c.now.Add(time.Hour)), time.Time{})
+	if got := testutil.ToFloat64(tel.Metrics.Failed.WithLabelValues(telemetry.StageExpired)); got != 2 {
+		t.Fatalf("expired = %v, want 2", got)
+	}
+	// A log for an evicted intent still counts as settled.
+	tel.Executed(ctx, executed(early, common.HexToHash("0xaa")))
+	if got := testutil.ToFloat64(tel.Metrics.Settled); got != 1 {
+		t.Fatalf("settled = %v, want 1", got)
+	}
+}
+
+func TestAmbiguousLog(t *testing.T) {
+	c := &clock{now: start}
+	tel := newTelemetry(c)
+	ctx := context.Background()
+	// Two intents differing only in nonce look the same in IntentExecuted.
+	first, second := intent(1, start.Add(time.Hour)), intent(2, start.Add(time.Hour))
+	second.UserAmtOut = first.UserAmtOut
+	tel.Received(ctx, common.HexToHash("0x01"), first, time.Time{})
+	tel.Received(ctx, common.HexToHash("0x02"), second, time.Time{})
+	tel.Submitted(ctx, common.HexToHash("0x02"), common.HexToHash("0xbb"))
+
+	// Landed in a transaction neither was reported in: no guess.
+	tel.Executed(ctx, executed(first, common.HexToHash("0xaa")))
+	if n := tel.Tracked(); n != 2 {
+		t.Fatalf("tracked %d after an ambiguous log, want 2", n)
+	}
+	// The reported transaction settles its own intent.
+	tel.Executed(ctx, executed(second, common.HexToHash("0xbb")))
+	if n := tel.Tracked(); n != 1 {
+		t.Fatalf("tracked %d, want 1", n)
+	}
+	// With one candidate left the fallback matches it.
+	tel.Executed(ctx, executed(first, common.HexToHash("0xcc")))
+	if n := tel.Tracked(); n != 0 {
+		t.Fatalf("tracked %d, want 0", n)
+	}
+}
@@ -0,0 +1,124 @@
+package telemetry
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+	"github.com/primev/fastprotocolapp/contracts-abi/submit"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumented is a settlement.Settlement reporting to a Telemetry.
+type instrumented struct {
+	settlement.Settlement
+	t *Telemetry
+}
+
+// WrapSettlement instruments s: ExecuteWithPermit and ExecuteWithETH report
+// Submitted, or Failed when they revert, and WatchIntentExecuted reports
+// every delivered log to Executed before passing it on.
+func WrapSettlement(t *Telemetry, s settlement.Settlement) settlement.Settlement {
+	return &instrumented{Settlement: s, t: t}
+}
+
+// ExecuteWithPermit implements settlement.Transactor.
+func (s *instrumented) ExecuteWithPermit(opts *bind.TransactOpts, intent settlement.Intent, signature []byte, swapData settlement.SwapCall) (*types.Transaction, error) {
+	tx, err := s.Settlement.ExecuteWithPermit(opts, intent, signature, swapData)
+	s.sent(opts, intent, tx, err)
+	return tx, err
+}
+
+// ExecuteWithETH implements settlement.Transactor.
+func (s *instrumented) ExecuteWithETH(opts *bind.TransactOpts, intent settlement.Intent, swapData settlement.SwapCall) (*types.Transaction, error) {
+	tx, err := s.Settlement.ExecuteWithETH(opts, intent, swapData)
+	s.sent(opts, intent, tx, err)
+	return tx, err
+}
+
+func (s *instrumented) sent(opts *bind.TransactOpts, intent settlement.Intent, tx *types.Transaction, err error) {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	hash := permit2.WitnessHash(intent)
+	switch {
+	case err == nil:
+		s.t.Submitted(ctx, hash, tx.Hash())
+	case reverts.FromError(err) != nil:
+		// Gas estimation reverted: the intent cannot settle as built.
+		s.t.Failed(ctx, hash, err)
+	default:
+		// Transport and nonce errors are retried by the executor.
+		s.t.event(hash, "send failed", attribute.String("error", err.Error()))
+	}
+}
+
+// WatchIntentExecuted implements settlement.Filterer.
+func (s *instrumented) WatchIntentExecuted(opts *bind.WatchOpts, sink chan<- *settlement.IntentExecuted, user, inputToken, outputToken []common.Address) (event.Subscription, error) {
+	logs := make(chan *settlement.IntentExecuted)
+	sub, err := s.Settlement.WatchIntentExecuted(opts, logs, user, inputToken, outputToken)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	if opts != nil && opts.Context != nil {
+		ctx = opts.Context
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-logs:
+				// Logs removed by a reorg were counted when first delivered.
+				if !ev.Raw.Removed {
+					s.t.Executed(ctx, ev)
+				}
+				select {
+				case sink <- ev:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// event adds an event to a tracked intent's root span.
+func (t *Telemetry) event(hash common.Hash, name string, attrs ...attribute.KeyValue) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if f := t.flows[hash]; f != nil {
+		f.root.AddEvent(name, trace.WithAttributes(attrs...))
+	}
+}
+
+// routed is a submit.Submitter reporting deliveries to a Telemetry.
+type routed struct {
+	submit.Submitter
+	t *Telemetry
+}
+
+// WrapSubmitter instruments sub so each delivery is reported to Routed.
+func WrapSubmitter(t *Telemetry, sub submit.Submitter) submit.Submitter {
+	return &routed{Submitter: sub, t: t}
+}
+
+// Submit implements submit.Submitter.
+func (r *routed) Submit(ctx context.Context, tx *types.Transaction, target uint64) error {
+	err := r.Submitter.Submit(ctx, tx, target)
+	r.t.Routed(ctx, tx.Hash(), r.Name(), err)
+	return err
+}
@@ -0,0 +1,148 @@
+package testharness
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/primev/fastprotocolapp/contracts-abi/userop"
+)
+
+// ErrNoSmartAccounts is returned by the smart account helpers when the
+// harness was started without Config.SmartAccounts.
+var ErrNoSmartAccounts = errors.New("testharness: smart accounts not deployed; set Config.SmartAccounts")
+
+func (h *Harness) deploySmartAccounts() error {
+	entryPoint, err := h.artifacts.get("HarnessEntryPoint")
+	if err != nil {
+		return err
+	}
+	factory, err := h.artifacts.get("HarnessAccountFactory")
+	if err != nil {
+		return err
+	}
+	if h.EntryPoint, err = h.Deploy(h.Owner, entryPoint); err != nil {
+		return err
+	}
+	h.AccountFactory, err = h.Deploy(h.Owner, factory, h.EntryPoint)
+	return err
+}
+
+// smartAccount returns the artifact of name after checking the EntryPoint
+// and factory were deployed.
+func (h *Harness) smartAccount(name string) (*Artifact, error) {
+	if h.EntryPoint == (common.Address{}) {
+		return nil, ErrNoSmartAccounts
+	}
+	return h.artifacts.get(name)
+}
+
+// SmartAccountAddress returns the counterfactual address of owner's
+// HarnessAccount at salt.
+func (h *Harness) SmartAccountAddress(owner common.Address, salt *big.Int) (common.Address, error) {
+	factory, err := h.smartAccount("HarnessAccountFactory")
+	if err != nil {
+		return common.Address{}, err
+	}
+	out, err := h.Call(factory, h.AccountFactory, "getAddress", owner, salt)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return out[0].(common.Address), nil
+}
+
+// DeploySmartAccount deploys owner's HarnessAccount at salt directly through
+// the factory, without a user operation.
+func (h *Harness) DeploySmartAccount(owner Account, salt *big.Int) (common.Address, error) {
+	factory, err := h.smartAccount("HarnessAccountFactory")
+	if err != nil {
+		return common.Address{}, err
+	}
+	if _, err := h.Transact(owner, factory, h.AccountFactory, "createAccount", owner.Address, salt); err != nil {
+		return common.Address{}, err
+	}
+	return h.SmartAccountAddress(owner.Address, salt)
+}
+
+// AccountInitCode sets op's factory fields so the EntryPoint deploys owner's
+// account at salt before validating op.
+func (h *Harness) AccountInitCode(op *userop.UserOperation, owner common.Address, salt *big.Int) error {
+	factory, err := h.smartAccount("HarnessAccountFactory")
+	if err != nil {
+		return err
+	}
+	data, err := factory.ABI.Pack("createAccount", owner, salt)
+	if err != nil {
+		return err
+	}
+	addr := h.AccountFactory
+	op.Factory, op.FactoryData = &addr, data
+	return nil
+}
+
+// NewUserOp returns an unsigned operation from sender making calls, with its
+// EntryPoint nonce, the chain's current fees and gas limits generous enough
+// for a settlement.
+func (h *Harness) NewUserOp(sender common.Address, calls ...userop.Call) (*userop.UserOperation, error) {
+	if h.EntryPoint == (common.Address{}) {
+		return nil, ErrNoSmartAccounts
+	}
+	ctx := context.Background()
+	callData, err := userop.CallData(calls...)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := userop.Nonce(ctx, h.Client, h.EntryPoint, sender, nil)
+	if err != nil {
+		return nil, err
+	}
+	tip, err := h.Client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	head, err := h.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	feeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, common.Big2))
+	return &userop.UserOperation{
+		Sender:               sender,
+		Nonce:                (*hexutil.Big)(nonce),
+		CallData:             callData,
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(2_000_000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(500_000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50_000)),
+		MaxFeePerGas:         (*hexutil.Big)(feeCap),
+		MaxPriorityFeePerGas: (*hexutil.Big)(tip),
+	}, nil
+}
+
+// SignUserOp signs op as owner against the harness EntryPoint.
+func (h *Harness) SignUserOp(owner Account, op *userop.UserOperation) error {
+	if h.EntryPoint == (common.Address{}) {
+		return ErrNoSmartAccounts
+	}
+	return op.Sign(owner.Key, h.EntryPoint, h.ChainID)
+}
+
+// HandleOps sends ops to the EntryPoint as bundler, who is also the
+// beneficiary of their gas payments. Senders must hold enough ETH, or an
+// EntryPoint deposit, to prefund their gas limits.
+func (h *Harness) HandleOps(bundler Account, ops ...*userop.UserOperation) (*types.Receipt, error) {
+	entryPoint, err := h.smartAccount("HarnessEntryPoint")
+	if err != nil {
+		return nil, err
+	}
+	packed := make([]userop.PackedUserOperation, len(ops))
+	for i, op := range ops {
+		p, err := op.Pack()
+		if err != nil {
+			return nil, err
+		}
+		packed[i] = p
+	}
+	return h.Transact(bundler, entryPoint, h.EntryPoint, "handleOps", packed, bundler.Address)
+}
@@ -0,0 +1,54 @@
+package testharness
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	imulticall3 "github.com/primev/fastprotocolapp/contracts-abi/clients/IMulticall3"
+)
+
+// DeployAggregator deploys an ExecutorAggregator owned by Executor and hands
+// it the settlement's executor role, as package batch expects. Intents are
+// then settled through Aggregate.
+func (h *Harness) DeployAggregator() (common.Address, error) {
+	a, err := h.artifacts.get("ExecutorAggregator")
+	if err != nil {
+		return common.Address{}, err
+	}
+	addr, err := h.Deploy(h.Owner, a, h.Executor.Address)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if _, err := h.Mine(h.Settlement.SetExecutor(h.Opts(h.Owner), addr)); err != nil {
+		return common.Address{}, fmt.Errorf("set executor: %w", err)
+	}
+	return addr, nil
+}
+
+// Aggregate sends calls through aggregator's aggregate3Value as Executor.
+func (h *Harness) Aggregate(aggregator common.Address, calls []imulticall3.IMulticall3Call3Value) (*types.Receipt, error) {
+	a, err := h.artifacts.get("ExecutorAggregator")
+	if err != nil {
+		return nil, err
+	}
+	return h.Transact(h.Executor, a, aggregator, "aggregate3Value", calls)
+}
+
+// DeployCowHelper deploys a CowSettlementHelper owned by Owner with operator
+// arming its legs, and allowlists it on the settlement contract. Its float is
+// funded with Mint or SendETH.
+func (h *Harness) DeployCowHelper(operator common.Address) (common.Address, error) {
+	a, err := h.artifacts.get("CowSettlementHelper")
+	if err != nil {
+		return common.Address{}, err
+	}
+	addr, err := h.Deploy(h.Owner, a, h.Owner.Address, operator)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if err := h.SetSwapTargets([]common.Address{addr}, []bool{true}); err != nil {
+		return common.Address{}, fmt.Errorf("allowlist helper: %w", err)
+	}
+	return addr, nil
+}
@@ -0,0 +1,137 @@
+package testharness
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Artifact is the subset of a Forge build artifact the harness needs to
+// deploy a contract.
+type Artifact struct {
+	Name             string
+	ABI              abi.ABI
+	Bytecode         []byte
+	DeployedBytecode []byte
+}
+
+type forgeArtifact struct {
+	ABI      json.RawMessage `json:"abi"`
+	Bytecode struct {
+		Object string `json:"object"`
+	} `json:"bytecode"`
+	DeployedBytecode struct {
+		Object string `json:"object"`
+	} `json:"deployedBytecode"`
+}
+
+// LoadArtifact reads out/<file>/<contract>.json from a Forge output
+// directory, e.g. LoadArtifact("../contracts/out", "FastSettlementV3.sol",
+// "FastSettlementV3").
+func LoadArtifact(outDir, file, contract string) (*Artifact, error) {
+	path := filepath.Join(outDir, file, contract+".json")
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read artifact %s: %w", path, err)
+	}
+	var fa forgeArtifact
+	if err := json.Unmarshal(raw, &fa); err != nil {
+		return nil, fmt.Errorf("decode artifact %s: %w", path, err)
+	}
+	parsed, err := abi.JSON(strings.NewReader(string(fa.ABI)))
+	if err != nil {
+		return nil, fmt.Errorf("parse abi %s: %w", path, err)
+	}
+	code, err := hexutil.Decode(fa.Bytecode.Object)
+	if err != nil {
+		return nil, fmt.Errorf("decode bytecode %s: %w", path, err)
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("artifact %s has no creation bytecode (abstract contract or interface?)", path)
+	}
+	deployed, err := hexutil.Decode(fa.DeployedBytecode.Object)
+	if err != nil {
+		return nil, fmt.Errorf("decode deployed bytecode %s: %w", path, err)
+	}
+	return &Artifact{Name: contract, ABI: parsed, Bytecode: code, DeployedBytecode: deployed}, nil
+}
+
+// artifactSet holds the contracts every harness deploys, and loads the ones
+// deployed on request from dir the first time they are asked for.
+type artifactSet struct {
+	dir string
+
+	settlement *Artifact
+	proxy      *Artifact
+	permit2    *Artifact
+	weth       *Artifact
+	erc20      *Artifact
+	router     *Artifact
+
+	optional map[string]*Artifact
+}
+
+// optionalArtifacts maps the contracts deployed on request to the source
+// file Forge names their artifact directory after.
+var optionalArtifacts = map[string]string{
+	"ProgrammableSwapRouter": "ProgrammableSwapRouter.sol",
+	"HarnessEntryPoint":      "HarnessEntryPoint.sol",
+	"HarnessAccount":         "HarnessAccount.sol",
+	"HarnessAccountFactory":  "HarnessAccount.sol",
+	"ExecutorAggregator":     "ExecutorAggregator.sol",
+	"CowSettlementHelper":    "CowSettlementHelper.sol",
+	"FastSettlementV2":       "FastSettlementV2.sol",
+}
+
+func loadArtifacts(outDir string) (*artifactSet, error) {
+	var (
+		set  = artifactSet{dir: outDir, optional: map[string]*Artifact{}}
+		errs []string
+	)
+	load := func(dst **Artifact, file, contract string) {
+		a, err := LoadArtifact(outDir, file, contract)
+		if err != nil {
+			errs = append(errs, err.Error())
+			return
+		}
+		*dst = a
+	}
+	load(&set.settlement, "FastSettlementV3.sol", "FastSettlementV3")
+	load(&set.proxy, "ERC1967Proxy.sol", "ERC1967Proxy")
+	load(&set.permit2, "Permit2.sol", "Permit2")
+	load(&set.weth, "HarnessTokens.sol", "WETH9")
+	load(&set.erc20, "HarnessTokens.sol", "HarnessERC20")
+	load(&set.router, "HarnessSwapRouter.sol", "HarnessSwapRouter")
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%w: run `forge build` in contracts/:\n  %s", ErrArtifactsMissing, strings.Join(errs, "\n  "))
+	}
+	return &set, nil
+}
+
+// get returns the artifact of contract name, loading an optional one on
+// first use.
+func (s *artifactSet) get(name string) (*Artifact, error) {
+	for _, a := range []*Artifact{s.settlement, s.proxy, s.permit2, s.weth, s.erc20, s.router} {
+		if a.Name == name {
+			return a, nil
+		}
+	}
+	if a, ok := s.optional[name]; ok {
+		return a, nil
+	}
+	file, ok := optionalArtifacts[name]
+	if !ok {
+		return nil, fmt.Errorf("testharness: unknown artifact %s", name)
+	}
+	a, err := LoadArtifact(s.dir, file, name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: run `forge build` in contracts/: %v", ErrArtifactsMissing, err)
+	}
+	s.optional[name] = a
+	return a, nil
+}
@@ -0,0 +1,482 @@
+// Package testharness boots a simulated chain with FastSettlementV3 deployed
+// behind an ERC1967 proxy, together with Permit2, WETH9, mintable ERC-20s and
+// a fixed-rate swap router, so Go code can be tested against the real
+// contracts without a live node. The rest is deployed only when a test asks
+// for it: a minimal ERC-4337 EntryPoint and smart account factory with
+// Config.SmartAccounts, and a programmable router, batch aggregator, CoW
+// settlement helper or FastSettlementV2 through their Deploy methods. Their
+// artifacts are read on first use.
+//
+// Bytecode comes from Forge build artifacts; run `forge build` in contracts/
+// first. Tests should skip when New returns ErrArtifactsMissing.
+package testharness
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	"github.com/primev/fastprotocolapp/contracts-abi/permit2"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+)
+
+// ArtifactsDirEnv overrides the Forge output directory the harness reads.
+const ArtifactsDirEnv = "FAST_CONTRACTS_OUT"
+
+var (
+	// ErrArtifactsMissing is returned by New when the Forge artifacts have
+	// not been built.
+	ErrArtifactsMissing = errors.New("testharness: forge artifacts not found")
+
+	// ErrTxFailed is returned when a mined transaction has a failed status.
+	ErrTxFailed = errors.New("testharness: transaction failed")
+)
+
+// Ether is 1e18 wei.
+var Ether = big.NewInt(params.Ether)
+
+// Account is a funded test account.
+type Account struct {
+	Name    string
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+}
+
+// NewAccount derives a deterministic account from name, like forge-std's
+// makeAddr.
+func NewAccount(name string) Account {
+	key, err := crypto.ToECDSA(crypto.Keccak256([]byte(name)))
+	if err != nil {
+		panic(err)
+	}
+	return Account{Name: name, Key: key, Address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// Config controls how the harness is set up. The zero value is usable.
+type Config struct {
+	// ArtifactsDir is the Forge output directory. Defaults to $FAST_CONTRACTS_OUT
+	// or the nearest contracts/out above the working directory.
+	ArtifactsDir string
+	// Funding is the ETH balance of every named account. Defaults to 1000 ether.
+	Funding *big.Int
+	// UserTokens is the TokenIn balance minted to User. Defaults to 1000e18.
+	UserTokens *big.Int
+	// RouterETH is the ETH the router holds for ETH-output swaps. Defaults to
+	// 1000 ether.
+	RouterETH *big.Int
+	// TokenDecimals is the decimals of TokenIn and TokenOut. Defaults to 18.
+	TokenDecimals uint8
+	// SmartAccounts deploys the EntryPoint and the smart account factory.
+	SmartAccounts bool
+}
+
+// Harness is a simulated chain with the settlement stack deployed.
+type Harness struct {
+	Backend *simulated.Backend
+	Client  simulated.Client
+	ChainID *big.Int
+
+	Owner     Account
+	Executor  Account
+	Treasury  Account
+	User      Account
+	Recipient Account
+
+	Permit2        common.Address
+	WETH           common.Address
+	TokenIn        common.Address
+	TokenOut       common.Address
+	Router         common.Address
+	Implementation common.Address
+	Proxy          common.Address
+	// EntryPoint is a HarnessEntryPoint, a hand-written ERC-4337 v0.7
+	// stand-in rather than the canonical EntryPoint, and AccountFactory
+	// deploys HarnessAccount smart accounts for it. Both are zero unless
+	// Config.SmartAccounts is set.
+	EntryPoint     common.Address
+	AccountFactory common.Address
+
+	// Settlement is bound to Proxy.
+	Settlement *fastsettlementv3.Fastsettlementv3
+
+	artifacts *artifactSet
+	nonce     int64
+}
+
+// New boots the chain and deploys the stack the way FastSettlementV3.t.sol's
+// setUp does: the router is an initial swap target, User holds TokenIn and has
+// approved Permit2, and the router holds ETH for ETH-output swaps.
+func New(cfg Config) (*Harness, error) {
+	dir := cfg.ArtifactsDir
+	if dir == "" {
+		dir = defaultArtifactsDir()
+	}
+	set, err := loadArtifacts(dir)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Funding == nil {
+		cfg.Funding = new(big.Int).Mul(big.NewInt(1000), Ether)
+	}
+	if cfg.UserTokens == nil {
+		cfg.UserTokens = new(big.Int).Mul(big.NewInt(1000), Ether)
+	}
+	if cfg.RouterETH == nil {
+		cfg.RouterETH = new(big.Int).Mul(big.NewInt(1000), Ether)
+	}
+	if cfg.TokenDecimals == 0 {
+		cfg.TokenDecimals = 18
+	}
+
+	h := &Harness{
+		Owner:     NewAccount("owner"),
+		Executor:  NewAccount("executor"),
+		Treasury:  NewAccount("treasury"),
+		User:      NewAccount("user"),
+		Recipient: NewAccount("recipient"),
+		artifacts: set,
+	}
+	alloc := types.GenesisAlloc{}
+	for _, a := range h.Accounts() {
+		alloc[a.Address] = types.Account{Balance: new(big.Int).Set(cfg.Funding)}
+	}
+	alloc[h.Owner.Address] = types.Account{Balance: new(big.Int).Add(cfg.Funding, cfg.RouterETH)}
+	h.Backend = simulated.NewBackend(alloc)
+	h.Client = h.Backend.Client()
+	if h.ChainID, err = h.Client.ChainID(context.Background()); err != nil {
+		h.Close()
+		return nil, err
+	}
+	if err := h.deploy(cfg); err != nil {
+		h.Close()
+		return nil, err
+	}
+	return h, nil
+}
+
+func (h *Harness) deploy(cfg Config) error {
+	var err error
+	if h.Permit2, err = h.Deploy(h.Owner, h.artifacts.permit2); err != nil {
+		return err
+	}
+	if h.WETH, err = h.Deploy(h.Owner, h.artifacts.weth); err != nil {
+		return err
+	}
+	if h.TokenIn, err = h.DeployToken("Token In", "TIN", cfg.TokenDecimals); err != nil {
+		return err
+	}
+	if h.TokenOut, err = h.DeployToken("Token Out", "TOUT", cfg.TokenDecimals); err != nil {
+		return err
+	}
+	if h.Router, err = h.Deploy(h.Owner, h.artifacts.router, h.WETH); err != nil {
+		return err
+	}
+	if h.Implementation, h.Proxy, err = h.DeploySettlement(h.Permit2, h.WETH, []common.Address{h.Router}); err != nil {
+		return err
+	}
+	if h.Settlement, err = fastsettlementv3.NewFastsettlementv3(h.Proxy, h.Client); err != nil {
+		return err
+	}
+	if err := h.Mint(h.TokenIn, h.User.Address, cfg.UserTokens); err != nil {
+		return err
+	}
+	if err := h.ApprovePermit2(h.User, h.TokenIn); err != nil {
+		return err
+	}
+	if cfg.SmartAccounts {
+		if err := h.deploySmartAccounts(); err != nil {
+			return err
+		}
+	}
+	return h.SendETH(h.Owner, h.Router, cfg.RouterETH)
+}
+
+// Accounts returns the named accounts in a stable order.
+func (h *Harness) Accounts() []Account {
+	return []Account{h.Owner, h.Executor, h.Treasury, h.User, h.Recipient}
+}
+
+// Close shuts the simulated chain down.
+func (h *Harness) Close() error {
+	if h.Backend == nil {
+		return nil
+	}
+	return h.Backend.Close()
+}
+
+// Opts returns transact options signing as acct.
+func (h *Harness) Opts(acct Account) *bind.TransactOpts {
+	opts, err := bind.NewKeyedTransactorWithChainID(acct.Key, h.ChainID)
+	if err != nil {
+		panic(err)
+	}
+	return opts
+}
+
+// Mine commits a block containing tx and returns its receipt. It is shaped to
+// wrap a binding call directly: h.Mine(h.Settlement.SetTreasury(...)). A
+// reverted transaction returns its receipt together with ErrTxFailed; a
+// revert caught during gas estimation is returned as a *reverts.Error.
+func (h *Harness) Mine(tx *types.Transaction, err error) (*types.Receipt, error) {
+	if err != nil {
+		if rev := reverts.FromError(err); rev != nil {
+			return nil, rev
+		}
+		return nil, err
+	}
+	h.Backend.Commit()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	receipt, err := bind.WaitMined(ctx, h.Client, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("%w: %s", ErrTxFailed, tx.Hash())
+	}
+	return receipt, nil
+}
+
+// Deploy deploys a contract from its artifact and mines it.
+func (h *Harness) Deploy(from Account, a *Artifact, args ...interface{}) (common.Address, error) {
+	addr, tx, _, err := bind.DeployContract(h.Opts(from), a.ABI, a.Bytecode, h.Client, args...)
+	if _, err := h.Mine(tx, err); err != nil {
+		return common.Address{}, fmt.Errorf("deploy %s: %w", a.Name, err)
+	}
+	return addr, nil
+}
+
+// DeploySettlement deploys a FastSettlementV3 implementation and an
+// ERC1967Proxy initialized with Owner, Executor, Treasury and targets.
+func (h *Harness) DeploySettlement(permit2Addr, weth common.Address, targets []common.Address) (impl, proxy common.Address, err error) {
+	if impl, err = h.Deploy(h.Owner, h.artifacts.settlement, permit2Addr, weth); err != nil {
+		return
+	}
+	initData, err := h.artifacts.settlement.ABI.Pack("initialize", h.Owner.Address, h.Executor.Address, h.Treasury.Address, targets)
+	if err != nil {
+		return
+	}
+	proxy, err = h.Deploy(h.Owner, h.artifacts.proxy, impl, initData)
+	return
+}
+
+// DeployV2 deploys a FastSettlementV2 owned by Owner, with Treasury as its
+// surplus recipient, for migration tests.
+func (h *Harness) DeployV2() (common.Address, error) {
+	a, err := h.artifacts.get("FastSettlementV2")
+	if err != nil {
+		return common.Address{}, err
+	}
+	return h.Deploy(h.Owner, a, h.Permit2, h.Owner.Address, h.Treasury.Address)
+}
+
+// DeployToken deploys a mintable HarnessERC20.
+func (h *Harness) DeployToken(name, symbol string, decimals uint8) (common.Address, error) {
+	return h.Deploy(h.Owner, h.artifacts.erc20, name, symbol, decimals)
+}
+
+// Artifact returns one of the harness's artifacts by contract name, loading
+// an on-demand one if it has not been used yet.
+func (h *Harness) Artifact(name string) (*Artifact, bool) {
+	a, err := h.artifacts.get(name)
+	return a, err == nil
+}
+
+// Transact sends a call to a contract deployed from a.
+func (h *Harness) Transact(from Account, a *Artifact, to common.Address, method string, args ...interface{}) (*types.Receipt, error) {
+	c := bind.NewBoundContract(to, a.ABI, h.Client, h.Client, h.Client)
+	return h.Mine(c.Transact(h.Opts(from), method, args...))
+}
+
+// Call performs a read-only call against a contract deployed from a.
+func (h *Harness) Call(a *Artifact, to common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	c := bind.NewBoundContract(to, a.ABI, h.Client, h.Client, h.Client)
+	var out []interface{}
+	err := c.Call(&bind.CallOpts{}, &out, method, args...)
+	return out, err
+}
+
+// Mint mints amount of a HarnessERC20 token to to.
+func (h *Harness) Mint(token, to common.Address, amount *big.Int) error {
+	_, err := h.Transact(h.Owner, h.artifacts.erc20, token, "mint", to, amount)
+	return err
+}
+
+// ApprovePermit2 gives Permit2 an unlimited allowance over acct's token.
+func (h *Harness) ApprovePermit2(acct Account, token common.Address) error {
+	max := new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
+	_, err := h.Transact(acct, h.artifacts.erc20, token, "approve", h.Permit2, max)
+	return err
+}
+
+// SendETH transfers value wei from acct to to.
+func (h *Harness) SendETH(from Account, to common.Address, value *big.Int) error {
+	ctx := context.Background()
+	nonce, err := h.Client.PendingNonceAt(ctx, from.Address)
+	if err != nil {
+		return err
+	}
+	tip, err := h.Client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := h.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	feeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, common.Big2))
+	tx, err := types.SignNewTx(from.Key, types.LatestSignerForChainID(h.ChainID), &types.DynamicFeeTx{
+		ChainID:   h.ChainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       100_000,
+		To:        &to,
+		Value:     value,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = h.Mine(tx, h.Client.SendTransaction(ctx, tx))
+	return err
+}
+
+// BalanceOf returns holder's balance of token, or its ETH balance when token
+// is the zero address.
+func (h *Harness) BalanceOf(token, holder common.Address) (*big.Int, error) {
+	if token == (common.Address{}) {
+		return h.Client.BalanceAt(context.Background(), holder, nil)
+	}
+	out, err := h.Call(h.artifacts.erc20, token, "balanceOf", holder)
+	if err != nil {
+		return nil, err
+	}
+	return out[0].(*big.Int), nil
+}
+
+// Now returns the timestamp of the latest block.
+func (h *Harness) Now() (uint64, error) {
+	head, err := h.Client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return 0, err
+	}
+	return head.Time, nil
+}
+
+// AdjustTime moves the chain clock forward and mines a block.
+func (h *Harness) AdjustTime(d time.Duration) error {
+	if err := h.Backend.AdjustTime(d); err != nil {
+		return err
+	}
+	h.Backend.Commit()
+	return nil
+}
+
+// Permit2Domain returns the EIP-712 domain of the deployed Permit2.
+func (h *Harness) Permit2Domain() permit2.Domain {
+	return permit2.Domain{ChainID: h.ChainID, Permit2: h.Permit2}
+}
+
+// NewIntent returns an intent from User to Recipient expiring in an hour, with
+// a fresh Permit2 nonce.
+func (h *Harness) NewIntent(inputToken, outputToken common.Address, inputAmt, userAmtOut *big.Int) (fastsettlementv3.IFastSettlementV3Intent, error) {
+	now, err := h.Now()
+	if err != nil {
+		return fastsettlementv3.IFastSettlementV3Intent{}, err
+	}
+	h.nonce++
+	return fastsettlementv3.IFastSettlementV3Intent{
+		User:        h.User.Address,
+		InputToken:  inputToken,
+		OutputToken: outputToken,
+		InputAmt:    inputAmt,
+		UserAmtOut:  userAmtOut,
+		Recipient:   h.Recipient.Address,
+		Deadline:    new(big.Int).SetUint64(now + 3600),
+		Nonce:       big.NewInt(h.nonce),
+	}, nil
+}
+
+// SignIntent signs intent's Permit2 witness transfer as acct, with the
+// settlement proxy as spender.
+func (h *Harness) SignIntent(acct Account, intent fastsettlementv3.IFastSettlementV3Intent) ([]byte, error) {
+	return h.Permit2Domain().Sign(acct.Key, h.Proxy, intent)
+}
+
+// SwapCall encodes HarnessSwapRouter.swap, which pulls amountIn of tokenIn and
+// pays amountOut of tokenOut (or ETH when ethOut is set).
+func (h *Harness) SwapCall(tokenIn common.Address, amountIn *big.Int, tokenOut common.Address, amountOut *big.Int, ethOut bool) (fastsettlementv3.IFastSettlementV3SwapCall, error) {
+	data, err := h.artifacts.router.ABI.Pack("swap", tokenIn, amountIn, tokenOut, amountOut, ethOut)
+	return fastsettlementv3.IFastSettlementV3SwapCall{To: h.Router, Value: new(big.Int), Data: data}, err
+}
+
+// WETHSwapCall encodes HarnessSwapRouter.swapWETH for executeWithETH flows.
+func (h *Harness) WETHSwapCall(amountIn *big.Int, tokenOut common.Address, amountOut *big.Int) (fastsettlementv3.IFastSettlementV3SwapCall, error) {
+	data, err := h.artifacts.router.ABI.Pack("swapWETH", amountIn, tokenOut, amountOut)
+	return fastsettlementv3.IFastSettlementV3SwapCall{To: h.Router, Value: new(big.Int), Data: data}, err
+}
+
+// ExecuteWithPermit settles intent as Executor.
+func (h *Harness) ExecuteWithPermit(intent fastsettlementv3.IFastSettlementV3Intent, sig []byte, call fastsettlementv3.IFastSettlementV3SwapCall) (*types.Receipt, error) {
+	return h.Mine(h.Settlement.ExecuteWithPermit(h.Opts(h.Executor), intent, sig, call))
+}
+
+// ExecuteWithETH settles an ETH-input intent sent by from with msg.value.
+func (h *Harness) ExecuteWithETH(from Account, intent fastsettlementv3.IFastSettlementV3Intent, call fastsettlementv3.IFastSettlementV3SwapCall, value *big.Int) (*types.Receipt, error) {
+	opts := h.Opts(from)
+	opts.Value = value
+	return h.Mine(h.Settlement.ExecuteWithETH(opts, intent, call))
+}
+
+// SetSwapTargets updates the settlement allowlist as Owner.
+func (h *Harness) SetSwapTargets(targets []common.Address, allowed []bool) error {
+	_, err := h.Mine(h.Settlement.SetSwapTargets(h.Opts(h.Owner), targets, allowed))
+	return err
+}
+
+// IntentExecuted returns the IntentExecuted events in receipt.
+func (h *Harness) IntentExecuted(receipt *types.Receipt) ([]*fastsettlementv3.Fastsettlementv3IntentExecuted, error) {
+	var out []*fastsettlementv3.Fastsettlementv3IntentExecuted
+	for _, l := range receipt.Logs {
+		if l.Address != h.Proxy {
+			continue
+		}
+		ev, err := h.Settlement.ParseIntentExecuted(*l)
+		if err != nil {
+			continue
+		}
+		out = append(out, ev)
+	}
+	return out, nil
+}
+
+func defaultArtifactsDir() string {
+	if dir := os.Getenv(ArtifactsDirEnv); dir != "" {
+		return dir
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return filepath.Join("..", "contracts", "out")
+	}
+	for dir := wd; ; dir = filepath.Dir(dir) {
+		candidate := filepath.Join(dir, "contracts", "out")
+		if st, err := os.Stat(candidate); err == nil && st.IsDir() {
+			return candidate
+		}
+		if filepath.Dir(dir) == dir {
+			return filepath.Join(wd, "contracts", "out")
+		}
+	}
+}
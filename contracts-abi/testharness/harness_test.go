@@ -0,0 +1,126 @@
+package testharness_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+	"github.com/primev/fastprotocolapp/contracts-abi/testharness"
+)
+
+// TestSurplus settles intents whose swap returns more than userAmtOut and
+// checks the surplus goes to Treasury in the output token or in ETH.
+func TestSurplus(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{})
+	balance := func(token, holder common.Address) *big.Int {
+		t.Helper()
+		b, err := h.BalanceOf(token, holder)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	tests := []struct {
+		name     string
+		tokenOut common.Address
+		ethOut   bool
+	}{
+		{"erc20 output", h.TokenOut, false},
+		{"eth output", common.Address{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in, err := h.NewIntent(h.TokenIn, tt.tokenOut, ether(10), ether(8))
+			if err != nil {
+				t.Fatal(err)
+			}
+			sig, err := h.SignIntent(h.User, in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			call, err := h.SwapCall(h.TokenIn, ether(10), h.TokenOut, ether(9), tt.ethOut)
+			if err != nil {
+				t.Fatal(err)
+			}
+			recipientBefore := balance(tt.tokenOut, h.Recipient.Address)
+			treasuryBefore := balance(tt.tokenOut, h.Treasury.Address)
+			receipt, err := h.ExecuteWithPermit(in, sig, call)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d := new(big.Int).Sub(balance(tt.tokenOut, h.Recipient.Address), recipientBefore); d.Cmp(ether(8)) != 0 {
+				t.Errorf("recipient received %s, want %s", d, ether(8))
+			}
+			if d := new(big.Int).Sub(balance(tt.tokenOut, h.Treasury.Address), treasuryBefore); d.Cmp(ether(1)) != 0 {
+				t.Errorf("treasury received %s, want %s", d, ether(1))
+			}
+			if got := balance(tt.tokenOut, h.Proxy); got.Sign() != 0 {
+				t.Errorf("settlement kept %s of the output", got)
+			}
+			events, err := h.IntentExecuted(receipt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(events) != 1 {
+				t.Fatalf("got %d IntentExecuted events, want 1", len(events))
+			}
+			if ev := events[0]; ev.Received.Cmp(ether(9)) != 0 || ev.Surplus.Cmp(ether(1)) != 0 {
+				t.Errorf("IntentExecuted received %s surplus %s, want %s and %s", ev.Received, ev.Surplus, ether(9), ether(1))
+			}
+		})
+	}
+}
+
+// TestRejected checks that a replayed Permit2 nonce and a past deadline
+// revert with the contract's errors.
+func TestRejected(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{})
+	call, err := h.SwapCall(h.TokenIn, ether(10), h.TokenOut, ether(8), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in, err := h.NewIntent(h.TokenIn, h.TokenOut, ether(10), ether(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := h.SignIntent(h.User, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.ExecuteWithPermit(in, sig, call); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.ExecuteWithPermit(in, sig, call); !errors.Is(err, reverts.Named("InvalidNonce")) {
+		t.Fatalf("replay: err = %v, want InvalidNonce", err)
+	}
+
+	in, err = h.NewIntent(h.TokenIn, h.TokenOut, ether(10), ether(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig, err = h.SignIntent(h.User, in); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.AdjustTime(2 * time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.ExecuteWithPermit(in, sig, call); !errors.Is(err, reverts.Named("IntentExpired")) {
+		t.Fatalf("past deadline: err = %v, want IntentExpired", err)
+	}
+}
+
+// TestSmartAccountsOptIn checks that the account helpers refuse to run when
+// the harness was started without Config.SmartAccounts.
+func TestSmartAccountsOptIn(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{})
+	if h.EntryPoint != (common.Address{}) {
+		t.Fatalf("EntryPoint = %s, want zero", h.EntryPoint)
+	}
+	if _, err := h.NewUserOp(h.User.Address); !errors.Is(err, testharness.ErrNoSmartAccounts) {
+		t.Fatalf("NewUserOp: err = %v, want ErrNoSmartAccounts", err)
+	}
+}
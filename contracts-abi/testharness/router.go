@@ -0,0 +1,216 @@
+package testharness
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+)
+
+// Behavior scripts a single ProgrammableSwapRouter.swap call. Field names
+// match the Solidity struct so it ABI-encodes directly; use the constructors
+// below rather than filling it by hand.
+type Behavior struct {
+	Fail          bool
+	Reason        string
+	PullAmount    *big.Int
+	ReturnAmount  *big.Int
+	OutAmount     *big.Int
+	OutTo         common.Address
+	Reentry       []byte
+	BubbleReentry bool
+}
+
+func behavior(pull, ret, out *big.Int) Behavior {
+	return Behavior{PullAmount: orZero(pull), ReturnAmount: orZero(ret), OutAmount: orZero(out)}
+}
+
+// Exact pulls amountIn and pays exactly amountOut to the settlement.
+func Exact(amountIn, amountOut *big.Int) Behavior {
+	return behavior(amountIn, nil, amountOut)
+}
+
+// Short pays shortfall less than userAmtOut, triggering
+// InsufficientOut(userAmtOut-shortfall, userAmtOut).
+func Short(amountIn, userAmtOut, shortfall *big.Int) Behavior {
+	return behavior(amountIn, nil, new(big.Int).Sub(orZero(userAmtOut), orZero(shortfall)))
+}
+
+// PartialPull pulls only pullAmount of the approved input. The remainder stays
+// in the settlement contract: _execute only refunds when its input balance
+// grows, so this is what stranded input looks like. ReturnInput drives the
+// refund branch instead.
+func PartialPull(pullAmount, amountOut *big.Int) Behavior {
+	return behavior(pullAmount, nil, amountOut)
+}
+
+// ReturnInput pulls pullAmount of the input and sends returnAmount back to
+// the settlement, minting whatever it does not hold. With returnAmount above
+// pullAmount the settlement ends the swap holding more input than it started
+// with, and _execute refunds returnAmount-pullAmount to the user; input the
+// router never pulled still stays in the settlement.
+func ReturnInput(pullAmount, returnAmount, amountOut *big.Int) Behavior {
+	return behavior(pullAmount, returnAmount, amountOut)
+}
+
+// Refund pulls amountIn, then hands back amountIn+extra, so the settlement
+// ends with extra more input than it started with and refunds it to the user.
+func Refund(amountIn, extra, amountOut *big.Int) Behavior {
+	return ReturnInput(amountIn, new(big.Int).Add(orZero(amountIn), orZero(extra)), amountOut)
+}
+
+// Revert makes the swap revert with reason, surfacing as BadCallTarget.
+func Revert(reason string) Behavior {
+	return Behavior{Fail: true, Reason: reason, PullAmount: new(big.Int), ReturnAmount: new(big.Int), OutAmount: new(big.Int)}
+}
+
+// PayDirect pulls amountIn and sends amountOut straight to to, bypassing the
+// settlement so its balance-delta check sees nothing received.
+func PayDirect(to common.Address, amountIn, amountOut *big.Int) Behavior {
+	b := behavior(amountIn, nil, amountOut)
+	b.OutTo = to
+	return b
+}
+
+// Reenter performs then's swap after calling back into the settlement with
+// calldata. The outcome is recorded in a ReentryAttempted event; with bubble
+// set, a failed reentry reverts the swap instead.
+func Reenter(calldata []byte, bubble bool, then Behavior) Behavior {
+	then.Reentry = calldata
+	then.BubbleReentry = bubble
+	return then
+}
+
+// ReentryAttempt is a decoded ReentryAttempted event.
+type ReentryAttempt struct {
+	Index      uint64
+	Success    bool
+	ReturnData []byte
+}
+
+// ProgrammableRouter controls a deployed ProgrammableSwapRouter.
+type ProgrammableRouter struct {
+	Address common.Address
+
+	h        *Harness
+	artifact *Artifact
+	contract *bind.BoundContract
+}
+
+// DeployProgrammableRouter deploys a ProgrammableSwapRouter, funds it with eth
+// for ETH-output swaps, and allowlists it on the settlement contract.
+func (h *Harness) DeployProgrammableRouter(eth *big.Int) (*ProgrammableRouter, error) {
+	a, err := h.artifacts.get("ProgrammableSwapRouter")
+	if err != nil {
+		return nil, err
+	}
+	addr, err := h.Deploy(h.Owner, a)
+	if err != nil {
+		return nil, err
+	}
+	if eth != nil && eth.Sign() > 0 {
+		if err := h.SendETH(h.Owner, addr, eth); err != nil {
+			return nil, fmt.Errorf("fund router: %w", err)
+		}
+	}
+	if err := h.SetSwapTargets([]common.Address{addr}, []bool{true}); err != nil {
+		return nil, fmt.Errorf("allowlist router: %w", err)
+	}
+	return &ProgrammableRouter{
+		Address:  addr,
+		h:        h,
+		artifact: a,
+		contract: bind.NewBoundContract(addr, a.ABI, h.Client, h.Client, h.Client),
+	}, nil
+}
+
+// Program queues behaviors for the next swaps, in order.
+func (r *ProgrammableRouter) Program(behaviors ...Behavior) error {
+	for i, b := range behaviors {
+		if _, err := r.h.Mine(r.contract.Transact(r.h.Opts(r.h.Owner), "push", b)); err != nil {
+			return fmt.Errorf("push behavior %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// SetDefault sets the behavior used once the queue is drained.
+func (r *ProgrammableRouter) SetDefault(b Behavior) error {
+	_, err := r.h.Mine(r.contract.Transact(r.h.Opts(r.h.Owner), "setDefault", b))
+	return err
+}
+
+// Reset clears the queue, the default behavior and the call counter.
+func (r *ProgrammableRouter) Reset() error {
+	_, err := r.h.Mine(r.contract.Transact(r.h.Opts(r.h.Owner), "reset"))
+	return err
+}
+
+// Calls returns how many swaps the router has served.
+func (r *ProgrammableRouter) Calls() (uint64, error) {
+	return r.uintCall("callCount")
+}
+
+// Pending returns how many scripted behaviors are still queued.
+func (r *ProgrammableRouter) Pending() (uint64, error) {
+	return r.uintCall("pending")
+}
+
+func (r *ProgrammableRouter) uintCall(method string) (uint64, error) {
+	var out []interface{}
+	if err := r.contract.Call(&bind.CallOpts{}, &out, method); err != nil {
+		return 0, err
+	}
+	return out[0].(*big.Int).Uint64(), nil
+}
+
+// SwapCall returns the SwapCall the executor passes to the settlement; the
+// amounts come from the scripted behavior, not the calldata.
+func (r *ProgrammableRouter) SwapCall(tokenIn, tokenOut common.Address) (fastsettlementv3.IFastSettlementV3SwapCall, error) {
+	data, err := r.artifact.ABI.Pack("swap", tokenIn, tokenOut)
+	return fastsettlementv3.IFastSettlementV3SwapCall{To: r.Address, Value: new(big.Int), Data: data}, err
+}
+
+// ReentryAttempts returns the ReentryAttempted events emitted in receipt.
+func (r *ProgrammableRouter) ReentryAttempts(receipt *types.Receipt) ([]ReentryAttempt, error) {
+	if receipt == nil {
+		return nil, errors.New("nil receipt")
+	}
+	var out []ReentryAttempt
+	id := r.artifact.ABI.Events["ReentryAttempted"].ID
+	for _, l := range receipt.Logs {
+		if l.Address != r.Address || len(l.Topics) < 2 || l.Topics[0] != id {
+			continue
+		}
+		var ev struct {
+			Success    bool
+			ReturnData []byte
+		}
+		if err := r.contract.UnpackLog(&ev, "ReentryAttempted", *l); err != nil {
+			return nil, err
+		}
+		out = append(out, ReentryAttempt{
+			Index:      new(big.Int).SetBytes(l.Topics[1].Bytes()).Uint64(),
+			Success:    ev.Success,
+			ReturnData: ev.ReturnData,
+		})
+	}
+	return out, nil
+}
+
+// ExecuteWithPermitCalldata encodes an executeWithPermit call for use as a
+// reentry payload.
+func (h *Harness) ExecuteWithPermitCalldata(intent fastsettlementv3.IFastSettlementV3Intent, sig []byte, call fastsettlementv3.IFastSettlementV3SwapCall) ([]byte, error) {
+	return h.artifacts.settlement.ABI.Pack("executeWithPermit", intent, sig, call)
+}
+
+func orZero(x *big.Int) *big.Int {
+	if x == nil {
+		return new(big.Int)
+	}
+	return x
+}
@@ -0,0 +1,89 @@
+package testharness_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+	"github.com/primev/fastprotocolapp/contracts-abi/testharness"
+)
+
+func ether(n int64) *big.Int { return new(big.Int).Mul(big.NewInt(n), testharness.Ether) }
+
+// TestRouterInput settles intents through the programmable router and checks
+// where unpulled and returned input ends up.
+func TestRouterInput(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{})
+	router, err := h.DeployProgrammableRouter(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call, err := router.SwapCall(h.TokenIn, h.TokenOut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	balance := func(holder common.Address) *big.Int {
+		t.Helper()
+		b, err := h.BalanceOf(h.TokenIn, holder)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	tests := []struct {
+		name      string
+		behavior  testharness.Behavior
+		userDelta *big.Int
+		stranded  *big.Int
+	}{
+		// The router takes 6 of 10: 4 stay in the settlement, none is refunded.
+		{"partial pull", testharness.PartialPull(ether(6), ether(9)), ether(-10), ether(4)},
+		// The router takes 6 and sends 8 back: the 2 the settlement gained are
+		// refunded, the 4 it never pulled are still stranded.
+		{"return input", testharness.ReturnInput(ether(6), ether(8), ether(9)), ether(-8), ether(4)},
+		{"refund", testharness.Refund(ether(10), ether(1), ether(9)), ether(-9), ether(0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := router.Program(tt.behavior); err != nil {
+				t.Fatal(err)
+			}
+			in, err := h.NewIntent(h.TokenIn, h.TokenOut, ether(10), ether(8))
+			if err != nil {
+				t.Fatal(err)
+			}
+			sig, err := h.SignIntent(h.User, in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			userBefore, heldBefore := balance(h.User.Address), balance(h.Proxy)
+			if _, err := h.ExecuteWithPermit(in, sig, call); err != nil {
+				t.Fatal(err)
+			}
+			if d := new(big.Int).Sub(balance(h.User.Address), userBefore); d.Cmp(tt.userDelta) != 0 {
+				t.Errorf("user input changed by %s, want %s", d, tt.userDelta)
+			}
+			if d := new(big.Int).Sub(balance(h.Proxy), heldBefore); d.Cmp(tt.stranded) != 0 {
+				t.Errorf("settlement kept %s of the input, want %s", d, tt.stranded)
+			}
+		})
+	}
+
+	if err := router.Program(testharness.Short(ether(10), ether(8), ether(1))); err != nil {
+		t.Fatal(err)
+	}
+	in, err := h.NewIntent(h.TokenIn, h.TokenOut, ether(10), ether(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := h.SignIntent(h.User, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.ExecuteWithPermit(in, sig, call); !errors.Is(err, reverts.Named("InsufficientOut")) {
+		t.Fatalf("short output: err = %v, want InsufficientOut", err)
+	}
+}
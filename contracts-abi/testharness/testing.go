@@ -0,0 +1,22 @@
+package testharness
+
+import (
+	"errors"
+	"testing"
+)
+
+// Start boots a harness for a test, skipping it when the Forge artifacts have
+// not been built and failing it on any other error. The chain is shut down
+// when the test ends.
+func Start(t testing.TB, cfg Config) *Harness {
+	t.Helper()
+	h, err := New(cfg)
+	if errors.Is(err, ErrArtifactsMissing) {
+		t.Skip("forge artifacts not built; run `forge build` in contracts/")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { h.Close() })
+	return h
+}
@@ -0,0 +1,174 @@
+// Package tokens reads ERC-20 metadata so intent amounts — InputAmt,
+// UserAmtOut, Surplus — can be shown in human units. Formatting itself is
+// package amount's: amount.Of(x, md).String() renders "1.5 USDC".
+//
+// Service fetches decimals, symbol and name once per token and caches them;
+// ERC-20 metadata does not change after deployment. Tokens that predate the
+// standard's string getters, MKR and SAI among them, return bytes32 from
+// symbol and name, which are decoded as null-padded strings. The zero
+// address is ETH throughout, matching the settlement's convention.
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ierc20token "github.com/primev/fastprotocolapp/contracts-abi/clients/IERC20Token"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+)
+
+// ErrNoDecimals is returned for an address whose decimals() call reverts or
+// returns no uint8, which is either not a token or one whose amounts cannot
+// be scaled.
+var ErrNoDecimals = errors.New("tokens: decimals() failed")
+
+// Metadata describes a token. Name and Symbol are empty when the token does
+// not implement them.
+type Metadata struct {
+	Address  common.Address `json:"address"`
+	Name     string         `json:"name"`
+	Symbol   string         `json:"symbol"`
+	Decimals uint8          `json:"decimals"`
+}
+
+// ETH is the metadata of native ether, the zero address.
+var ETH = Metadata{Name: "Ether", Symbol: "ETH", Decimals: 18}
+
+var erc20ABI = func() *abi.ABI {
+	parsed, err := ierc20token.Ierc20tokenMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// Service fetches and caches token metadata. It is safe for concurrent use;
+// concurrent lookups of one token share a single set of calls.
+type Service struct {
+	caller bind.ContractCaller
+
+	mu    sync.Mutex
+	cache map[common.Address]*entry
+}
+
+type entry struct {
+	done chan struct{}
+	md   Metadata
+	err  error
+}
+
+// NewService returns a Service reading through caller.
+func NewService(caller bind.ContractCaller) *Service {
+	return &Service{caller: caller, cache: make(map[common.Address]*entry)}
+}
+
+// Set caches md, e.g. to preload a token list or correct a token whose
+// on-chain metadata is misleading.
+func (s *Service) Set(md Metadata) {
+	e := &entry{done: make(chan struct{}), md: md}
+	close(e.done)
+	s.mu.Lock()
+	s.cache[md.Address] = e
+	s.mu.Unlock()
+}
+
+// Get returns token's metadata, fetching it on first use. Failed lookups are
+// not cached.
+func (s *Service) Get(ctx context.Context, token common.Address) (Metadata, error) {
+	if token == (common.Address{}) {
+		return ETH, nil
+	}
+	s.mu.Lock()
+	e, ok := s.cache[token]
+	if !ok {
+		e = &entry{done: make(chan struct{})}
+		s.cache[token] = e
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		e.md, e.err = s.fetch(ctx, token)
+		if e.err != nil {
+			s.mu.Lock()
+			delete(s.cache, token)
+			s.mu.Unlock()
+		}
+		close(e.done)
+	}
+	select {
+	case <-e.done:
+		return e.md, e.err
+	case <-ctx.Done():
+		return Metadata{}, ctx.Err()
+	}
+}
+
+func (s *Service) fetch(ctx context.Context, token common.Address) (Metadata, error) {
+	md := Metadata{Address: token}
+	out, err := s.call(ctx, token, "decimals")
+	if err != nil {
+		if reverted(err) {
+			return Metadata{}, fmt.Errorf("%w: %s: %v", ErrNoDecimals, token, err)
+		}
+		return Metadata{}, fmt.Errorf("tokens: decimals of %s: %w", token, err)
+	}
+	// Some tokens declare decimals as uint256; accept any value that fits.
+	if len(out) != 32 || new(big.Int).SetBytes(out).BitLen() > 8 {
+		return Metadata{}, fmt.Errorf("%w: %s returned %x", ErrNoDecimals, token, out)
+	}
+	md.Decimals = out[31]
+	// name and symbol are optional in ERC-20; a reverted call leaves them
+	// empty.
+	for _, f := range []struct {
+		method string
+		dst    *string
+	}{{"symbol", &md.Symbol}, {"name", &md.Name}} {
+		out, err := s.call(ctx, token, f.method)
+		if err != nil && !reverted(err) {
+			return Metadata{}, fmt.Errorf("tokens: %s of %s: %w", f.method, token, err)
+		}
+		*f.dst = decodeString(out)
+	}
+	return md, nil
+}
+
+func (s *Service) call(ctx context.Context, token common.Address, method string) ([]byte, error) {
+	data, err := erc20ABI.Pack(method)
+	if err != nil {
+		return nil, err
+	}
+	return s.caller.CallContract(ctx, ethereum.CallMsg{To: &token, Data: data}, nil)
+}
+
+// reverted tells a call the token rejected from a transport failure.
+func reverted(err error) bool {
+	if _, ok := reverts.Data(err); ok {
+		return true
+	}
+	return strings.Contains(err.Error(), "execution reverted")
+}
+
+// decodeString decodes a string getter's return data, either an ABI string
+// or a bytes32 padded with trailing zeros.
+func decodeString(out []byte) string {
+	var s string
+	if vals, err := erc20ABI.Unpack("symbol", out); err == nil {
+		s = vals[0].(string)
+	} else if len(out) == 32 {
+		s = string(bytes.TrimRight(out, "\x00"))
+	}
+	if !utf8.ValidString(s) {
+		s = strings.ToValidUTF8(s, "")
+	}
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,141 @@
+package tokens_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ierc20token "github.com/primev/fastprotocolapp/contracts-abi/clients/IERC20Token"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+	"github.com/primev/fastprotocolapp/contracts-abi/tokens"
+)
+
+var (
+	usdc = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	mkr  = common.HexToAddress("0x9f8F72aA9304c8B593d555F12eF6589cC3A579A2")
+	bare = common.HexToAddress("0x0000000000000000000000000000000000000ba5")
+	eoa  = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+)
+
+// revert is the error a node returns for a reverted eth_call.
+type revert struct{}
+
+func (revert) Error() string          { return "execution reverted" }
+func (revert) ErrorData() interface{} { return "0x" }
+
+// chain answers ERC-20 metadata calls: USDC with string getters, MKR with
+// bytes32 ones, a token without name or symbol, and an EOA that reverts
+// everything. down fails every call as a transport error.
+type chain struct {
+	bind.ContractCaller
+
+	mu    sync.Mutex
+	calls int
+	down  bool
+}
+
+func (c *chain) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	if c.down {
+		return nil, errors.New("connection refused")
+	}
+	erc20, _ := ierc20token.Ierc20tokenMetaData.GetAbi()
+	method, err := erc20.MethodById(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+	pack := func(v interface{}) ([]byte, error) { return method.Outputs.Pack(v) }
+	bytes32 := func(s string) []byte {
+		var b [32]byte
+		copy(b[:], s)
+		return b[:]
+	}
+	switch *msg.To {
+	case usdc:
+		switch method.Name {
+		case "decimals":
+			return pack(uint8(6))
+		case "symbol":
+			return pack("USDC")
+		case "name":
+			return pack("USD Coin")
+		}
+	case mkr:
+		switch method.Name {
+		case "decimals":
+			return pack(uint8(18))
+		case "symbol":
+			return bytes32("MKR"), nil
+		case "name":
+			return bytes32("Maker"), nil
+		}
+	case bare:
+		if method.Name == "decimals" {
+			return pack(uint8(8))
+		}
+	}
+	return nil, revert{}
+}
+
+func TestGet(t *testing.T) {
+	c := &chain{}
+	s := tokens.NewService(c)
+	ctx := context.Background()
+	for _, tt := range []struct {
+		name  string
+		token common.Address
+		want  tokens.Metadata
+	}{
+		{"string getters", usdc, tokens.Metadata{Address: usdc, Name: "USD Coin", Symbol: "USDC", Decimals: 6}},
+		{"bytes32 getters", mkr, tokens.Metadata{Address: mkr, Name: "Maker", Symbol: "MKR", Decimals: 18}},
+		{"no name or symbol", bare, tokens.Metadata{Address: bare, Decimals: 8}},
+		{"ETH", common.Address{}, tokens.ETH},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Get(ctx, tt.token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("Get = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	calls := c.calls
+	if _, err := s.Get(ctx, usdc); err != nil {
+		t.Fatal(err)
+	}
+	if c.calls != calls {
+		t.Error("cached metadata fetched again")
+	}
+
+	if _, err := s.Get(ctx, eoa); !errors.Is(err, tokens.ErrNoDecimals) {
+		t.Errorf("EOA: err = %v, want ErrNoDecimals", err)
+	}
+}
+
+func TestGetTransportError(t *testing.T) {
+	c := &chain{down: true}
+	s := tokens.NewService(c)
+	ctx := context.Background()
+	_, err := s.Get(ctx, usdc)
+	if err == nil || errors.Is(err, tokens.ErrNoDecimals) {
+		t.Fatalf("err = %v, want a transport error", err)
+	}
+	if reverts.FromError(err) != nil {
+		t.Fatalf("transport error decoded as a revert: %v", err)
+	}
+	// Failures are not cached.
+	c.down = false
+	if md, err := s.Get(ctx, usdc); err != nil || md.Symbol != "USDC" {
+		t.Fatalf("after recovery: %+v, %v", md, err)
+	}
+}
@@ -0,0 +1,144 @@
+package userop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrPending is returned by Receipt while the operation is not yet included.
+var ErrPending = errors.New("userop: operation not included yet")
+
+// RPC issues raw JSON-RPC calls; *rpc.Client satisfies it.
+type RPC interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// Bundler is a client for a bundler's ERC-4337 JSON-RPC methods.
+type Bundler struct {
+	RPC RPC
+	// EntryPoint defaults to EntryPointV07.
+	EntryPoint common.Address
+}
+
+func (b *Bundler) entryPoint() common.Address {
+	if b.EntryPoint == (common.Address{}) {
+		return EntryPointV07
+	}
+	return b.EntryPoint
+}
+
+// SupportedEntryPoints returns the EntryPoints the bundler serves.
+func (b *Bundler) SupportedEntryPoints(ctx context.Context) ([]common.Address, error) {
+	var out []common.Address
+	if err := b.RPC.CallContext(ctx, &out, "eth_supportedEntryPoints"); err != nil {
+		return nil, fmt.Errorf("userop: supported entry points: %w", err)
+	}
+	return out, nil
+}
+
+// GasEstimate is eth_estimateUserOperationGas's result.
+type GasEstimate struct {
+	PreVerificationGas            *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit          *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit                  *hexutil.Big `json:"callGasLimit"`
+	PaymasterVerificationGasLimit *hexutil.Big `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big `json:"paymasterPostOpGasLimit,omitempty"`
+}
+
+// Apply copies the estimate into op's gas fields; paymaster limits are only
+// copied when the bundler returned them.
+func (g *GasEstimate) Apply(op *UserOperation) {
+	op.PreVerificationGas = g.PreVerificationGas
+	op.VerificationGasLimit = g.VerificationGasLimit
+	op.CallGasLimit = g.CallGasLimit
+	if g.PaymasterVerificationGasLimit != nil {
+		op.PaymasterVerificationGasLimit = g.PaymasterVerificationGasLimit
+	}
+	if g.PaymasterPostOpGasLimit != nil {
+		op.PaymasterPostOpGasLimit = g.PaymasterPostOpGasLimit
+	}
+}
+
+// EstimateGas asks the bundler to simulate op. An unsigned op is estimated
+// with DummySignature, since validation runs during simulation.
+func (b *Bundler) EstimateGas(ctx context.Context, op *UserOperation) (*GasEstimate, error) {
+	est := *op
+	if len(est.Signature) == 0 {
+		est.Signature = DummySignature
+	}
+	var out GasEstimate
+	if err := b.RPC.CallContext(ctx, &out, "eth_estimateUserOperationGas", &est, b.entryPoint()); err != nil {
+		return nil, fmt.Errorf("userop: estimate gas: %w", err)
+	}
+	if out.PreVerificationGas == nil || out.VerificationGasLimit == nil || out.CallGasLimit == nil {
+		return nil, errors.New("userop: estimate gas: incomplete result")
+	}
+	return &out, nil
+}
+
+// Send submits a signed op and returns its userOpHash.
+func (b *Bundler) Send(ctx context.Context, op *UserOperation) (common.Hash, error) {
+	var hash common.Hash
+	if err := b.RPC.CallContext(ctx, &hash, "eth_sendUserOperation", op, b.entryPoint()); err != nil {
+		return common.Hash{}, fmt.Errorf("userop: send: %w", err)
+	}
+	return hash, nil
+}
+
+// Receipt is eth_getUserOperationReceipt's result.
+type Receipt struct {
+	UserOpHash    common.Hash    `json:"userOpHash"`
+	EntryPoint    common.Address `json:"entryPoint"`
+	Sender        common.Address `json:"sender"`
+	Nonce         *hexutil.Big   `json:"nonce"`
+	Paymaster     common.Address `json:"paymaster"`
+	ActualGasCost *hexutil.Big   `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big   `json:"actualGasUsed"`
+	// Success reports whether the account's call succeeded; a failed call
+	// is still included and paid for.
+	Success bool   `json:"success"`
+	Reason  string `json:"reason,omitempty"`
+	// Logs are the operation's own logs; Receipt is the bundle transaction's.
+	Logs    []*types.Log   `json:"logs"`
+	Receipt *types.Receipt `json:"receipt"`
+}
+
+// Receipt returns the receipt of the operation with userOpHash hash, or
+// ErrPending when it has not been included.
+func (b *Bundler) Receipt(ctx context.Context, hash common.Hash) (*Receipt, error) {
+	var out *Receipt
+	if err := b.RPC.CallContext(ctx, &out, "eth_getUserOperationReceipt", hash); err != nil {
+		return nil, fmt.Errorf("userop: receipt: %w", err)
+	}
+	if out == nil {
+		return nil, ErrPending
+	}
+	return out, nil
+}
+
+// Wait polls Receipt every interval until the operation is included or ctx
+// ends. interval defaults to 1s.
+func (b *Bundler) Wait(ctx context.Context, hash common.Hash, interval time.Duration) (*Receipt, error) {
+	if interval == 0 {
+		interval = time.Second
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		r, err := b.Receipt(ctx, hash)
+		if !errors.Is(err, ErrPending) {
+			return r, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package userop
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	ierc20token "github.com/primev/fastprotocolapp/contracts-abi/clients/IERC20Token"
+	"github.com/primev/fastprotocolapp/contracts-abi/settlement"
+)
+
+// accountABI is SimpleAccount v0.7's execution surface, which most
+// ERC-4337 accounts implement or mirror.
+const accountABI = `[
+	{"type":"function","name":"execute","stateMutability":"nonpayable","inputs":[{"name":"dest","type":"address"},{"name":"value","type":"uint256"},{"name":"func","type":"bytes"}],"outputs":[]},
+	{"type":"function","name":"executeBatch","stateMutability":"nonpayable","inputs":[{"name":"dest","type":"address[]"},{"name":"value","type":"uint256[]"},{"name":"func","type":"bytes[]"}],"outputs":[]}
+]`
+
+var (
+	account = func() abi.ABI {
+		parsed, err := abi.JSON(strings.NewReader(accountABI))
+		if err != nil {
+			panic(err)
+		}
+		return parsed
+	}()
+	erc20 = func() *abi.ABI {
+		parsed, err := ierc20token.Ierc20tokenMetaData.GetAbi()
+		if err != nil {
+			panic(err)
+		}
+		return parsed
+	}()
+)
+
+// ErrNoCalls is returned by CallData for an empty batch.
+var ErrNoCalls = errors.New("userop: no calls")
+
+// MaxUint256 is the unlimited allowance ApprovePermit2 grants by default.
+var MaxUint256 = new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
+
+// Call is one call made by the smart account.
+type Call struct {
+	To    common.Address
+	Value *big.Int
+	Data  []byte
+}
+
+// CallData encodes calls as the account's callData: execute for a single
+// call, executeBatch otherwise.
+func CallData(calls ...Call) ([]byte, error) {
+	switch len(calls) {
+	case 0:
+		return nil, ErrNoCalls
+	case 1:
+		return account.Pack("execute", calls[0].To, value(calls[0].Value), calls[0].Data)
+	}
+	dest := make([]common.Address, len(calls))
+	values := make([]*big.Int, len(calls))
+	data := make([][]byte, len(calls))
+	for i, c := range calls {
+		dest[i], values[i], data[i] = c.To, value(c.Value), c.Data
+	}
+	return account.Pack("executeBatch", dest, values, data)
+}
+
+func value(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
+
+// ExecuteWithETH is the account's executeWithETH call on the settlement at
+// settlementAddr. intent.User must be the account, and the call carries
+// intent.InputAmt as msg.value.
+func ExecuteWithETH(settlementAddr common.Address, intent settlement.Intent, swapData settlement.SwapCall) (Call, error) {
+	parsed, err := fastsettlementv3.Fastsettlementv3MetaData.GetAbi()
+	if err != nil {
+		return Call{}, err
+	}
+	data, err := parsed.Pack("executeWithETH", intent, swapData)
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: settlementAddr, Value: intent.InputAmt, Data: data}, nil
+}
+
+// ApprovePermit2 is the account's approval of amount of token to Permit2,
+// after which intents the account signs through ERC-1271 can be settled with
+// executeWithPermit. A nil amount approves MaxUint256.
+func ApprovePermit2(token, permit2Addr common.Address, amount *big.Int) (Call, error) {
+	if amount == nil {
+		amount = MaxUint256
+	}
+	data, err := erc20.Pack("approve", permit2Addr, amount)
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: token, Data: data}, nil
+}
+
+// Nonce reads sender's next nonce for key from the EntryPoint. Most
+// operations use key 0, a single sequential nonce.
+func Nonce(ctx context.Context, caller bind.ContractCaller, entryPoint, sender common.Address, key *big.Int) (*big.Int, error) {
+	if key == nil {
+		key = new(big.Int)
+	}
+	c := bind.NewBoundContract(entryPoint, EntryPointABI, caller, nil, nil)
+	var out []interface{}
+	if err := c.Call(&bind.CallOpts{Context: ctx}, &out, "getNonce", sender, key); err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
@@ -0,0 +1,250 @@
+// Package userop lets smart accounts use the settlement through ERC-4337
+// v0.7. A smart account cannot produce the EOA signature executeWithPermit's
+// Permit2 witness expects from a plain key, so it takes one of two routes:
+//
+//   - it sends executeWithETH itself, as the call of a UserOperation;
+//   - it approves Permit2 in a UserOperation and then pre-signs intents
+//     whose user is the account, which Permit2 checks through ERC-1271
+//     (see permit2.Domain.VerifyAny).
+//
+// UserOperation is the unpacked form bundlers speak over JSON-RPC; Pack
+// converts it to the PackedUserOperation the EntryPoint hashes and executes.
+// Hash computes the userOpHash exactly as EntryPoint v0.7's getUserOpHash,
+// Bundler is a client for the eth_*UserOperation* methods, and HandleOps
+// encodes a direct EntryPoint.handleOps call for self-bundling and local
+// tests against testharness's EntryPoint.
+//
+// testharness's EntryPoint is a minimal stand-in, not the canonical
+// contract, which this repo does not vendor. Hash is pinned to the canonical
+// getUserOpHash by fixed vectors, and by TestCanonicalEntryPoint, which
+// calls EntryPointV07 itself when RPC_URL names a chain it is deployed on.
+package userop
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/primev/fastprotocolapp/contracts-abi/reverts"
+)
+
+// EntryPointV07 is the canonical EntryPoint v0.7 deployment address.
+var EntryPointV07 = common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+
+// ErrTooLarge is returned when a gas value does not fit the 128 bits the
+// packed form gives it.
+var ErrTooLarge = errors.New("userop: value exceeds 128 bits")
+
+// UserOperation is an ERC-4337 v0.7 user operation in the unpacked form of
+// the bundler JSON-RPC API. Factory, Paymaster and their fields are omitted
+// when unused.
+type UserOperation struct {
+	Sender                        common.Address  `json:"sender"`
+	Nonce                         *hexutil.Big    `json:"nonce"`
+	Factory                       *common.Address `json:"factory,omitempty"`
+	FactoryData                   hexutil.Bytes   `json:"factoryData,omitempty"`
+	CallData                      hexutil.Bytes   `json:"callData"`
+	CallGasLimit                  *hexutil.Big    `json:"callGasLimit"`
+	VerificationGasLimit          *hexutil.Big    `json:"verificationGasLimit"`
+	PreVerificationGas            *hexutil.Big    `json:"preVerificationGas"`
+	MaxFeePerGas                  *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas          *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Paymaster                     *common.Address `json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit *hexutil.Big    `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big    `json:"paymasterPostOpGasLimit,omitempty"`
+	PaymasterData                 hexutil.Bytes   `json:"paymasterData,omitempty"`
+	Signature                     hexutil.Bytes   `json:"signature"`
+}
+
+// PackedUserOperation is the EntryPoint v0.7 struct. Field names match the
+// Solidity struct so it can be passed to abi.Pack directly.
+type PackedUserOperation struct {
+	Sender             common.Address
+	Nonce              *big.Int
+	InitCode           []byte
+	CallData           []byte
+	AccountGasLimits   [32]byte
+	PreVerificationGas *big.Int
+	GasFees            [32]byte
+	PaymasterAndData   []byte
+	Signature          []byte
+}
+
+// Pack converts op to its packed form: initCode is factory || factoryData,
+// accountGasLimits is verificationGasLimit || callGasLimit, gasFees is
+// maxPriorityFeePerGas || maxFeePerGas, and paymasterAndData is paymaster ||
+// paymasterVerificationGasLimit || paymasterPostOpGasLimit || paymasterData,
+// each gas value taking 16 bytes.
+func (op *UserOperation) Pack() (PackedUserOperation, error) {
+	p := PackedUserOperation{
+		Sender:             op.Sender,
+		Nonce:              toInt(op.Nonce),
+		CallData:           op.CallData,
+		PreVerificationGas: toInt(op.PreVerificationGas),
+		Signature:          op.Signature,
+	}
+	var err error
+	if p.AccountGasLimits, err = pair(op.VerificationGasLimit, op.CallGasLimit); err != nil {
+		return PackedUserOperation{}, err
+	}
+	if p.GasFees, err = pair(op.MaxPriorityFeePerGas, op.MaxFeePerGas); err != nil {
+		return PackedUserOperation{}, err
+	}
+	if op.Factory != nil {
+		p.InitCode = append(op.Factory.Bytes(), op.FactoryData...)
+	}
+	if op.Paymaster != nil {
+		limits, err := pair(op.PaymasterVerificationGasLimit, op.PaymasterPostOpGasLimit)
+		if err != nil {
+			return PackedUserOperation{}, err
+		}
+		p.PaymasterAndData = append(append(op.Paymaster.Bytes(), limits[:]...), op.PaymasterData...)
+	}
+	return p, nil
+}
+
+// pair packs hi and lo into the two 16-byte halves of a word.
+func pair(hi, lo *hexutil.Big) ([32]byte, error) {
+	var out [32]byte
+	h, l := toInt(hi), toInt(lo)
+	if h.BitLen() > 128 || l.BitLen() > 128 {
+		return out, ErrTooLarge
+	}
+	h.FillBytes(out[:16])
+	l.FillBytes(out[16:])
+	return out, nil
+}
+
+func toInt(x *hexutil.Big) *big.Int {
+	if x == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(x.ToInt())
+}
+
+var (
+	bytes32Ty, _ = abi.NewType("bytes32", "", nil)
+	uint256Ty, _ = abi.NewType("uint256", "", nil)
+	addressTy, _ = abi.NewType("address", "", nil)
+
+	// opFields is UserOperationLib.encode's layout, with the dynamic fields
+	// replaced by their hashes.
+	opFields = abi.Arguments{
+		{Type: addressTy}, {Type: uint256Ty}, {Type: bytes32Ty}, {Type: bytes32Ty},
+		{Type: bytes32Ty}, {Type: uint256Ty}, {Type: bytes32Ty}, {Type: bytes32Ty},
+	}
+	hashFields = abi.Arguments{{Type: bytes32Ty}, {Type: addressTy}, {Type: uint256Ty}}
+)
+
+// Hash returns the userOpHash EntryPoint v0.7 at entryPoint on chainID
+// computes for p: keccak256(abi.encode(keccak256(encode(p)), entryPoint,
+// chainId)). The signature is not part of the hash.
+func (p PackedUserOperation) Hash(entryPoint common.Address, chainID *big.Int) common.Hash {
+	enc, err := opFields.Pack(
+		p.Sender, p.Nonce,
+		crypto.Keccak256Hash(p.InitCode), crypto.Keccak256Hash(p.CallData),
+		p.AccountGasLimits, p.PreVerificationGas, p.GasFees,
+		crypto.Keccak256Hash(p.PaymasterAndData),
+	)
+	if err != nil {
+		panic(err) // static types; only a nil big.Int can fail, and Pack never leaves one
+	}
+	outer, err := hashFields.Pack(crypto.Keccak256Hash(enc), entryPoint, chainID)
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(outer)
+}
+
+// Hash packs op and returns its userOpHash.
+func (op *UserOperation) Hash(entryPoint common.Address, chainID *big.Int) (common.Hash, error) {
+	p, err := op.Pack()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return p.Hash(entryPoint, chainID), nil
+}
+
+// Sign sets op.Signature to key's signature over the userOpHash, in the
+// EIP-191 personal-message form SimpleAccount-style accounts recover: the
+// account owner signs keccak256("\x19Ethereum Signed Message:\n32" ||
+// userOpHash), with v in {27, 28}.
+func (op *UserOperation) Sign(key *ecdsa.PrivateKey, entryPoint common.Address, chainID *big.Int) error {
+	hash, err := op.Hash(entryPoint, chainID)
+	if err != nil {
+		return err
+	}
+	sig, err := crypto.Sign(accounts.TextHash(hash.Bytes()), key)
+	if err != nil {
+		return fmt.Errorf("userop: sign: %w", err)
+	}
+	sig[64] += 27
+	op.Signature = sig
+	return nil
+}
+
+// DummySignature is a well-formed 65-byte signature for gas estimation,
+// which simulates validation before the operation is signed.
+var DummySignature = hexutil.MustDecode("0x" + strings.Repeat("ff", 64) + "1c")
+
+const entryPointABI = `[
+	{"type":"function","name":"handleOps","stateMutability":"nonpayable","inputs":[
+		{"name":"ops","type":"tuple[]","components":[
+			{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},
+			{"name":"initCode","type":"bytes"},{"name":"callData","type":"bytes"},
+			{"name":"accountGasLimits","type":"bytes32"},{"name":"preVerificationGas","type":"uint256"},
+			{"name":"gasFees","type":"bytes32"},{"name":"paymasterAndData","type":"bytes"},
+			{"name":"signature","type":"bytes"}]},
+		{"name":"beneficiary","type":"address"}],"outputs":[]},
+	{"type":"function","name":"getUserOpHash","stateMutability":"view","inputs":[
+		{"name":"userOp","type":"tuple","components":[
+			{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},
+			{"name":"initCode","type":"bytes"},{"name":"callData","type":"bytes"},
+			{"name":"accountGasLimits","type":"bytes32"},{"name":"preVerificationGas","type":"uint256"},
+			{"name":"gasFees","type":"bytes32"},{"name":"paymasterAndData","type":"bytes"},
+			{"name":"signature","type":"bytes"}]}],
+		"outputs":[{"name":"","type":"bytes32"}]},
+	{"type":"function","name":"getNonce","stateMutability":"view","inputs":[{"name":"sender","type":"address"},{"name":"key","type":"uint192"}],"outputs":[{"name":"nonce","type":"uint256"}]},
+	{"type":"function","name":"depositTo","stateMutability":"payable","inputs":[{"name":"account","type":"address"}],"outputs":[]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"event","name":"UserOperationEvent","anonymous":false,"inputs":[
+		{"name":"userOpHash","type":"bytes32","indexed":true},{"name":"sender","type":"address","indexed":true},
+		{"name":"paymaster","type":"address","indexed":true},{"name":"nonce","type":"uint256","indexed":false},
+		{"name":"success","type":"bool","indexed":false},{"name":"actualGasCost","type":"uint256","indexed":false},
+		{"name":"actualGasUsed","type":"uint256","indexed":false}]},
+	{"type":"error","name":"FailedOp","inputs":[{"name":"opIndex","type":"uint256"},{"name":"reason","type":"string"}]}
+]`
+
+// EntryPointABI covers the EntryPoint v0.7 methods, event and error this
+// package uses.
+var EntryPointABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(entryPointABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// FailedOp reverts from handleOps decode through the reverts package.
+func init() { reverts.Register(EntryPointABI) }
+
+// HandleOps encodes EntryPoint.handleOps(ops, beneficiary), for a bundler
+// of one's own or a local EntryPoint without a bundler in front of it.
+func HandleOps(ops []*UserOperation, beneficiary common.Address) ([]byte, error) {
+	packed := make([]PackedUserOperation, len(ops))
+	for i, op := range ops {
+		p, err := op.Pack()
+		if err != nil {
+			return nil, fmt.Errorf("userop: op %d: %w", i, err)
+		}
+		packed[i] = p
+	}
+	return EntryPointABI.Pack("handleOps", packed, beneficiary)
+}
@@ -0,0 +1,231 @@
+package userop_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/primev/fastprotocolapp/contracts-abi/testharness"
+	"github.com/primev/fastprotocolapp/contracts-abi/userop"
+)
+
+func big64(x int64) *hexutil.Big { return (*hexutil.Big)(big.NewInt(x)) }
+
+// vectorOp is an operation with every optional part: a factory deploying the
+// account, a nonce key, and a paymaster with data.
+func vectorOp() *userop.UserOperation {
+	factory := common.HexToAddress("0x91E60e0613810449d098b0b5Ec8b51A0FE8c8985")
+	paymaster := common.HexToAddress("0x0000000000325602a77416A16136FDafd04b299f")
+	nonce := new(big.Int).Lsh(big.NewInt(1), 64)
+	nonce.Or(nonce, big.NewInt(7))
+	return &userop.UserOperation{
+		Sender:                        common.HexToAddress("0x8ba1f109551bD432803012645Ac136ddd64DBA72"),
+		Nonce:                         (*hexutil.Big)(nonce),
+		Factory:                       &factory,
+		FactoryData:                   hexutil.MustDecode("0x5fbfb9cf00000000000000000000000000000000000000000000000000000000000a11ce0000000000000000000000000000000000000000000000000000000000000000"),
+		CallData:                      hexutil.MustDecode("0xb61d27f60000000000000000000000005e771e5e771e5e771e5e771e5e771e5e771e5e77000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000600000000000000000000000000000000000000000000000000000000000000004deadbeef00000000000000000000000000000000000000000000000000000000"),
+		CallGasLimit:                  big64(200_000),
+		VerificationGasLimit:          big64(100_000),
+		PreVerificationGas:            big64(50_000),
+		MaxFeePerGas:                  big64(30_000_000_000),
+		MaxPriorityFeePerGas:          big64(1_000_000_000),
+		Paymaster:                     &paymaster,
+		PaymasterVerificationGasLimit: big64(60_000),
+		PaymasterPostOpGasLimit:       big64(30_000),
+		PaymasterData:                 hexutil.MustDecode("0x0badf00d"),
+		Signature:                     userop.DummySignature,
+	}
+}
+
+// TestHashVectors checks Hash against getUserOpHash of EntryPoint v0.7,
+// keccak256(abi.encode(keccak256(UserOperationLib.encode(op)), entryPoint,
+// chainid)). The expected hashes were computed outside Go, from that
+// definition, by a separate Keccak-256 and ABI encoder.
+func TestHashVectors(t *testing.T) {
+	full := vectorOp()
+	bare := vectorOp()
+	bare.Nonce = big64(0)
+	bare.Factory, bare.FactoryData = nil, nil
+	bare.Paymaster, bare.PaymasterVerificationGasLimit, bare.PaymasterPostOpGasLimit, bare.PaymasterData = nil, nil, nil, nil
+
+	for _, tt := range []struct {
+		name    string
+		op      *userop.UserOperation
+		chainID int64
+		want    string
+	}{
+		{"factory and paymaster, mainnet", full, 1, "0xeb7adde41c0aa7aba8dc00f51f41a692985342900f4632bac33b18d3fa1a62a1"},
+		{"bare, sepolia", bare, 11155111, "0xa5d006124c33c5076ce236593db12bb39326d16bf858f81fa037bb5b06354d27"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op.Hash(userop.EntryPointV07, big.NewInt(tt.chainID))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Hex() != tt.want {
+				t.Fatalf("hash %s, want %s", got.Hex(), tt.want)
+			}
+			// The signature is not hashed.
+			tt.op.Signature = nil
+			if again, _ := tt.op.Hash(userop.EntryPointV07, big.NewInt(tt.chainID)); again != got {
+				t.Fatal("hash depends on the signature")
+			}
+		})
+	}
+}
+
+// TestCanonicalEntryPoint checks Pack and Hash against getUserOpHash of the
+// deployed EntryPoint v0.7, which the harness does not vendor. It runs when
+// RPC_URL names a chain with the canonical deployment.
+func TestCanonicalEntryPoint(t *testing.T) {
+	url := os.Getenv("RPC_URL")
+	if url == "" {
+		t.Skip("RPC_URL not set")
+	}
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code, err := client.CodeAt(ctx, userop.EntryPointV07, nil); err != nil || len(code) == 0 {
+		t.Skipf("no EntryPoint v0.7 on chain %s (err %v)", chainID, err)
+	}
+
+	bare := vectorOp()
+	bare.Factory, bare.FactoryData, bare.Paymaster, bare.PaymasterData = nil, nil, nil, nil
+	bare.PaymasterVerificationGasLimit, bare.PaymasterPostOpGasLimit = nil, nil
+	for name, op := range map[string]*userop.UserOperation{"full": vectorOp(), "bare": bare} {
+		packed, err := op.Pack()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := userop.EntryPointABI.Pack("getUserOpHash", packed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := client.CallContract(ctx, ethereum.CallMsg{To: &userop.EntryPointV07, Data: data}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, _ := op.Hash(userop.EntryPointV07, chainID)
+		if got := common.BytesToHash(out); got != want {
+			t.Errorf("%s: getUserOpHash = %s, Hash = %s", name, got, want)
+		}
+	}
+}
+
+func TestPack(t *testing.T) {
+	p, err := vectorOp().Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name      string
+		got, want []byte
+	}{
+		{"initCode", p.InitCode[:20], common.HexToAddress("0x91E60e0613810449d098b0b5Ec8b51A0FE8c8985").Bytes()},
+		{"accountGasLimits", p.AccountGasLimits[:], hexutil.MustDecode("0x000000000000000000000000000186a000000000000000000000000000030d40")},
+		{"gasFees", p.GasFees[:], hexutil.MustDecode("0x0000000000000000000000003b9aca00000000000000000000000006fc23ac00")},
+		{"paymasterAndData", p.PaymasterAndData, hexutil.MustDecode("0x0000000000325602a77416a16136fdafd04b299f0000000000000000000000000000ea6000000000000000000000000000007530" + "0badf00d")},
+	} {
+		if !bytes.Equal(tt.got, tt.want) {
+			t.Errorf("%s = %x, want %x", tt.name, tt.got, tt.want)
+		}
+	}
+
+	op := vectorOp()
+	op.CallGasLimit = (*hexutil.Big)(new(big.Int).Lsh(big.NewInt(1), 128))
+	if _, err := op.Pack(); !errors.Is(err, userop.ErrTooLarge) {
+		t.Fatalf("129-bit gas limit: err = %v, want ErrTooLarge", err)
+	}
+}
+
+func TestSign(t *testing.T) {
+	key, _ := crypto.ToECDSA(crypto.Keccak256([]byte("owner")))
+	op := vectorOp()
+	if err := op.Sign(key, userop.EntryPointV07, big.NewInt(1)); err != nil {
+		t.Fatal(err)
+	}
+	hash, _ := op.Hash(userop.EntryPointV07, big.NewInt(1))
+	sig := append([]byte(nil), op.Signature...)
+	if v := sig[64]; v != 27 && v != 28 {
+		t.Fatalf("v = %d", v)
+	}
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(accounts.TextHash(hash.Bytes()), sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crypto.PubkeyToAddress(*pub) != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatal("signature does not recover to the owner")
+	}
+}
+
+// TestHandleOps runs an operation through the harness EntryPoint: its
+// getUserOpHash must match Hash, and the signed operation must execute.
+func TestHandleOps(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{SmartAccounts: true})
+	salt := big.NewInt(1)
+	account, err := h.SmartAccountAddress(h.User.Address, salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.SendETH(h.User, account, testharness.Ether); err != nil {
+		t.Fatal(err)
+	}
+	op, err := h.NewUserOp(account, userop.Call{To: h.Recipient.Address, Value: big.NewInt(1_000)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.AccountInitCode(op, h.User.Address, salt); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.SignUserOp(h.User, op); err != nil {
+		t.Fatal(err)
+	}
+	packed, err := op.Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ep, ok := h.Artifact("HarnessEntryPoint")
+	if !ok {
+		t.Fatal("no HarnessEntryPoint artifact")
+	}
+	out, err := h.Call(ep, h.EntryPoint, "getUserOpHash", packed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := op.Hash(h.EntryPoint, h.ChainID)
+	if got := common.Hash(out[0].([32]byte)); got != want {
+		t.Fatalf("getUserOpHash = %s, Hash = %s", got, want)
+	}
+
+	before, err := h.Client.BalanceAt(context.Background(), h.Recipient.Address, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.HandleOps(h.Executor, op); err != nil {
+		t.Fatal(err)
+	}
+	after, err := h.Client.BalanceAt(context.Background(), h.Recipient.Address, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := new(big.Int).Sub(after, before); d.Int64() != 1_000 {
+		t.Fatalf("recipient received %s, want 1000", d)
+	}
+}
@@ -0,0 +1,219 @@
+package watchdog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Severity ranks alerts.
+type Severity int
+
+const (
+	// Info records an expected change, e.g. an upgrade to a listed
+	// implementation, or a balance returning under its limit.
+	Info Severity = iota
+	// Warning is a deviation that does not put funds at risk, such as an
+	// expected swap target being disabled.
+	Warning
+	// Critical is an unexpected admin change or stranded funds.
+	Critical
+)
+
+var severityNames = [...]string{"info", "warning", "critical"}
+
+func (s Severity) String() string {
+	if s < 0 || int(s) >= len(severityNames) {
+		return fmt.Sprintf("severity(%d)", int(s))
+	}
+	return severityNames[s]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Severity) MarshalText() ([]byte, error) { return []byte(s.String()), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Severity) UnmarshalText(text []byte) error {
+	for i, name := range severityNames {
+		if string(text) == name {
+			*s = Severity(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("watchdog: unknown severity %q", text)
+}
+
+// Kind classifies alerts.
+type Kind string
+
+const (
+	KindUpgrade     Kind = "upgrade"
+	KindOwnership   Kind = "ownership"
+	KindExecutor    Kind = "executor"
+	KindSwapTargets Kind = "swap-targets"
+	KindBalance     Kind = "balance"
+	// KindConfig is a mismatch found by Audit rather than by an event.
+	KindConfig Kind = "config"
+)
+
+// Alert is one watchdog finding.
+type Alert struct {
+	Severity Severity       `json:"severity"`
+	Kind     Kind           `json:"kind"`
+	Contract common.Address `json:"contract"`
+	Message  string         `json:"message"`
+	// Block and TxHash locate the event that raised the alert; zero for
+	// polled state.
+	Block  uint64            `json:"block,omitempty"`
+	TxHash common.Hash       `json:"txHash,omitempty"`
+	Fields map[string]string `json:"fields,omitempty"`
+	Time   time.Time         `json:"time"`
+}
+
+func (a Alert) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s [%s] %s %s: %s", a.Time.UTC().Format(time.RFC3339), strings.ToUpper(a.Severity.String()), a.Kind, a.Contract.Hex(), a.Message)
+	if a.Block != 0 {
+		fmt.Fprintf(&b, " block=%d tx=%s", a.Block, a.TxHash.Hex())
+	}
+	keys := make([]string, 0, len(a.Fields))
+	for k := range a.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&b, " %s=%s", k, a.Fields[k])
+	}
+	return b.String()
+}
+
+// Sink delivers alerts.
+type Sink interface {
+	Send(ctx context.Context, a Alert) error
+}
+
+// WriterSink writes one line per alert, as text or JSON.
+type WriterSink struct {
+	JSON bool
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterSink returns a WriterSink writing to w.
+func NewWriterSink(w io.Writer, asJSON bool) *WriterSink {
+	return &WriterSink{JSON: asJSON, w: w}
+}
+
+// Stdout returns a text WriterSink on standard output.
+func Stdout() *WriterSink { return NewWriterSink(os.Stdout, false) }
+
+// Send implements Sink.
+func (s *WriterSink) Send(_ context.Context, a Alert) error {
+	var line []byte
+	if s.JSON {
+		var err error
+		if line, err = json.Marshal(a); err != nil {
+			return err
+		}
+	} else {
+		line = []byte(a.String())
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.w.Write(append(line, '\n'))
+	return err
+}
+
+// FileSink appends alerts to a file as JSON lines.
+type FileSink struct {
+	*WriterSink
+	f *os.File
+}
+
+// OpenFileSink opens path for appending, creating it if needed.
+func OpenFileSink(path string) (*FileSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{WriterSink: NewWriterSink(f, true), f: f}, nil
+}
+
+// Send implements Sink, syncing the file so alerts survive a crash.
+func (s *FileSink) Send(ctx context.Context, a Alert) error {
+	if err := s.WriterSink.Send(ctx, a); err != nil {
+		return err
+	}
+	return s.f.Sync()
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error { return s.f.Close() }
+
+// Webhook posts each alert as JSON.
+type Webhook struct {
+	URL string
+	// Header is sent with every request, e.g. an Authorization token.
+	Header http.Header
+	Client *http.Client
+}
+
+// Send implements Sink.
+func (h *Webhook) Send(ctx context.Context, a Alert) error {
+	body, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, vs := range h.Header {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := h.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("watchdog: webhook HTTP %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// MinSeverity drops alerts below min before passing them to sink.
+func MinSeverity(min Severity, sink Sink) Sink {
+	return filtered{min: min, sink: sink}
+}
+
+type filtered struct {
+	min  Severity
+	sink Sink
+}
+
+func (f filtered) Send(ctx context.Context, a Alert) error {
+	if a.Severity < f.min {
+		return nil
+	}
+	return f.sink.Send(ctx, a)
+}
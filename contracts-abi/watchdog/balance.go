@@ -0,0 +1,77 @@
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ierc20token "github.com/primev/fastprotocolapp/contracts-abi/clients/IERC20Token"
+)
+
+// Balance reads the settlement contract's balance of token, the zero address
+// meaning ETH.
+func (w *Watchdog) Balance(ctx context.Context, token common.Address) (*big.Int, error) {
+	holder := w.cfg.Expected.Settlement
+	if token == (common.Address{}) {
+		return w.cfg.Backend.BalanceAt(ctx, holder, nil)
+	}
+	c, err := ierc20token.NewIerc20tokenCaller(token, w.cfg.Backend)
+	if err != nil {
+		return nil, err
+	}
+	return c.BalanceOf(&bind.CallOpts{Context: ctx}, holder)
+}
+
+// Balances polls every token in Expected.Balances. A balance over its limit
+// raises a critical alert once, and again only if it changes; a balance back
+// within its limit raises an info alert. Alerts are sent to the sinks and
+// returned. Tokens whose balance cannot be read are skipped and reported in
+// the error.
+func (w *Watchdog) Balances(ctx context.Context) ([]Alert, error) {
+	var (
+		alerts []Alert
+		failed []string
+	)
+	for _, l := range w.cfg.Expected.Balances {
+		bal, err := w.Balance(ctx, l.Token)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", l.name(), err))
+			continue
+		}
+		if a, ok := w.checkBalance(l, bal); ok {
+			alerts = append(alerts, a)
+		}
+	}
+	w.emit(ctx, alerts...)
+	if len(failed) > 0 {
+		return alerts, fmt.Errorf("watchdog: balances: %s", strings.Join(failed, "; "))
+	}
+	return alerts, nil
+}
+
+func (w *Watchdog) checkBalance(l BalanceLimit, bal *big.Int) (Alert, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	last := w.stranded[l.Token]
+	fields := map[string]string{
+		"token":   l.Token.Hex(),
+		"symbol":  l.name(),
+		"balance": bal.String(),
+		"max":     l.max().String(),
+	}
+	if bal.Cmp(l.max()) > 0 {
+		if last != nil && last.Cmp(bal) == 0 {
+			return Alert{}, false
+		}
+		w.stranded[l.Token] = bal
+		return w.alert(Critical, KindBalance, fmt.Sprintf("settlement holds stranded %s", l.name()), fields), true
+	}
+	if last == nil {
+		return Alert{}, false
+	}
+	delete(w.stranded, l.Token)
+	return w.alert(Info, KindBalance, fmt.Sprintf("%s balance back within limit", l.name()), fields), true
+}
@@ -0,0 +1,126 @@
+package watchdog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// Expected is the settlement deployment's intended configuration, loaded
+// from a JSON file:
+//
+//	{
+//	  "settlement": "0xProxy",
+//	  "implementations": ["0xImplV3", "0xImplV3_1"],
+//	  "owner": "0xSafe",
+//	  "executor": "0xExecutor",
+//	  "swapTargets": ["0xRouter"],
+//	  "balances": [
+//	    {"token": "0x0000000000000000000000000000000000000000", "symbol": "ETH", "max": "0"},
+//	    {"token": "0xUSDC", "symbol": "USDC", "max": "1000000"}
+//	  ]
+//	}
+//
+// Implementations lists every implementation the proxy may be upgraded to,
+// the current one included. Balances lists the tokens to poll; the zero
+// address is ETH, as in the contract's _getBalance.
+type Expected struct {
+	Settlement      common.Address   `json:"settlement"`
+	Implementations []common.Address `json:"implementations"`
+	Owner           common.Address   `json:"owner"`
+	Executor        common.Address   `json:"executor"`
+	SwapTargets     []common.Address `json:"swapTargets"`
+	Balances        []BalanceLimit   `json:"balances"`
+}
+
+// BalanceLimit bounds what the settlement contract may hold of a token.
+// _execute pays out or refunds everything it touches, so anything above Max
+// is stranded.
+type BalanceLimit struct {
+	Token  common.Address `json:"token"`
+	Symbol string         `json:"symbol,omitempty"`
+	// Max is in base units, decimal or 0x-hex. Defaults to 0.
+	Max *math.HexOrDecimal256 `json:"max,omitempty"`
+}
+
+func (l BalanceLimit) max() *big.Int {
+	if l.Max == nil {
+		return new(big.Int)
+	}
+	return (*big.Int)(l.Max)
+}
+
+func (l BalanceLimit) name() string {
+	switch {
+	case l.Symbol != "":
+		return l.Symbol
+	case l.Token == (common.Address{}):
+		return "ETH"
+	}
+	return l.Token.Hex()
+}
+
+// LoadExpected reads an Expected from a JSON file. Unknown fields are
+// rejected so that a misspelt key cannot silently disable a check.
+func LoadExpected(path string) (*Expected, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	var e Expected
+	if err := dec.Decode(&e); err != nil {
+		return nil, fmt.Errorf("watchdog: %s: %w", path, err)
+	}
+	if err := e.validate(); err != nil {
+		return nil, fmt.Errorf("watchdog: %s: %w", path, err)
+	}
+	return &e, nil
+}
+
+func (e *Expected) validate() error {
+	if e.Settlement == (common.Address{}) {
+		return errors.New("settlement is required")
+	}
+	if len(e.Implementations) == 0 {
+		return errors.New("at least one implementation is required")
+	}
+	if e.Owner == (common.Address{}) || e.Executor == (common.Address{}) {
+		return errors.New("owner and executor are required")
+	}
+	seen := make(map[common.Address]bool)
+	for _, l := range e.Balances {
+		if seen[l.Token] {
+			return fmt.Errorf("duplicate balance entry for %s", l.Token)
+		}
+		seen[l.Token] = true
+		if l.max().Sign() < 0 {
+			return fmt.Errorf("negative max for %s", l.name())
+		}
+	}
+	return nil
+}
+
+func (e *Expected) implementation(addr common.Address) bool {
+	return contains(e.Implementations, addr)
+}
+
+func (e *Expected) swapTarget(addr common.Address) bool {
+	return contains(e.SwapTargets, addr)
+}
+
+func contains(list []common.Address, addr common.Address) bool {
+	for _, a := range list {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,332 @@
+// Package watchdog raises alerts on unexpected changes to a FastSettlementV3
+// deployment.
+//
+// The proxy's admin surface is small: the owner can upgrade the
+// implementation, hand over ownership, replace the executor and edit the swap
+// target allowlist. Each of these emits an event (Upgraded,
+// OwnershipTransferStarted, ExecutorUpdated, SwapTargetsUpdated) which the
+// Watchdog subscribes to through Fastsettlementv3Filterer and checks against
+// an Expected configuration. Independently it polls the contract's ETH and
+// ERC-20 balances: _execute pays out the user and treasury and refunds unused
+// input within the transaction, so a balance left behind means a swap target
+// or an execution path misbehaved.
+//
+// Alerts go to pluggable Sinks: WriterSink (stdout), FileSink (JSON lines)
+// and Webhook.
+package watchdog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+)
+
+// ImplementationSlot is the EIP-1967 storage slot holding a proxy's
+// implementation address.
+var ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+// Backend is the chain access the Watchdog needs; ethclient.Client and
+// simulated.Client satisfy it. Event subscriptions need a websocket or IPC
+// connection.
+type Backend interface {
+	bind.ContractBackend
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// Config configures a Watchdog.
+type Config struct {
+	Expected *Expected
+	Backend  Backend
+	Sinks    []Sink
+	// PollInterval between balance checks. Defaults to 30s.
+	PollInterval time.Duration
+	// Logger defaults to slog.Default().
+	Logger *slog.Logger
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+// Watchdog checks a settlement deployment against its Expected config.
+type Watchdog struct {
+	cfg      Config
+	caller   *fastsettlementv3.Fastsettlementv3Caller
+	filterer *fastsettlementv3.Fastsettlementv3Filterer
+
+	mu sync.Mutex
+	// stranded holds the last alerted balance of each token over its limit.
+	stranded map[common.Address]*big.Int
+}
+
+// New returns a Watchdog for cfg.
+func New(cfg Config) (*Watchdog, error) {
+	if cfg.Expected == nil || cfg.Backend == nil {
+		return nil, errors.New("watchdog: Config.Expected and Config.Backend are required")
+	}
+	if err := cfg.Expected.validate(); err != nil {
+		return nil, fmt.Errorf("watchdog: %w", err)
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = 30 * time.Second
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	addr := cfg.Expected.Settlement
+	caller, err := fastsettlementv3.NewFastsettlementv3Caller(addr, cfg.Backend)
+	if err != nil {
+		return nil, err
+	}
+	filterer, err := fastsettlementv3.NewFastsettlementv3Filterer(addr, cfg.Backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Watchdog{
+		cfg:      cfg,
+		caller:   caller,
+		filterer: filterer,
+		stranded: make(map[common.Address]*big.Int),
+	}, nil
+}
+
+// Run audits the deployment, then watches events and polls balances until
+// ctx is done or a subscription fails. Events emitted while Run is not
+// running are missed, so callers restarting it after an error rely on the
+// initial Audit to catch their effects.
+func (w *Watchdog) Run(ctx context.Context) error {
+	if _, err := w.Audit(ctx); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	opts := &bind.WatchOpts{Context: ctx}
+
+	upgraded := make(chan *fastsettlementv3.Fastsettlementv3Upgraded)
+	ownership := make(chan *fastsettlementv3.Fastsettlementv3OwnershipTransferStarted)
+	executor := make(chan *fastsettlementv3.Fastsettlementv3ExecutorUpdated)
+	targets := make(chan *fastsettlementv3.Fastsettlementv3SwapTargetsUpdated)
+	var subs []event.Subscription
+	defer func() {
+		for _, s := range subs {
+			s.Unsubscribe()
+		}
+	}()
+	for _, watch := range []func() (event.Subscription, error){
+		func() (event.Subscription, error) { return w.filterer.WatchUpgraded(opts, upgraded, nil) },
+		func() (event.Subscription, error) {
+			return w.filterer.WatchOwnershipTransferStarted(opts, ownership, nil, nil)
+		},
+		func() (event.Subscription, error) { return w.filterer.WatchExecutorUpdated(opts, executor, nil, nil) },
+		func() (event.Subscription, error) { return w.filterer.WatchSwapTargetsUpdated(opts, targets) },
+	} {
+		sub, err := watch()
+		if err != nil {
+			return fmt.Errorf("watchdog: subscribe: %w", err)
+		}
+		subs = append(subs, sub)
+	}
+	errc := make(chan error, len(subs))
+	for _, s := range subs {
+		go func(s event.Subscription) {
+			if err := <-s.Err(); err != nil {
+				errc <- err
+			}
+		}(s)
+	}
+
+	w.cfg.Logger.Info("watchdog running", "settlement", w.cfg.Expected.Settlement, "tokens", len(w.cfg.Expected.Balances))
+	if _, err := w.Balances(ctx); err != nil {
+		w.cfg.Logger.Warn("balance poll failed", "err", err)
+	}
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errc:
+			return fmt.Errorf("watchdog: subscription: %w", err)
+		case ev := <-upgraded:
+			w.emit(ctx, w.Upgraded(ev))
+		case ev := <-ownership:
+			w.emit(ctx, w.OwnershipTransferStarted(ev))
+		case ev := <-executor:
+			w.emit(ctx, w.ExecutorUpdated(ev))
+		case ev := <-targets:
+			w.emit(ctx, w.SwapTargetsUpdated(ev)...)
+		case <-ticker.C:
+			if _, err := w.Balances(ctx); err != nil {
+				w.cfg.Logger.Warn("balance poll failed", "err", err)
+			}
+		}
+	}
+}
+
+// emit sends alerts to every sink. A failing sink is logged and does not
+// stop delivery to the others.
+func (w *Watchdog) emit(ctx context.Context, alerts ...Alert) {
+	for _, a := range alerts {
+		log := w.cfg.Logger.With("kind", a.Kind, "severity", a.Severity)
+		switch a.Severity {
+		case Critical:
+			log.Error(a.Message)
+		case Warning:
+			log.Warn(a.Message)
+		default:
+			log.Info(a.Message)
+		}
+		for _, s := range w.cfg.Sinks {
+			if err := s.Send(ctx, a); err != nil {
+				log.Warn("alert delivery failed", "sink", fmt.Sprintf("%T", s), "err", err)
+			}
+		}
+	}
+}
+
+func (w *Watchdog) alert(sev Severity, kind Kind, msg string, fields map[string]string) Alert {
+	return Alert{
+		Severity: sev,
+		Kind:     kind,
+		Contract: w.cfg.Expected.Settlement,
+		Message:  msg,
+		Fields:   fields,
+		Time:     w.cfg.Now(),
+	}
+}
+
+// Upgraded checks an Upgraded event against Expected.Implementations.
+func (w *Watchdog) Upgraded(ev *fastsettlementv3.Fastsettlementv3Upgraded) Alert {
+	fields := map[string]string{"implementation": ev.Implementation.Hex()}
+	a := w.alert(Info, KindUpgrade, "proxy upgraded to expected implementation", fields)
+	if !w.cfg.Expected.implementation(ev.Implementation) {
+		a = w.alert(Critical, KindUpgrade, "proxy upgraded to unexpected implementation", fields)
+	}
+	return located(a, ev.Raw.BlockNumber, ev.Raw.TxHash)
+}
+
+// OwnershipTransferStarted checks a proposed owner against Expected.Owner.
+func (w *Watchdog) OwnershipTransferStarted(ev *fastsettlementv3.Fastsettlementv3OwnershipTransferStarted) Alert {
+	fields := map[string]string{"previousOwner": ev.PreviousOwner.Hex(), "newOwner": ev.NewOwner.Hex()}
+	a := w.alert(Info, KindOwnership, "ownership transfer to expected owner started", fields)
+	if ev.NewOwner != w.cfg.Expected.Owner {
+		a = w.alert(Critical, KindOwnership, "ownership transfer to unexpected owner started", fields)
+	}
+	return located(a, ev.Raw.BlockNumber, ev.Raw.TxHash)
+}
+
+// ExecutorUpdated checks a new executor against Expected.Executor.
+func (w *Watchdog) ExecutorUpdated(ev *fastsettlementv3.Fastsettlementv3ExecutorUpdated) Alert {
+	fields := map[string]string{"oldExecutor": ev.OldExecutor.Hex(), "newExecutor": ev.NewExecutor.Hex()}
+	a := w.alert(Info, KindExecutor, "executor set to expected address", fields)
+	if ev.NewExecutor != w.cfg.Expected.Executor {
+		a = w.alert(Critical, KindExecutor, "executor set to unexpected address", fields)
+	}
+	return located(a, ev.Raw.BlockNumber, ev.Raw.TxHash)
+}
+
+// SwapTargetsUpdated checks each allowlist change against
+// Expected.SwapTargets: allowing an unlisted target is critical, since the
+// executor's calldata runs against it with the settlement's approvals, and
+// disallowing a listed one is a warning.
+func (w *Watchdog) SwapTargetsUpdated(ev *fastsettlementv3.Fastsettlementv3SwapTargetsUpdated) []Alert {
+	var alerts []Alert
+	for i, target := range ev.Targets {
+		if i >= len(ev.Allowed) {
+			break
+		}
+		fields := map[string]string{"target": target.Hex(), "allowed": fmt.Sprint(ev.Allowed[i])}
+		expected := w.cfg.Expected.swapTarget(target)
+		var a Alert
+		switch {
+		case ev.Allowed[i] && !expected:
+			a = w.alert(Critical, KindSwapTargets, "unexpected swap target allowed", fields)
+		case !ev.Allowed[i] && expected:
+			a = w.alert(Warning, KindSwapTargets, "expected swap target disallowed", fields)
+		default:
+			a = w.alert(Info, KindSwapTargets, "swap target updated as expected", fields)
+		}
+		alerts = append(alerts, located(a, ev.Raw.BlockNumber, ev.Raw.TxHash))
+	}
+	return alerts
+}
+
+func located(a Alert, block uint64, tx common.Hash) Alert {
+	a.Block, a.TxHash = block, tx
+	return a
+}
+
+// Audit reads the deployment's current configuration and alerts on every
+// difference from Expected. Alerts are sent to the sinks and returned.
+func (w *Watchdog) Audit(ctx context.Context) ([]Alert, error) {
+	exp := w.cfg.Expected
+	opts := &bind.CallOpts{Context: ctx}
+	var alerts []Alert
+
+	slot, err := w.cfg.Backend.StorageAt(ctx, exp.Settlement, ImplementationSlot, nil)
+	if err != nil {
+		return nil, fmt.Errorf("watchdog: read implementation: %w", err)
+	}
+	if impl := common.BytesToAddress(slot); !exp.implementation(impl) {
+		alerts = append(alerts, w.alert(Critical, KindConfig, "proxy points at unexpected implementation",
+			map[string]string{"implementation": impl.Hex()}))
+	}
+
+	owner, err := w.caller.Owner(opts)
+	if err != nil {
+		return nil, fmt.Errorf("watchdog: read owner: %w", err)
+	}
+	if owner != exp.Owner {
+		alerts = append(alerts, w.alert(Critical, KindConfig, "unexpected owner",
+			map[string]string{"owner": owner.Hex(), "expected": exp.Owner.Hex()}))
+	}
+	pending, err := w.caller.PendingOwner(opts)
+	if err != nil {
+		return nil, fmt.Errorf("watchdog: read pending owner: %w", err)
+	}
+	if pending != (common.Address{}) && pending != exp.Owner {
+		alerts = append(alerts, w.alert(Critical, KindConfig, "unexpected pending owner",
+			map[string]string{"pendingOwner": pending.Hex()}))
+	}
+
+	executor, err := w.caller.Executor(opts)
+	if err != nil {
+		return nil, fmt.Errorf("watchdog: read executor: %w", err)
+	}
+	if executor != exp.Executor {
+		alerts = append(alerts, w.alert(Critical, KindConfig, "unexpected executor",
+			map[string]string{"executor": executor.Hex(), "expected": exp.Executor.Hex()}))
+	}
+
+	// The allowlist is a mapping, so only the expected targets can be read
+	// back; unexpected additions are caught as SwapTargetsUpdated events.
+	var disabled []string
+	for _, t := range exp.SwapTargets {
+		ok, err := w.caller.AllowedSwapTargets(opts, t)
+		if err != nil {
+			return nil, fmt.Errorf("watchdog: read swap target %s: %w", t, err)
+		}
+		if !ok {
+			disabled = append(disabled, t.Hex())
+		}
+	}
+	if len(disabled) > 0 {
+		alerts = append(alerts, w.alert(Warning, KindConfig, "expected swap targets not allowed",
+			map[string]string{"targets": strings.Join(disabled, ",")}))
+	}
+
+	w.emit(ctx, alerts...)
+	return alerts, nil
+}
@@ -0,0 +1,271 @@
+package watchdog_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	fastsettlementv3 "github.com/primev/fastprotocolapp/contracts-abi/clients/FastSettlementV3"
+	"github.com/primev/fastprotocolapp/contracts-abi/testharness"
+	"github.com/primev/fastprotocolapp/contracts-abi/watchdog"
+)
+
+var (
+	proxy    = common.HexToAddress("0x5e771e5e771e5e771e5e771e5e771e5e771e5e77")
+	impl     = common.HexToAddress("0x0000000000000000000000000000000000001111")
+	owner    = common.HexToAddress("0x0000000000000000000000000000000000000a0a")
+	executor = common.HexToAddress("0x0000000000000000000000000000000000000e0e")
+	router   = common.HexToAddress("0x0000000000000000000000000000000000000f0f")
+	attacker = common.HexToAddress("0x000000000000000000000000000000000000bad0")
+)
+
+// recorder is a Sink keeping every alert.
+type recorder struct {
+	mu     sync.Mutex
+	alerts []watchdog.Alert
+}
+
+func (r *recorder) Send(_ context.Context, a watchdog.Alert) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.alerts = append(r.alerts, a)
+	return nil
+}
+
+func (r *recorder) find(kind watchdog.Kind, sev watchdog.Severity) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, a := range r.alerts {
+		if a.Kind == kind && a.Severity == sev {
+			return true
+		}
+	}
+	return false
+}
+
+// backend satisfies watchdog.Backend for checks that never reach the chain.
+type backend struct{ watchdog.Backend }
+
+func expected() *watchdog.Expected {
+	return &watchdog.Expected{
+		Settlement:      proxy,
+		Implementations: []common.Address{impl},
+		Owner:           owner,
+		Executor:        executor,
+		SwapTargets:     []common.Address{router},
+	}
+}
+
+func TestEventChecks(t *testing.T) {
+	w, err := watchdog.New(watchdog.Config{Expected: expected(), Backend: backend{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name string
+		got  watchdog.Alert
+		want watchdog.Severity
+	}{
+		{"listed upgrade", w.Upgraded(&fastsettlementv3.Fastsettlementv3Upgraded{Implementation: impl}), watchdog.Info},
+		{"unlisted upgrade", w.Upgraded(&fastsettlementv3.Fastsettlementv3Upgraded{Implementation: attacker}), watchdog.Critical},
+		{"expected owner", w.OwnershipTransferStarted(&fastsettlementv3.Fastsettlementv3OwnershipTransferStarted{NewOwner: owner}), watchdog.Info},
+		{"unexpected owner", w.OwnershipTransferStarted(&fastsettlementv3.Fastsettlementv3OwnershipTransferStarted{NewOwner: attacker}), watchdog.Critical},
+		{"expected executor", w.ExecutorUpdated(&fastsettlementv3.Fastsettlementv3ExecutorUpdated{NewExecutor: executor}), watchdog.Info},
+		{"unexpected executor", w.ExecutorUpdated(&fastsettlementv3.Fastsettlementv3ExecutorUpdated{NewExecutor: attacker}), watchdog.Critical},
+	} {
+		if tt.got.Severity != tt.want {
+			t.Errorf("%s: severity %s, want %s", tt.name, tt.got.Severity, tt.want)
+		}
+	}
+
+	alerts := w.SwapTargetsUpdated(&fastsettlementv3.Fastsettlementv3SwapTargetsUpdated{
+		Targets: []common.Address{attacker, router, router},
+		Allowed: []bool{true, false, true},
+	})
+	want := []watchdog.Severity{watchdog.Critical, watchdog.Warning, watchdog.Info}
+	if len(alerts) != len(want) {
+		t.Fatalf("%d swap target alerts, want %d", len(alerts), len(want))
+	}
+	for i, a := range alerts {
+		if a.Severity != want[i] {
+			t.Errorf("swap target alert %d: severity %s, want %s", i, a.Severity, want[i])
+		}
+	}
+}
+
+func TestLoadExpected(t *testing.T) {
+	dir := t.TempDir()
+	write := func(body string) string {
+		path := filepath.Join(dir, "expected.json")
+		if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	good := `{"settlement": "` + proxy.Hex() + `", "implementations": ["` + impl.Hex() + `"],
+		"owner": "` + owner.Hex() + `", "executor": "` + executor.Hex() + `",
+		"balances": [{"token": "0x0000000000000000000000000000000000000000", "max": "0x10"}]}`
+	e, err := watchdog.LoadExpected(write(good))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := (*big.Int)(e.Balances[0].Max); got.Int64() != 16 {
+		t.Errorf("max %s, want 16", got)
+	}
+	for name, body := range map[string]string{
+		"misspelt key":       strings.Replace(good, "executor", "exector", 1),
+		"no implementations": strings.Replace(good, `["`+impl.Hex()+`"]`, "[]", 1),
+		"duplicate balance":  strings.Replace(good, `"max": "0x10"}]`, `"max": "0x10"}, {"token": "0x0000000000000000000000000000000000000000"}]`, 1),
+	} {
+		if _, err := watchdog.LoadExpected(write(body)); err == nil {
+			t.Errorf("%s: accepted", name)
+		}
+	}
+}
+
+func TestSinks(t *testing.T) {
+	var got []watchdog.Alert
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer hook" {
+			http.Error(w, "denied", http.StatusForbidden)
+			return
+		}
+		var a watchdog.Alert
+		if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		got = append(got, a)
+	}))
+	defer srv.Close()
+
+	hook := &watchdog.Webhook{URL: srv.URL, Header: http.Header{"Authorization": {"Bearer hook"}}}
+	sink := watchdog.MinSeverity(watchdog.Warning, hook)
+	ctx := context.Background()
+	for _, sev := range []watchdog.Severity{watchdog.Info, watchdog.Critical} {
+		if err := sink.Send(ctx, watchdog.Alert{Severity: sev, Kind: watchdog.KindExecutor, Contract: proxy}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(got) != 1 || got[0].Severity != watchdog.Critical || got[0].Contract != proxy {
+		t.Fatalf("webhook received %+v, want the critical alert only", got)
+	}
+	if err := (&watchdog.Webhook{URL: srv.URL}).Send(ctx, watchdog.Alert{}); err == nil {
+		t.Fatal("rejected webhook delivery reported no error")
+	}
+
+	path := filepath.Join(t.TempDir(), "alerts.jsonl")
+	f, err := watchdog.OpenFileSink(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Send(ctx, watchdog.Alert{Severity: watchdog.Critical, Kind: watchdog.KindBalance}); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(raw), `"severity":"critical"`) {
+		t.Fatalf("file sink wrote %s", raw)
+	}
+}
+
+func harnessExpected(h *testharness.Harness) *watchdog.Expected {
+	zero := math.HexOrDecimal256(*big.NewInt(0))
+	return &watchdog.Expected{
+		Settlement:      h.Proxy,
+		Implementations: []common.Address{h.Implementation},
+		Owner:           h.Owner.Address,
+		Executor:        h.Executor.Address,
+		SwapTargets:     []common.Address{h.Router},
+		Balances: []watchdog.BalanceLimit{
+			{Token: common.Address{}, Symbol: "ETH"},
+			{Token: h.TokenIn, Symbol: "TIN", Max: &zero},
+		},
+	}
+}
+
+// TestAuditAndBalances checks the harness deployment, which matches its
+// expected config, then strands tokens in it.
+func TestAuditAndBalances(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{})
+	rec := &recorder{}
+	w, err := watchdog.New(watchdog.Config{Expected: harnessExpected(h), Backend: h.Client, Sinks: []watchdog.Sink{rec}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if alerts, err := w.Audit(ctx); err != nil || len(alerts) != 0 {
+		t.Fatalf("Audit = %v, %v; want no alerts", alerts, err)
+	}
+	if alerts, err := w.Balances(ctx); err != nil || len(alerts) != 0 {
+		t.Fatalf("Balances = %v, %v; want no alerts", alerts, err)
+	}
+
+	if err := h.Mint(h.TokenIn, h.Proxy, big.NewInt(5)); err != nil {
+		t.Fatal(err)
+	}
+	alerts, err := w.Balances(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 || alerts[0].Severity != watchdog.Critical || alerts[0].Fields["balance"] != "5" {
+		t.Fatalf("alerts %v, want one critical TIN alert", alerts)
+	}
+	// An unchanged stranded balance is not alerted again.
+	if alerts, _ := w.Balances(ctx); len(alerts) != 0 {
+		t.Fatalf("repeated alerts %v", alerts)
+	}
+
+	if _, err := h.Mine(h.Settlement.SetExecutor(h.Opts(h.Owner), attacker)); err != nil {
+		t.Fatal(err)
+	}
+	alerts, err = w.Audit(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 || alerts[0].Severity != watchdog.Critical || alerts[0].Fields["executor"] != attacker.Hex() {
+		t.Fatalf("alerts %v, want one critical executor alert", alerts)
+	}
+}
+
+// TestRun checks that admin events are alerted as they are mined.
+func TestRun(t *testing.T) {
+	h := testharness.Start(t, testharness.Config{})
+	rec := &recorder{}
+	w, err := watchdog.New(watchdog.Config{Expected: harnessExpected(h), Backend: h.Client, Sinks: []watchdog.Sink{rec}, PollInterval: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := make(chan error, 1)
+	go func() { stopped <- w.Run(ctx) }()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for !rec.find(watchdog.KindSwapTargets, watchdog.Critical) {
+		if time.Now().After(deadline) {
+			t.Fatal("no critical swap target alert")
+		}
+		// Run may not have subscribed yet; allowing the target again is
+		// alerted each time.
+		if err := h.SetSwapTargets([]common.Address{attacker}, []bool{true}); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	cancel()
+	<-stopped
+}
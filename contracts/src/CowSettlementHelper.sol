@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+import {Ownable, Ownable2Step} from "@openzeppelin/contracts/access/Ownable2Step.sol";
+import {IERC20} from "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+import {SafeERC20} from "@openzeppelin/contracts/token/ERC20/utils/SafeERC20.sol";
+import {Address} from "@openzeppelin/contracts/utils/Address.sol";
+import {ICowSettlementHelper} from "./interfaces/ICowSettlementHelper.sol";
+
+/// @title CowSettlementHelper
+/// @notice Swap target settling matched intents against each other's inputs.
+/// @dev Legs are armed in transient storage, so an armed leg only lives for
+/// the operator's transaction: nothing armed can be settled by a later
+/// executeWithETH. The float is the helper's own balance; the owner funds it
+/// with plain transfers and takes it back with withdraw().
+contract CowSettlementHelper is Ownable2Step, ICowSettlementHelper {
+    using SafeERC20 for IERC20;
+    using Address for address payable;
+
+    /// @dev Transient slot base; leg L is counted at keccak256(L, ARMED_SLOT).
+    bytes32 private constant ARMED_SLOT = keccak256("fast.cowsettlementhelper.armed");
+
+    /// @inheritdoc ICowSettlementHelper
+    address public operator;
+
+    event OperatorUpdated(address indexed oldOperator, address indexed newOperator);
+
+    constructor(address _owner, address _operator) Ownable(_owner) {
+        operator = _operator;
+        emit OperatorUpdated(address(0), _operator);
+    }
+
+    receive() external payable {}
+
+    // ============ Settlement ============
+
+    /// @inheritdoc ICowSettlementHelper
+    function arm(bytes32[] calldata legs) external {
+        if (msg.sender != operator) revert Unauthorized();
+        for (uint256 i = 0; i < legs.length; i++) {
+            // A count rather than a flag, so identical legs in one match
+            // each settle once.
+            bytes32 slot = _slot(legs[i]);
+            _tstore(slot, _tload(slot) + 1);
+            emit LegArmed(legs[i]);
+        }
+    }
+
+    /// @inheritdoc ICowSettlementHelper
+    /// @dev tokenIn must be an ERC-20: executeWithPermit never passes ETH.
+    function settle(address tokenIn, uint256 amountIn, address tokenOut, uint256 amountOut) external {
+        bytes32 leg = keccak256(abi.encode(msg.sender, tokenIn, amountIn, tokenOut, amountOut));
+        bytes32 slot = _slot(leg);
+        uint256 count = _tload(slot);
+        if (count == 0) revert LegNotArmed(leg);
+        _tstore(slot, count - 1);
+
+        IERC20(tokenIn).safeTransferFrom(msg.sender, address(this), amountIn);
+        // Paid from the inputs of legs already settled, or from the float.
+        if (tokenOut == address(0)) {
+            payable(msg.sender).sendValue(amountOut);
+        } else {
+            IERC20(tokenOut).safeTransfer(msg.sender, amountOut);
+        }
+        emit LegSettled(leg, tokenIn, tokenOut, amountIn, amountOut);
+    }
+
+    /// @inheritdoc ICowSettlementHelper
+    function armed(bytes32 leg) external view returns (bool) {
+        return _tload(_slot(leg)) > 0;
+    }
+
+    // ============ Admin ============
+
+    function setOperator(address _operator) external onlyOwner {
+        address old = operator;
+        operator = _operator;
+        emit OperatorUpdated(old, _operator);
+    }
+
+    /// @notice Withdraw float. token is address(0) for ETH.
+    function withdraw(address token, address to, uint256 amount) external onlyOwner {
+        if (token == address(0)) {
+            payable(to).sendValue(amount);
+        } else {
+            IERC20(token).safeTransfer(to, amount);
+        }
+    }
+
+    // ============ Internal ============
+
+    function _slot(bytes32 leg) internal pure returns (bytes32) {
+        return keccak256(abi.encode(leg, ARMED_SLOT));
+    }
+
+    function _tload(bytes32 slot) internal view returns (uint256 value) {
+        assembly ("memory-safe") {
+            value := tload(slot)
+        }
+    }
+
+    function _tstore(bytes32 slot, uint256 value) internal {
+        assembly ("memory-safe") {
+            tstore(slot, value)
+        }
+    }
+}
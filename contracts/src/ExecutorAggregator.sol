@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+import {Ownable, Ownable2Step} from "@openzeppelin/contracts/access/Ownable2Step.sol";
+import {IMulticall3} from "./interfaces/IMulticall3.sol";
+
+/// @title ExecutorAggregator
+/// @notice Owner-gated Multicall3 that holds FastSettlementV3's executor role,
+///         so several intents settle in one transaction.
+/// @dev Only the owner, the relayer's executor key, may aggregate: the public
+/// Multicall3 would let anyone act as the executor. A failing call marked
+/// allowFailure=false reverts the whole batch with its return data.
+contract ExecutorAggregator is Ownable2Step, IMulticall3 {
+    error ValueMismatch(uint256 sent, uint256 required);
+
+    constructor(address _owner) Ownable(_owner) {}
+
+    /// @inheritdoc IMulticall3
+    function aggregate3(Call3[] calldata calls) external payable onlyOwner returns (Result[] memory returnData) {
+        if (msg.value != 0) revert ValueMismatch(msg.value, 0);
+        returnData = new Result[](calls.length);
+        for (uint256 i = 0; i < calls.length; i++) {
+            Call3 calldata c = calls[i];
+            returnData[i] = _call(c.target, 0, c.callData, c.allowFailure);
+        }
+    }
+
+    /// @inheritdoc IMulticall3
+    function aggregate3Value(
+        Call3Value[] calldata calls
+    ) external payable onlyOwner returns (Result[] memory returnData) {
+        uint256 total;
+        returnData = new Result[](calls.length);
+        for (uint256 i = 0; i < calls.length; i++) {
+            Call3Value calldata c = calls[i];
+            total += c.value;
+            returnData[i] = _call(c.target, c.value, c.callData, c.allowFailure);
+        }
+        if (msg.value != total) revert ValueMismatch(msg.value, total);
+    }
+
+    function _call(
+        address target,
+        uint256 value,
+        bytes calldata data,
+        bool allowFailure
+    ) internal returns (Result memory) {
+        (bool success, bytes memory ret) = target.call{value: value}(data);
+        if (!success && !allowFailure) {
+            assembly ("memory-safe") {
+                revert(add(ret, 0x20), mload(ret))
+            }
+        }
+        return Result(success, ret);
+    }
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+/// @notice Swap target that settles coincidence-of-wants legs between intents.
+/// @dev Allowlisted on FastSettlementV3. Each executeWithPermit call in a
+/// matched set names the helper as its swap target with settle() calldata:
+/// the helper pulls the intent's input from the settlement contract and pays
+/// the clearing amount of the output token back to it, using the inputs of
+/// the other legs (and, for legs paid before their counterpart executes, a
+/// working float it holds). executeWithETH is callable by users directly, so
+/// settle() only honours legs the operator armed earlier in the same batch.
+interface ICowSettlementHelper {
+    /// @notice A leg was armed by the operator.
+    event LegArmed(bytes32 indexed leg);
+
+    /// @notice A leg was settled.
+    event LegSettled(bytes32 indexed leg, address indexed tokenIn, address indexed tokenOut, uint256 amountIn, uint256 amountOut);
+
+    error LegNotArmed(bytes32 leg);
+    error Unauthorized();
+
+    /// @notice Arm legs for settlement. Each leg is
+    /// keccak256(abi.encode(settlement, tokenIn, amountIn, tokenOut, amountOut)).
+    /// @dev Operator only.
+    function arm(bytes32[] calldata legs) external;
+
+    /// @notice Pull amountIn of tokenIn from msg.sender and pay it amountOut
+    /// of tokenOut (address(0) for ETH), consuming the armed leg.
+    function settle(address tokenIn, uint256 amountIn, address tokenOut, uint256 amountOut) external;
+
+    /// @notice Whether a leg is armed and not yet settled.
+    function armed(bytes32 leg) external view returns (bool);
+
+    /// @notice The account allowed to arm legs.
+    function operator() external view returns (address);
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+/// @notice The aggregate3 subset of Multicall3 used to batch settlements.
+/// @dev FastSettlementV3 only accepts calls from its executor, so a batch must
+/// come from an aggregator that is itself the executor. Use an owner-gated
+/// deployment exposing this interface, never the public Multicall3: anyone
+/// could route calls through that one.
+interface IMulticall3 {
+    struct Call3 {
+        address target;
+        bool allowFailure;
+        bytes callData;
+    }
+
+    struct Call3Value {
+        address target;
+        bool allowFailure;
+        uint256 value;
+        bytes callData;
+    }
+
+    struct Result {
+        bool success;
+        bytes returnData;
+    }
+
+    function aggregate3(Call3[] calldata calls) external payable returns (Result[] memory returnData);
+
+    function aggregate3Value(Call3Value[] calldata calls) external payable returns (Result[] memory returnData);
+}
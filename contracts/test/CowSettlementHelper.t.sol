@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+import {Test} from "forge-std/Test.sol";
+import {Ownable} from "@openzeppelin/contracts/access/Ownable.sol";
+import {CowSettlementHelper} from "../src/CowSettlementHelper.sol";
+import {ICowSettlementHelper} from "../src/interfaces/ICowSettlementHelper.sol";
+import {HarnessERC20} from "./harness/HarnessTokens.sol";
+
+/// @dev The test contract plays the settlement: it approves and calls settle().
+contract CowSettlementHelperTest is Test {
+    CowSettlementHelper public helper;
+    HarnessERC20 public tokenA;
+    HarnessERC20 public tokenB;
+
+    address public owner = makeAddr("owner");
+    address public operator = makeAddr("operator");
+    address public stranger = makeAddr("stranger");
+
+    function setUp() public {
+        helper = new CowSettlementHelper(owner, operator);
+        tokenA = new HarnessERC20("Token A", "TA", 18);
+        tokenB = new HarnessERC20("Token B", "TB", 18);
+        tokenA.mint(address(this), 100 ether);
+        tokenA.approve(address(helper), type(uint256).max);
+    }
+
+    function _leg(uint256 amountIn, uint256 amountOut) internal view returns (bytes32) {
+        return keccak256(abi.encode(address(this), address(tokenA), amountIn, address(tokenB), amountOut));
+    }
+
+    function _arm(bytes32 leg) internal {
+        bytes32[] memory legs = new bytes32[](1);
+        legs[0] = leg;
+        vm.prank(operator);
+        helper.arm(legs);
+    }
+
+    function test_ArmOnlyOperator() public {
+        bytes32[] memory legs = new bytes32[](1);
+        legs[0] = _leg(10 ether, 9 ether);
+        vm.prank(stranger);
+        vm.expectRevert(ICowSettlementHelper.Unauthorized.selector);
+        helper.arm(legs);
+    }
+
+    function test_SettleRequiresArmedLeg() public {
+        bytes32 leg = _leg(10 ether, 9 ether);
+        vm.expectRevert(abi.encodeWithSelector(ICowSettlementHelper.LegNotArmed.selector, leg));
+        helper.settle(address(tokenA), 10 ether, address(tokenB), 9 ether);
+    }
+
+    function test_SettlePaysFromFloatAndConsumesLeg() public {
+        tokenB.mint(address(helper), 9 ether);
+        bytes32 leg = _leg(10 ether, 9 ether);
+        _arm(leg);
+        assertTrue(helper.armed(leg));
+
+        helper.settle(address(tokenA), 10 ether, address(tokenB), 9 ether);
+        assertEq(tokenA.balanceOf(address(helper)), 10 ether);
+        assertEq(tokenB.balanceOf(address(this)), 9 ether);
+        assertFalse(helper.armed(leg));
+
+        vm.expectRevert(abi.encodeWithSelector(ICowSettlementHelper.LegNotArmed.selector, leg));
+        helper.settle(address(tokenA), 10 ether, address(tokenB), 9 ether);
+    }
+
+    function test_ArmIsBoundToCaller() public {
+        tokenB.mint(address(helper), 9 ether);
+        _arm(_leg(10 ether, 9 ether));
+        // Same amounts, different settlement: not armed.
+        tokenA.transfer(stranger, 10 ether);
+        bytes32 theirs = keccak256(abi.encode(stranger, address(tokenA), 10 ether, address(tokenB), 9 ether));
+        vm.startPrank(stranger);
+        tokenA.approve(address(helper), 10 ether);
+        vm.expectRevert(abi.encodeWithSelector(ICowSettlementHelper.LegNotArmed.selector, theirs));
+        helper.settle(address(tokenA), 10 ether, address(tokenB), 9 ether);
+        vm.stopPrank();
+    }
+
+    function test_IdenticalLegsArmTwice() public {
+        tokenB.mint(address(helper), 18 ether);
+        bytes32 leg = _leg(10 ether, 9 ether);
+        _arm(leg);
+        _arm(leg);
+        helper.settle(address(tokenA), 10 ether, address(tokenB), 9 ether);
+        assertTrue(helper.armed(leg));
+        helper.settle(address(tokenA), 10 ether, address(tokenB), 9 ether);
+        assertFalse(helper.armed(leg));
+    }
+
+    function test_AdminOnlyOwner() public {
+        tokenB.mint(address(helper), 1 ether);
+        vm.startPrank(stranger);
+        vm.expectRevert(abi.encodeWithSelector(Ownable.OwnableUnauthorizedAccount.selector, stranger));
+        helper.withdraw(address(tokenB), stranger, 1 ether);
+        vm.expectRevert(abi.encodeWithSelector(Ownable.OwnableUnauthorizedAccount.selector, stranger));
+        helper.setOperator(stranger);
+        vm.stopPrank();
+
+        vm.startPrank(owner);
+        helper.withdraw(address(tokenB), owner, 1 ether);
+        helper.setOperator(stranger);
+        vm.stopPrank();
+        assertEq(tokenB.balanceOf(owner), 1 ether);
+        assertEq(helper.operator(), stranger);
+    }
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+import {Test} from "forge-std/Test.sol";
+import {Ownable} from "@openzeppelin/contracts/access/Ownable.sol";
+import {IERC20Errors} from "@openzeppelin/contracts/interfaces/draft-IERC6093.sol";
+import {ExecutorAggregator} from "../src/ExecutorAggregator.sol";
+import {IMulticall3} from "../src/interfaces/IMulticall3.sol";
+import {HarnessERC20, WETH9} from "./harness/HarnessTokens.sol";
+
+contract ExecutorAggregatorTest is Test {
+    ExecutorAggregator public aggregator;
+    HarnessERC20 public token;
+    WETH9 public weth;
+
+    address public owner = makeAddr("owner");
+    address public stranger = makeAddr("stranger");
+    address public recipient = makeAddr("recipient");
+
+    function setUp() public {
+        aggregator = new ExecutorAggregator(owner);
+        token = new HarnessERC20("Token", "TK", 18);
+        weth = new WETH9();
+        token.mint(address(aggregator), 10 ether);
+        vm.deal(owner, 10 ether);
+    }
+
+    function _transfer(uint256 amount, bool allowFailure) internal view returns (IMulticall3.Call3Value memory) {
+        return IMulticall3.Call3Value({
+            target: address(token),
+            allowFailure: allowFailure,
+            value: 0,
+            callData: abi.encodeCall(token.transfer, (recipient, amount))
+        });
+    }
+
+    function test_OnlyOwner() public {
+        IMulticall3.Call3Value[] memory calls = new IMulticall3.Call3Value[](1);
+        calls[0] = _transfer(1 ether, false);
+        vm.prank(stranger);
+        vm.expectRevert(abi.encodeWithSelector(Ownable.OwnableUnauthorizedAccount.selector, stranger));
+        aggregator.aggregate3Value(calls);
+
+        IMulticall3.Call3[] memory plain = new IMulticall3.Call3[](1);
+        plain[0] = IMulticall3.Call3(address(token), false, calls[0].callData);
+        vm.prank(stranger);
+        vm.expectRevert(abi.encodeWithSelector(Ownable.OwnableUnauthorizedAccount.selector, stranger));
+        aggregator.aggregate3(plain);
+    }
+
+    function test_AllowFailureKeepsGoing() public {
+        IMulticall3.Call3Value[] memory calls = new IMulticall3.Call3Value[](2);
+        calls[0] = _transfer(20 ether, true);
+        calls[1] = _transfer(3 ether, true);
+        vm.prank(owner);
+        IMulticall3.Result[] memory results = aggregator.aggregate3Value(calls);
+
+        assertFalse(results[0].success);
+        assertEq(
+            results[0].returnData,
+            abi.encodeWithSelector(
+                IERC20Errors.ERC20InsufficientBalance.selector, address(aggregator), 10 ether, 20 ether
+            )
+        );
+        assertTrue(results[1].success);
+        assertEq(token.balanceOf(recipient), 3 ether);
+    }
+
+    function test_RequiredFailureRevertsBatch() public {
+        IMulticall3.Call3Value[] memory calls = new IMulticall3.Call3Value[](2);
+        calls[0] = _transfer(3 ether, false);
+        calls[1] = _transfer(20 ether, false);
+        vm.prank(owner);
+        vm.expectRevert(
+            abi.encodeWithSelector(
+                IERC20Errors.ERC20InsufficientBalance.selector, address(aggregator), 7 ether, 20 ether
+            )
+        );
+        aggregator.aggregate3Value(calls);
+        assertEq(token.balanceOf(recipient), 0);
+    }
+
+    function test_ForwardsValue() public {
+        IMulticall3.Call3Value[] memory calls = new IMulticall3.Call3Value[](2);
+        calls[0] = IMulticall3.Call3Value(address(weth), false, 1 ether, abi.encodeCall(weth.deposit, ()));
+        calls[1] = IMulticall3.Call3Value(recipient, false, 2 ether, "");
+        vm.prank(owner);
+        aggregator.aggregate3Value{value: 3 ether}(calls);
+        assertEq(weth.balanceOf(address(aggregator)), 1 ether);
+        assertEq(recipient.balance, 2 ether);
+        assertEq(address(aggregator).balance, 0);
+    }
+
+    function test_ValueMismatch() public {
+        IMulticall3.Call3Value[] memory calls = new IMulticall3.Call3Value[](1);
+        calls[0] = IMulticall3.Call3Value(recipient, false, 1 ether, "");
+        vm.prank(owner);
+        vm.expectRevert(abi.encodeWithSelector(ExecutorAggregator.ValueMismatch.selector, 2 ether, 1 ether));
+        aggregator.aggregate3Value{value: 2 ether}(calls);
+
+        IMulticall3.Call3[] memory plain = new IMulticall3.Call3[](0);
+        vm.prank(owner);
+        vm.expectRevert(abi.encodeWithSelector(ExecutorAggregator.ValueMismatch.selector, 1 ether, 0));
+        aggregator.aggregate3{value: 1 ether}(plain);
+    }
+
+    function test_TwoStepOwnership() public {
+        vm.prank(owner);
+        aggregator.transferOwnership(stranger);
+        assertEq(aggregator.owner(), owner);
+        vm.prank(stranger);
+        aggregator.acceptOwnership();
+        assertEq(aggregator.owner(), stranger);
+    }
+}
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+/// @notice Permit2's SignatureTransfer surface used off-chain: the transfers the
+/// settlement makes plus the unordered nonce bitmap. Bound for Go only; contracts
+/// use the minimal src/interfaces/IPermit2.sol. Named apart from Permit2's own
+/// ISignatureTransfer to keep Forge artifact names unique.
+interface IPermit2SignatureTransfer {
+    struct TokenPermissions {
+        address token;
+        uint256 amount;
+    }
+
+    struct PermitTransferFrom {
+        TokenPermissions permitted;
+        uint256 nonce;
+        uint256 deadline;
+    }
+
+    struct SignatureTransferDetails {
+        address to;
+        uint256 requestedAmount;
+    }
+
+    event UnorderedNonceInvalidation(address indexed owner, uint256 word, uint256 mask);
+
+    error InvalidAmount(uint256 maxAmount);
+    error LengthMismatch();
+
+    function DOMAIN_SEPARATOR() external view returns (bytes32);
+
+    function nonceBitmap(address owner, uint256 wordPos) external view returns (uint256);
+
+    function invalidateUnorderedNonces(uint256 wordPos, uint256 mask) external;
+
+    function permitTransferFrom(
+        PermitTransferFrom memory permit,
+        SignatureTransferDetails calldata transferDetails,
+        address owner,
+        bytes calldata signature
+    ) external;
+
+    function permitWitnessTransferFrom(
+        PermitTransferFrom memory permit,
+        SignatureTransferDetails calldata transferDetails,
+        address owner,
+        bytes32 witness,
+        string calldata witnessTypeString,
+        bytes calldata signature
+    ) external;
+}
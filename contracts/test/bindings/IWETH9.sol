@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+/// @notice WETH9's full surface, including its events and ERC-20 metadata. Bound
+/// for Go only; contracts use the minimal src/interfaces/IWETH.sol.
+interface IWETH9 {
+    event Deposit(address indexed dst, uint256 wad);
+    event Withdrawal(address indexed src, uint256 wad);
+    event Transfer(address indexed src, address indexed dst, uint256 wad);
+    event Approval(address indexed src, address indexed guy, uint256 wad);
+
+    function deposit() external payable;
+    function withdraw(uint256 wad) external;
+    function name() external view returns (string memory);
+    function symbol() external view returns (string memory);
+    function decimals() external view returns (uint8);
+    function totalSupply() external view returns (uint256);
+    function balanceOf(address owner) external view returns (uint256);
+    function allowance(address owner, address spender) external view returns (uint256);
+    function transfer(address to, uint256 value) external returns (bool);
+    function transferFrom(address from, address to, uint256 value) external returns (bool);
+    function approve(address spender, uint256 value) external returns (bool);
+}
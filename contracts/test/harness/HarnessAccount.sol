@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import {ECDSA} from "@openzeppelin/contracts/utils/cryptography/ECDSA.sol";
+import {MessageHashUtils} from "@openzeppelin/contracts/utils/cryptography/MessageHashUtils.sol";
+import {Create2} from "@openzeppelin/contracts/utils/Create2.sol";
+import {PackedUserOperation} from "./HarnessEntryPoint.sol";
+
+/// @title HarnessAccount
+/// @notice SimpleAccount v0.7-style smart account deployed by the Go test harness: one ECDSA
+/// owner, execute/executeBatch, user operation validation over the EIP-191 hash of the
+/// userOpHash, and ERC-1271 isValidSignature over the raw hash so Permit2 accepts intents
+/// the owner signs on the account's behalf.
+contract HarnessAccount {
+    address public immutable entryPoint;
+    address public immutable owner;
+
+    error NotFromEntryPointOrOwner();
+    error NotFromEntryPoint();
+    error ArrayLengthMismatch();
+
+    constructor(address entryPoint_, address owner_) {
+        entryPoint = entryPoint_;
+        owner = owner_;
+    }
+
+    receive() external payable {}
+
+    function execute(address dest, uint256 value, bytes calldata func) external {
+        _requireFromEntryPointOrOwner();
+        _call(dest, value, func);
+    }
+
+    function executeBatch(address[] calldata dest, uint256[] calldata value, bytes[] calldata func) external {
+        _requireFromEntryPointOrOwner();
+        if (dest.length != func.length || (value.length != 0 && value.length != func.length)) {
+            revert ArrayLengthMismatch();
+        }
+        for (uint256 i = 0; i < dest.length; i++) {
+            _call(dest[i], value.length == 0 ? 0 : value[i], func[i]);
+        }
+    }
+
+    function validateUserOp(PackedUserOperation calldata userOp, bytes32 userOpHash, uint256 missingAccountFunds)
+        external
+        returns (uint256 validationData)
+    {
+        if (msg.sender != entryPoint) revert NotFromEntryPoint();
+        bytes32 hash = MessageHashUtils.toEthSignedMessageHash(userOpHash);
+        validationData = _isOwnerSignature(hash, userOp.signature) ? 0 : 1;
+        if (missingAccountFunds != 0) {
+            (bool ok,) = payable(msg.sender).call{value: missingAccountFunds}("");
+            (ok);
+        }
+    }
+
+    function isValidSignature(bytes32 hash, bytes calldata signature) external view returns (bytes4) {
+        return _isOwnerSignature(hash, signature) ? this.isValidSignature.selector : bytes4(0xffffffff);
+    }
+
+    function _isOwnerSignature(bytes32 hash, bytes memory signature) internal view returns (bool) {
+        (address recovered, ECDSA.RecoverError err,) = ECDSA.tryRecover(hash, signature);
+        return err == ECDSA.RecoverError.NoError && recovered == owner;
+    }
+
+    function _requireFromEntryPointOrOwner() internal view {
+        if (msg.sender != entryPoint && msg.sender != owner) revert NotFromEntryPointOrOwner();
+    }
+
+    function _call(address target, uint256 value, bytes memory data) internal {
+        (bool success, bytes memory result) = target.call{value: value}(data);
+        if (!success) {
+            assembly {
+                revert(add(result, 32), mload(result))
+            }
+        }
+    }
+}
+
+/// @title HarnessAccountFactory
+/// @notice CREATE2 factory for HarnessAccount, usable as a user operation's initCode factory.
+contract HarnessAccountFactory {
+    address public immutable entryPoint;
+
+    constructor(address entryPoint_) {
+        entryPoint = entryPoint_;
+    }
+
+    function createAccount(address owner, uint256 salt) external returns (address) {
+        address account = getAddress(owner, salt);
+        if (account.code.length != 0) return account;
+        return address(new HarnessAccount{salt: bytes32(salt)}(entryPoint, owner));
+    }
+
+    function getAddress(address owner, uint256 salt) public view returns (address) {
+        bytes32 codeHash =
+            keccak256(abi.encodePacked(type(HarnessAccount).creationCode, abi.encode(entryPoint, owner)));
+        return Create2.computeAddress(bytes32(salt), codeHash);
+    }
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+/// @notice ERC-4337 v0.7 PackedUserOperation.
+struct PackedUserOperation {
+    address sender;
+    uint256 nonce;
+    bytes initCode;
+    bytes callData;
+    bytes32 accountGasLimits;
+    uint256 preVerificationGas;
+    bytes32 gasFees;
+    bytes paymasterAndData;
+    bytes signature;
+}
+
+interface IHarnessAccount {
+    function validateUserOp(PackedUserOperation calldata userOp, bytes32 userOpHash, uint256 missingAccountFunds)
+        external
+        returns (uint256 validationData);
+}
+
+/// @title HarnessEntryPoint
+/// @notice Minimal EntryPoint v0.7 stand-in deployed by the Go test harness. getUserOpHash,
+/// keyed nonces, deposits and handleOps' validate-then-execute loop follow v0.7; paymasters,
+/// aggregators and validity time ranges are not supported, and the full prefund is charged
+/// instead of the gas actually used.
+/// @dev The canonical eth-infinitism EntryPoint is not a dependency of this repo, so harness
+/// tests exercise this contract, not the deployed one. contracts-abi/userop's
+/// TestCanonicalEntryPoint checks packing and getUserOpHash against the canonical deployment
+/// when RPC_URL points at a chain that has it.
+contract HarnessEntryPoint {
+    event UserOperationEvent(
+        bytes32 indexed userOpHash,
+        address indexed sender,
+        address indexed paymaster,
+        uint256 nonce,
+        bool success,
+        uint256 actualGasCost,
+        uint256 actualGasUsed
+    );
+    event UserOperationRevertReason(
+        bytes32 indexed userOpHash, address indexed sender, uint256 nonce, bytes revertReason
+    );
+    event AccountDeployed(bytes32 indexed userOpHash, address indexed sender, address factory, address paymaster);
+    event Deposited(address indexed account, uint256 totalDeposit);
+
+    error FailedOp(uint256 opIndex, string reason);
+
+    mapping(address => mapping(uint192 => uint256)) public nonceSequenceNumber;
+    mapping(address => uint256) public balanceOf;
+
+    receive() external payable {
+        depositTo(msg.sender);
+    }
+
+    function depositTo(address account) public payable {
+        balanceOf[account] += msg.value;
+        emit Deposited(account, balanceOf[account]);
+    }
+
+    function getNonce(address sender, uint192 key) external view returns (uint256) {
+        return nonceSequenceNumber[sender][key] | (uint256(key) << 64);
+    }
+
+    function getUserOpHash(PackedUserOperation calldata userOp) public view returns (bytes32) {
+        bytes32 packed = keccak256(
+            abi.encode(
+                userOp.sender,
+                userOp.nonce,
+                keccak256(userOp.initCode),
+                keccak256(userOp.callData),
+                userOp.accountGasLimits,
+                userOp.preVerificationGas,
+                userOp.gasFees,
+                keccak256(userOp.paymasterAndData)
+            )
+        );
+        return keccak256(abi.encode(packed, address(this), block.chainid));
+    }
+
+    function handleOps(PackedUserOperation[] calldata ops, address payable beneficiary) external {
+        uint256 collected;
+        for (uint256 i = 0; i < ops.length; i++) {
+            collected += _handleOp(i, ops[i]);
+        }
+        (bool ok,) = beneficiary.call{value: collected}("");
+        if (!ok) revert FailedOp(0, "AA91 failed send to beneficiary");
+    }
+
+    function _handleOp(uint256 i, PackedUserOperation calldata op) internal returns (uint256 prefund) {
+        if (op.paymasterAndData.length != 0) revert FailedOp(i, "paymasters unsupported");
+        bytes32 userOpHash = getUserOpHash(op);
+        if (op.initCode.length != 0) _deploy(i, userOpHash, op);
+        if (op.sender.code.length == 0) revert FailedOp(i, "AA20 account not deployed");
+
+        uint256 verificationGasLimit = uint128(uint256(op.accountGasLimits) >> 128);
+        uint256 callGasLimit = uint128(uint256(op.accountGasLimits));
+        uint256 maxFeePerGas = uint128(uint256(op.gasFees));
+        uint256 gasLimit = verificationGasLimit + callGasLimit + op.preVerificationGas;
+        prefund = gasLimit * maxFeePerGas;
+
+        uint256 deposit = balanceOf[op.sender];
+        uint256 missing = deposit >= prefund ? 0 : prefund - deposit;
+        uint256 validationData;
+        try IHarnessAccount(op.sender).validateUserOp{gas: verificationGasLimit}(op, userOpHash, missing) returns (
+            uint256 v
+        ) {
+            validationData = v;
+        } catch {
+            revert FailedOp(i, "AA23 reverted");
+        }
+        if (validationData == 1) revert FailedOp(i, "AA24 signature error");
+        if (validationData != 0) revert FailedOp(i, "AA22 expired or not due");
+        if (balanceOf[op.sender] < prefund) revert FailedOp(i, "AA21 didn't pay prefund");
+        uint192 key = uint192(op.nonce >> 64);
+        if (nonceSequenceNumber[op.sender][key]++ != uint64(op.nonce)) {
+            revert FailedOp(i, "AA25 invalid account nonce");
+        }
+        balanceOf[op.sender] -= prefund;
+
+        (bool success, bytes memory ret) = op.sender.call{gas: callGasLimit}(op.callData);
+        if (!success) emit UserOperationRevertReason(userOpHash, op.sender, op.nonce, ret);
+        emit UserOperationEvent(userOpHash, op.sender, address(0), op.nonce, success, prefund, gasLimit);
+    }
+
+    function _deploy(uint256 i, bytes32 userOpHash, PackedUserOperation calldata op) internal {
+        if (op.sender.code.length != 0) revert FailedOp(i, "AA10 sender already constructed");
+        address factory = address(bytes20(op.initCode[:20]));
+        (bool ok, bytes memory ret) = factory.call(op.initCode[20:]);
+        if (!ok || ret.length < 32 || abi.decode(ret, (address)) != op.sender) {
+            revert FailedOp(i, "AA13 initCode failed or OOG");
+        }
+        emit AccountDeployed(userOpHash, op.sender, factory, address(0));
+    }
+}
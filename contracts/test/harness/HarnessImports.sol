@@ -0,0 +1,6 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+// Pulls the proxy into the build so forge emits the artifact the Go test
+// harness (contracts-abi/testharness) deploys FastSettlementV3 behind.
+import {ERC1967Proxy} from "@openzeppelin/contracts/proxy/ERC1967/ERC1967Proxy.sol";
@@ -0,0 +1,6 @@
+// SPDX-License-Identifier: MIT
+pragma solidity 0.8.17;
+
+// Permit2 pins its compiler version, so it is pulled into the build from its
+// own compilation unit for the Go test harness (contracts-abi/testharness).
+import {Permit2} from "permit2/src/Permit2.sol";
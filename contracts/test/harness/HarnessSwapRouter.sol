@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import {IERC20} from "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+import {HarnessERC20} from "./HarnessTokens.sol";
+
+/// @title HarnessSwapRouter
+/// @notice Fixed-rate router used by the Go test harness. Mirrors MockSwapRouter in
+///         FastSettlementV3.t.sol: pulls the input and mints (or pays in ETH) the output.
+contract HarnessSwapRouter {
+    address public weth;
+
+    constructor(address _weth) {
+        weth = _weth;
+    }
+
+    receive() external payable {}
+
+    function swap(
+        address tokenIn,
+        uint256 amountIn,
+        address tokenOut,
+        uint256 amountOut,
+        bool isEthOut
+    ) external payable {
+        IERC20(tokenIn).transferFrom(msg.sender, address(this), amountIn);
+
+        if (isEthOut) {
+            (bool s, ) = msg.sender.call{value: amountOut}("");
+            require(s, "HarnessSwapRouter: ETH transfer failed");
+        } else {
+            HarnessERC20(tokenOut).mint(msg.sender, amountOut);
+        }
+    }
+
+    function swapWETH(uint256 amountIn, address tokenOut, uint256 amountOut) external {
+        IERC20(weth).transferFrom(msg.sender, address(this), amountIn);
+        HarnessERC20(tokenOut).mint(msg.sender, amountOut);
+    }
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+import {IERC20} from "@openzeppelin/contracts/token/ERC20/IERC20.sol";
+import {HarnessERC20} from "./HarnessTokens.sol";
+
+/// @title ProgrammableSwapRouter
+/// @notice Failure-injection router for the Go test harness. Each call to `swap`
+///         consumes the next scripted Behavior (or the default once the queue is
+///         drained), so tests can drive every branch of FastSettlementV3._execute.
+contract ProgrammableSwapRouter {
+    struct Behavior {
+        // Revert with `reason` instead of swapping (surfaces as BadCallTarget).
+        bool fail;
+        string reason;
+        // Input pulled from the caller via transferFrom.
+        uint256 pullAmount;
+        // Input sent back to the caller; anything above pullAmount is minted,
+        // which drives the settlement's unused-input refund.
+        uint256 returnAmount;
+        // Output paid out; ETH when tokenOut is address(0).
+        uint256 outAmount;
+        // Output recipient; address(0) pays the caller, anything else bypasses it.
+        address outTo;
+        // When non-empty, called on the caller before paying out.
+        bytes reentry;
+        // Revert if the reentrant call fails instead of recording it.
+        bool bubbleReentry;
+    }
+
+    Behavior[] private _queue;
+    uint256 public cursor;
+    Behavior private _default;
+    uint256 public callCount;
+
+    event Swapped(
+        uint256 indexed index,
+        uint256 pulled,
+        uint256 returned,
+        uint256 paid,
+        address paidTo
+    );
+    event ReentryAttempted(uint256 indexed index, bool success, bytes returnData);
+
+    receive() external payable {}
+
+    function push(Behavior calldata b) external {
+        _queue.push(b);
+    }
+
+    function setDefault(Behavior calldata b) external {
+        _default = b;
+    }
+
+    function reset() external {
+        delete _queue;
+        cursor = 0;
+        callCount = 0;
+        delete _default;
+    }
+
+    function pending() external view returns (uint256) {
+        return _queue.length - cursor;
+    }
+
+    function swap(address tokenIn, address tokenOut) external payable {
+        uint256 index = callCount++;
+        Behavior memory b = cursor < _queue.length ? _queue[cursor++] : _default;
+
+        if (b.fail) revert(b.reason);
+
+        if (b.pullAmount > 0) {
+            IERC20(tokenIn).transferFrom(msg.sender, address(this), b.pullAmount);
+        }
+        if (b.returnAmount > 0) {
+            uint256 held = IERC20(tokenIn).balanceOf(address(this));
+            if (held < b.returnAmount) {
+                HarnessERC20(tokenIn).mint(address(this), b.returnAmount - held);
+            }
+            IERC20(tokenIn).transfer(msg.sender, b.returnAmount);
+        }
+
+        if (b.reentry.length > 0) {
+            (bool ok, bytes memory ret) = msg.sender.call(b.reentry);
+            emit ReentryAttempted(index, ok, ret);
+            if (!ok && b.bubbleReentry) {
+                assembly {
+                    revert(add(ret, 32), mload(ret))
+                }
+            }
+        }
+
+        address to = b.outTo == address(0) ? msg.sender : b.outTo;
+        if (b.outAmount > 0) {
+            if (tokenOut == address(0)) {
+                (bool s, ) = to.call{value: b.outAmount}("");
+                require(s, "ProgrammableSwapRouter: ETH transfer failed");
+            } else {
+                HarnessERC20(tokenOut).mint(to, b.outAmount);
+            }
+        }
+
+        emit Swapped(index, b.pullAmount, b.returnAmount, b.outAmount, to);
+    }
+}